@@ -0,0 +1,505 @@
+/*
+ * Copyright 2018 The Service Manager Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package apply
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	smtypes "github.com/Peripli/service-manager/pkg/types"
+	"github.com/Peripli/service-manager/pkg/web"
+
+	"github.com/Peripli/service-manager-cli/internal/cmd"
+	"github.com/Peripli/service-manager-cli/internal/output"
+	"github.com/Peripli/service-manager-cli/pkg/query"
+	"github.com/Peripli/service-manager-cli/pkg/types"
+)
+
+const (
+	actionCreate    = "create"
+	actionUpdate    = "update"
+	actionUnchanged = "unchanged"
+)
+
+type notFoundError struct {
+	kind string
+	name string
+}
+
+func (e *notFoundError) Error() string {
+	return fmt.Sprintf("%s %s not found", e.kind, e.name)
+}
+
+// applier creates or updates the resources of a manifest so that they match the current state in Service Manager
+type applier struct {
+	*cmd.Context
+
+	dryRun bool
+	// planned holds per kind the names of the resources which are created in a dry run
+	planned map[string]map[string]bool
+}
+
+func newApplier(ctx *cmd.Context, dryRun bool) *applier {
+	return &applier{Context: ctx, dryRun: dryRun, planned: make(map[string]map[string]bool)}
+}
+
+// apply applies the resource and returns the performed action (or the planned one in a dry run) and the name of the resource
+func (a *applier) apply(r *resource) (string, string, error) {
+	switch r.Kind {
+	case cmd.ManifestKindPlatform:
+		return a.applyPlatform(r)
+	case cmd.ManifestKindBroker:
+		return a.applyBroker(r)
+	case cmd.ManifestKindVisibility:
+		return a.applyVisibility(r)
+	case cmd.ManifestKindInstance:
+		return a.applyInstance(r)
+	case cmd.ManifestKindBinding:
+		return a.applyBinding(r)
+	default:
+		return "", "", fmt.Errorf("%s: unsupported kind %q", r.Source, r.Kind)
+	}
+}
+
+func (a *applier) applyPlatform(r *resource) (string, string, error) {
+	var desired types.Platform
+	if err := r.decode(&desired); err != nil {
+		return "", "", err
+	}
+
+	platforms, err := a.Client.ListPlatforms(a.Ctx, a.query(byName(desired.Name)))
+	if err != nil {
+		return "", desired.Name, err
+	}
+	if len(platforms.Platforms) == 0 {
+		if a.dryRun {
+			return a.plan(cmd.ManifestKindPlatform, desired.Name), desired.Name, nil
+		}
+		created, err := a.Client.RegisterPlatform(a.Ctx, &desired, a.query())
+		if err != nil {
+			return "", desired.Name, err
+		}
+		if created.Credentials != nil {
+			credentials := cmd.Printable(a.Context, created).(*types.Platform).Credentials
+			output.PrintMessage(a.Output, "Credentials of platform %s: username %s, password %s\n", desired.Name, credentials.Basic.User, credentials.Basic.Password)
+			if !a.ShowCredentials {
+				output.PrintMessage(a.Output, "Use --show-credentials to print them. They are returned on registration only, use update-platform --regenerate-credentials to get new ones\n")
+			}
+		}
+		return actionCreate, desired.Name, nil
+	}
+
+	existing := platforms.Platforms[0]
+	if !changed(existing.Description, desired.Description) && !changed(existing.Type, desired.Type) {
+		return actionUnchanged, desired.Name, nil
+	}
+	if !a.dryRun {
+		update := &types.Platform{Name: desired.Name, Description: desired.Description, Type: desired.Type}
+		if _, err := a.Client.UpdatePlatform(a.Ctx, existing.ID, update, a.query()); err != nil {
+			return "", desired.Name, err
+		}
+	}
+	return actionUpdate, desired.Name, nil
+}
+
+func (a *applier) applyBroker(r *resource) (string, string, error) {
+	var desired types.Broker
+	if err := r.decode(&desired); err != nil {
+		return "", "", err
+	}
+
+	brokers, err := a.Client.ListBrokers(a.Ctx, a.query(byName(desired.Name)))
+	if err != nil {
+		return "", desired.Name, err
+	}
+	if len(brokers.Brokers) == 0 {
+		if a.dryRun {
+			return a.plan(cmd.ManifestKindBroker, desired.Name), desired.Name, nil
+		}
+		_, location, err := a.Client.RegisterBroker(a.Ctx, &desired, a.query())
+		if err != nil {
+			return "", desired.Name, err
+		}
+		return actionCreate, desired.Name, a.wait(location)
+	}
+
+	// credentials cannot be read back, so they are sent only along with other changes
+	existing := brokers.Brokers[0]
+	if !changed(existing.URL, desired.URL) && !changed(existing.Description, desired.Description) {
+		return actionUnchanged, desired.Name, nil
+	}
+	if !a.dryRun {
+		update := &types.Broker{URL: desired.URL, Description: desired.Description, Credentials: desired.Credentials}
+		_, location, err := a.Client.UpdateBroker(a.Ctx, existing.ID, update, a.query())
+		if err != nil {
+			return "", desired.Name, err
+		}
+		if err := a.wait(location); err != nil {
+			return "", desired.Name, err
+		}
+	}
+	return actionUpdate, desired.Name, nil
+}
+
+func (a *applier) applyVisibility(r *resource) (string, string, error) {
+	var desired visibilityManifest
+	if err := r.decode(&desired); err != nil {
+		return "", "", err
+	}
+	name := desired.Offering + "/" + desired.Plan
+	if desired.Platform != "" {
+		name += " on " + desired.Platform
+	}
+
+	_, planID, err := a.resolvePlan(desired.Broker, desired.Offering, desired.Plan)
+	if a.isPending(err) {
+		return actionCreate, name, nil
+	}
+	if err != nil {
+		return "", name, err
+	}
+	var platformID string
+	if desired.Platform != "" {
+		platformID, err = a.resolvePlatform(desired.Platform)
+		if a.isPending(err) {
+			return actionCreate, name, nil
+		}
+		if err != nil {
+			return "", name, err
+		}
+	}
+
+	visibilities, err := a.Client.ListVisibilities(a.Ctx, a.query(fmt.Sprintf("service_plan_id eq '%s'", planID)))
+	if err != nil {
+		return "", name, err
+	}
+	for _, visibility := range visibilities.Visibilities {
+		if visibility.PlatformID != platformID {
+			continue
+		}
+		changes := labelChanges(visibility.Labels, desired.Labels)
+		if changes == nil {
+			return actionUnchanged, name, nil
+		}
+		if !a.dryRun {
+			if err := a.Client.Label(a.Ctx, web.VisibilitiesURL, visibility.ID, changes, a.query()); err != nil {
+				return "", name, err
+			}
+		}
+		return actionUpdate, name, nil
+	}
+	if !a.dryRun {
+		visibility := &types.Visibility{PlatformID: platformID, ServicePlanID: planID, Labels: desired.Labels}
+		if _, err := a.Client.RegisterVisibility(a.Ctx, visibility, a.query()); err != nil {
+			return "", name, err
+		}
+	}
+	return actionCreate, name, nil
+}
+
+func (a *applier) applyInstance(r *resource) (string, string, error) {
+	var desired instanceManifest
+	if err := r.decode(&desired); err != nil {
+		return "", "", err
+	}
+	name := desired.Name
+
+	instances, err := a.Client.ListInstances(a.Ctx, a.query(byName(name)))
+	if err != nil {
+		return "", name, err
+	}
+	offeringID, planID, err := a.resolvePlan(desired.Broker, desired.Offering, desired.Plan)
+	pending := a.isPending(err)
+	if err != nil && !pending {
+		return "", name, err
+	}
+
+	if len(instances.ServiceInstances) == 0 {
+		if a.dryRun {
+			return a.plan(cmd.ManifestKindInstance, name), name, nil
+		}
+		instance := desired.ServiceInstance
+		instance.ServiceID = offeringID
+		instance.ServicePlanID = planID
+		_, location, err := a.Client.Provision(a.Ctx, &instance, a.query())
+		if err != nil {
+			return "", name, err
+		}
+		return actionCreate, name, a.wait(location)
+	}
+
+	existing := instances.ServiceInstances[0]
+	update := &types.ServiceInstance{}
+	planChanged := existing.ServicePlanID != planID
+	if pending {
+		planChanged = !a.isCurrentPlan(existing.ServicePlanID, desired.Broker, desired.Offering, desired.Plan)
+	}
+	if planChanged {
+		update.ServicePlanID = planID
+	}
+	if len(desired.Parameters) > 0 && !a.sameParameters(existing.ID, desired.Parameters) {
+		update.Parameters = desired.Parameters
+	}
+	changes := labelChanges(existing.Labels, desired.Labels)
+	if !planChanged && update.Parameters == nil && changes == nil {
+		return actionUnchanged, name, nil
+	}
+	if a.dryRun {
+		return actionUpdate, name, nil
+	}
+	if planChanged || update.Parameters != nil {
+		_, location, err := a.Client.UpdateInstance(a.Ctx, existing.ID, update, a.query())
+		if err != nil {
+			return "", name, err
+		}
+		if err := a.wait(location); err != nil {
+			return "", name, err
+		}
+	}
+	if changes != nil {
+		if err := a.Client.Label(a.Ctx, web.ServiceInstancesURL, existing.ID, changes, a.query()); err != nil {
+			return "", name, err
+		}
+	}
+	return actionUpdate, name, nil
+}
+
+func (a *applier) applyBinding(r *resource) (string, string, error) {
+	var desired bindingManifest
+	if err := r.decode(&desired); err != nil {
+		return "", "", err
+	}
+	name := desired.Name
+
+	instances, err := a.Client.ListInstances(a.Ctx, a.query(byName(desired.Instance)))
+	if err != nil {
+		return "", name, err
+	}
+	if len(instances.ServiceInstances) == 0 {
+		if a.dryRun && a.planned[cmd.ManifestKindInstance][desired.Instance] {
+			return a.plan(cmd.ManifestKindBinding, name), name, nil
+		}
+		return "", name, &notFoundError{kind: cmd.ManifestKindInstance, name: desired.Instance}
+	}
+	instanceID := instances.ServiceInstances[0].ID
+
+	bindings, err := a.Client.ListBindings(a.Ctx, a.query(byName(name), fmt.Sprintf("service_instance_id eq '%s'", instanceID)))
+	if err != nil {
+		return "", name, err
+	}
+	if len(bindings.ServiceBindings) > 0 {
+		// bindings cannot be updated
+		return actionUnchanged, name, nil
+	}
+	if a.dryRun {
+		return a.plan(cmd.ManifestKindBinding, name), name, nil
+	}
+
+	binding := desired.ServiceBinding
+	binding.ServiceInstanceID = instanceID
+	// credentials are generated by the broker, exported ones are ignored
+	binding.Credentials = nil
+	_, location, err := a.Client.Bind(a.Ctx, &binding, a.query())
+	if err != nil {
+		return "", name, err
+	}
+	return actionCreate, name, a.wait(location)
+}
+
+// resolvePlan returns the IDs of the offering and the plan with the given names.
+// The broker name is required only if the offering name is ambiguous.
+func (a *applier) resolvePlan(brokerName, offeringName, planName string) (string, string, error) {
+	offerings, err := a.Client.ListOfferings(a.Ctx, a.query(byName(offeringName)))
+	if err != nil {
+		return "", "", err
+	}
+	if len(offerings.ServiceOfferings) == 0 {
+		return "", "", &notFoundError{kind: "service offering", name: offeringName}
+	}
+
+	offeringID := offerings.ServiceOfferings[0].ID
+	if len(offerings.ServiceOfferings) > 1 || brokerName != "" {
+		if brokerName == "" {
+			return "", "", fmt.Errorf("more than one service offering with name %s found, specify the broker", offeringName)
+		}
+		brokers, err := a.Client.ListBrokers(a.Ctx, a.query(byName(brokerName)))
+		if err != nil {
+			return "", "", err
+		}
+		if len(brokers.Brokers) == 0 {
+			return "", "", &notFoundError{kind: cmd.ManifestKindBroker, name: brokerName}
+		}
+		offeringID = ""
+		for _, offering := range offerings.ServiceOfferings {
+			if offering.BrokerID == brokers.Brokers[0].ID {
+				offeringID = offering.ID
+				break
+			}
+		}
+		if offeringID == "" {
+			return "", "", &notFoundError{kind: "service offering", name: offeringName}
+		}
+	}
+
+	plans, err := a.Client.ListPlans(a.Ctx, a.query(byName(planName), fmt.Sprintf("service_offering_id eq '%s'", offeringID)))
+	if err != nil {
+		return "", "", err
+	}
+	if len(plans.ServicePlans) != 1 {
+		return "", "", cmd.NewError(cmd.ErrorKindNotFound, "exactly one service plan with name %s for offering %s expected, found %d", planName, offeringName, len(plans.ServicePlans))
+	}
+	return offeringID, plans.ServicePlans[0].ID, nil
+}
+
+// isCurrentPlan returns true if the plan with the given ID has the given names.
+// It is used in a dry run, when the desired plan cannot be resolved since its broker is planned to be registered.
+func (a *applier) isCurrentPlan(planID, brokerName, offeringName, planName string) bool {
+	if a.planned[cmd.ManifestKindBroker][brokerName] {
+		return false
+	}
+	plan, err := a.Client.GetPlanByID(a.Ctx, planID, a.query())
+	if err != nil || plan.Name != planName {
+		return false
+	}
+	offering, err := a.Client.GetOfferingByID(a.Ctx, plan.ServiceOfferingID, a.query())
+	return err == nil && offering.Name == offeringName
+}
+
+func (a *applier) resolvePlatform(name string) (string, error) {
+	platforms, err := a.Client.ListPlatforms(a.Ctx, a.query(byName(name)))
+	if err != nil {
+		return "", err
+	}
+	if len(platforms.Platforms) == 0 {
+		return "", &notFoundError{kind: cmd.ManifestKindPlatform, name: name}
+	}
+	return platforms.Platforms[0].ID, nil
+}
+
+// isPending returns true if in a dry run a referenced resource is not found because it is planned to be created
+func (a *applier) isPending(err error) bool {
+	notFound, ok := err.(*notFoundError)
+	if !a.dryRun || !ok {
+		return false
+	}
+	switch notFound.kind {
+	case cmd.ManifestKindPlatform, cmd.ManifestKindBroker:
+		return a.planned[notFound.kind][notFound.name]
+	default:
+		// offerings of brokers which are not registered yet are not known
+		return len(a.planned[cmd.ManifestKindBroker]) > 0
+	}
+}
+
+func (a *applier) plan(kind, name string) string {
+	if a.planned[kind] == nil {
+		a.planned[kind] = make(map[string]bool)
+	}
+	a.planned[kind][name] = true
+	return actionCreate
+}
+
+func (a *applier) sameParameters(instanceID string, desired json.RawMessage) bool {
+	current, err := a.Client.GetInstanceParameters(a.Ctx, instanceID, a.query())
+	if err != nil {
+		return false
+	}
+	var parameters map[string]interface{}
+	if err := json.Unmarshal(desired, &parameters); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(current, parameters)
+}
+
+func (a *applier) wait(location string) error {
+	if location == "" {
+		return nil
+	}
+	operation, err := cmd.WaitForOperation(a.Context, location)
+	if err != nil {
+		return err
+	}
+	if operation.State == string(smtypes.FAILED) {
+		return cmd.NewError(cmd.ErrorKindOperationFailed, "operation %s failed: %s", operation.ID, string(operation.Errors))
+	}
+	return nil
+}
+
+func (a *applier) query(fieldQuery ...string) *query.Parameters {
+	return &query.Parameters{
+		FieldQuery:    fieldQuery,
+		GeneralParams: a.Parameters.GeneralParams,
+	}
+}
+
+func byName(name string) string {
+	return fmt.Sprintf("name eq '%s'", name)
+}
+
+// labelChanges returns the changes which add the desired labels to the current ones, or nil if there are none.
+// Only the keys given in the manifest are managed, other labels are kept.
+func labelChanges(current, desired smtypes.Labels) *types.LabelChanges {
+	keys := make([]string, 0, len(desired))
+	for key := range desired {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var changes []*smtypes.LabelChange
+	for _, key := range keys {
+		currentValues, found := current[key]
+		if !found {
+			changes = append(changes, &smtypes.LabelChange{Operation: smtypes.AddLabelOperation, Key: key, Values: desired[key]})
+			continue
+		}
+		if added := missingValues(desired[key], currentValues); len(added) > 0 {
+			changes = append(changes, &smtypes.LabelChange{Operation: smtypes.AddLabelValuesOperation, Key: key, Values: added})
+		}
+		if removed := missingValues(currentValues, desired[key]); len(removed) > 0 {
+			changes = append(changes, &smtypes.LabelChange{Operation: smtypes.RemoveLabelValuesOperation, Key: key, Values: removed})
+		}
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+	return &types.LabelChanges{LabelChanges: changes}
+}
+
+// missingValues returns the values which are not contained in the other ones
+func missingValues(values, other []string) []string {
+	var missing []string
+	for _, value := range values {
+		found := false
+		for _, v := range other {
+			if v == value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, value)
+		}
+	}
+	return missing
+}
+
+// changed returns true if the desired value is set and differs from the current one
+func changed(current, desired string) bool {
+	return desired != "" && current != desired
+}
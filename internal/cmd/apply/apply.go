@@ -0,0 +1,141 @@
+/*
+ * Copyright 2018 The Service Manager Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package apply
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+
+	"github.com/Peripli/service-manager-cli/internal/cmd"
+	"github.com/Peripli/service-manager-cli/internal/output"
+)
+
+var pastTense = map[string]string{
+	actionCreate:    "created",
+	actionUpdate:    "updated",
+	actionUnchanged: actionUnchanged,
+}
+
+// Cmd wraps the smctl apply command
+type Cmd struct {
+	*cmd.Context
+
+	input     io.Reader
+	fs        afero.Fs
+	files     []string
+	dryRun    bool
+	resources []*resource
+}
+
+// NewApplyCmd returns new apply command with context, input reader used for reading a manifest from stdin and file system
+func NewApplyCmd(context *cmd.Context, input io.Reader, fs afero.Fs) *Cmd {
+	return &Cmd{Context: context, input: input, fs: fs}
+}
+
+// Prepare returns cobra command
+func (ac *Cmd) Prepare(prepare cmd.PrepareFunc) *cobra.Command {
+	result := &cobra.Command{
+		Use:   "apply -f [manifest]",
+		Short: "Creates or updates resources from a manifest",
+		Long: `Creates or updates platforms, brokers, visibilities, instances and bindings described in a YAML or JSON manifest.
+Resources are matched by name against the current state and are created or updated in dependency order.`,
+
+		PreRunE: prepare(ac, ac.Context),
+		RunE:    cmd.RunE(ac),
+	}
+
+	result.Flags().StringArrayVarP(&ac.files, "filename", "f", nil, "Manifest file to apply, - reads the manifest from stdin")
+	result.Flags().BoolVarP(&ac.dryRun, "dry-run", "", false, "Print the changes without applying them")
+	result.Flags().DurationVarP(&ac.Wait.Timeout, "timeout", "", cmd.DefaultWaitTimeout, "Maximum time to wait for each asynchronous operation")
+	cmd.AddCommonQueryFlag(result.Flags(), &ac.Parameters)
+	cmd.AddShowCredentialsFlag(result.Flags(), &ac.ShowCredentials)
+
+	return result
+}
+
+// Validate validates command's arguments and reads the manifests
+func (ac *Cmd) Validate(args []string) error {
+	if len(ac.files) == 0 {
+		return errors.New("manifest is required, use -f to provide it")
+	}
+
+	ac.resources = nil
+	for _, file := range ac.files {
+		resources, err := ac.readManifest(file)
+		if err != nil {
+			return err
+		}
+		ac.resources = append(ac.resources, resources...)
+	}
+	if len(ac.resources) == 0 {
+		return errors.New("no resources found in manifest")
+	}
+	return nil
+}
+
+func (ac *Cmd) readManifest(file string) ([]*resource, error) {
+	if file == "-" {
+		return parseManifest("stdin", ac.input)
+	}
+
+	f, err := ac.fs.Open(file)
+	if err != nil {
+		return nil, fmt.Errorf("could not read manifest: %s", err)
+	}
+	defer f.Close()
+	return parseManifest(file, f)
+}
+
+// Run runs the command's logic
+func (ac *Cmd) Run() error {
+	applier := newApplier(ac.Context, ac.dryRun)
+	counts := make(map[string]int)
+
+	for _, kind := range cmd.ManifestKinds {
+		for _, r := range ac.resources {
+			if r.Kind != kind {
+				continue
+			}
+			action, name, err := applier.apply(r)
+			if err != nil {
+				return fmt.Errorf("could not apply %s %s: %s", r.Kind, name, err)
+			}
+			counts[action]++
+			if ac.dryRun {
+				output.PrintMessage(ac.Output, "%s %s: %s\n", r.Kind, name, action)
+			} else {
+				output.PrintMessage(ac.Output, "%s %s: %s\n", r.Kind, name, pastTense[action])
+			}
+		}
+	}
+
+	if ac.dryRun {
+		output.PrintMessage(ac.Output, "Plan: %d to create, %d to update, %d unchanged.\n", counts[actionCreate], counts[actionUpdate], counts[actionUnchanged])
+	} else {
+		output.PrintMessage(ac.Output, "Apply complete: %d created, %d updated, %d unchanged.\n", counts[actionCreate], counts[actionUpdate], counts[actionUnchanged])
+	}
+	return nil
+}
+
+// HideUsage hide command's usage
+func (ac *Cmd) HideUsage() bool {
+	return true
+}
@@ -0,0 +1,370 @@
+package apply
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	smtypes "github.com/Peripli/service-manager/pkg/types"
+	"github.com/Peripli/service-manager/pkg/web"
+	"github.com/spf13/afero"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/Peripli/service-manager-cli/internal/cmd"
+	"github.com/Peripli/service-manager-cli/pkg/query"
+	"github.com/Peripli/service-manager-cli/pkg/smclient/smclientfakes"
+	"github.com/Peripli/service-manager-cli/pkg/types"
+)
+
+const manifest = `
+kind: platform
+name: cf-eu
+type: cloudfoundry
+---
+kind: broker
+name: my-broker
+broker_url: https://broker.com
+credentials:
+  basic:
+    username: admin
+    password: secret
+---
+kind: visibility
+platform: cf-eu
+offering: my-service
+plan: small
+---
+kind: instance
+name: my-instance
+offering: my-service
+plan: small
+parameters:
+  size: 10
+---
+kind: binding
+name: my-binding
+instance: my-instance
+`
+
+var _ = Describe("Apply command test", func() {
+	var client *smclientfakes.FakeClient
+	var command *Cmd
+	var buffer *bytes.Buffer
+	var fs afero.Fs
+
+	executeWithArgs := func(args ...string) error {
+		commandToRun := command.Prepare(cmd.SmPrepare)
+		commandToRun.SetArgs(args)
+		return commandToRun.Execute()
+	}
+
+	existingState := func() {
+		client.ListPlatformsReturns(&types.Platforms{Platforms: []types.Platform{{ID: "platform-id", Name: "cf-eu", Type: "cloudfoundry"}}}, nil)
+		client.ListBrokersReturns(&types.Brokers{Brokers: []types.Broker{{ID: "broker-id", Name: "my-broker", URL: "https://broker.com"}}}, nil)
+		client.ListVisibilitiesReturns(&types.Visibilities{Visibilities: []types.Visibility{{ID: "visibility-id", PlatformID: "platform-id", ServicePlanID: "plan-id"}}}, nil)
+		client.ListInstancesReturns(&types.ServiceInstances{ServiceInstances: []types.ServiceInstance{{ID: "instance-id", Name: "my-instance", ServicePlanID: "plan-id"}}}, nil)
+		client.GetInstanceParametersReturns(map[string]interface{}{"size": float64(10)}, nil)
+		client.ListBindingsReturns(&types.ServiceBindings{ServiceBindings: []types.ServiceBinding{{ID: "binding-id", Name: "my-binding"}}}, nil)
+	}
+
+	BeforeEach(func() {
+		buffer = &bytes.Buffer{}
+		fs = afero.NewMemMapFs()
+		client = &smclientfakes.FakeClient{}
+		context := &cmd.Context{Output: buffer, Client: client}
+		command = NewApplyCmd(context, strings.NewReader(manifest), fs)
+
+		Expect(afero.WriteFile(fs, "manifest.yaml", []byte(manifest), 0644)).To(Succeed())
+
+		client.ListPlatformsReturns(&types.Platforms{}, nil)
+		client.ListBrokersReturns(&types.Brokers{}, nil)
+		client.ListVisibilitiesReturns(&types.Visibilities{}, nil)
+		client.ListInstancesReturns(&types.ServiceInstances{}, nil)
+		client.ListBindingsReturns(&types.ServiceBindings{}, nil)
+		client.ListOfferingsReturns(&types.ServiceOfferings{ServiceOfferings: []types.ServiceOffering{{ID: "offering-id", Name: "my-service", BrokerID: "broker-id"}}}, nil)
+		client.ListPlansReturns(&types.ServicePlans{ServicePlans: []types.ServicePlan{{ID: "plan-id", Name: "small"}}}, nil)
+		client.RegisterPlatformReturns(&types.Platform{ID: "platform-id"}, nil)
+		client.RegisterBrokerReturns(&types.Broker{ID: "broker-id"}, "", nil)
+		client.RegisterVisibilityReturns(&types.Visibility{ID: "visibility-id"}, nil)
+		client.ProvisionReturns(&types.ServiceInstance{ID: "instance-id"}, "", nil)
+		client.BindReturns(&types.ServiceBinding{ID: "binding-id"}, "", nil)
+	})
+
+	Context("when no resource exists", func() {
+		BeforeEach(func() {
+			client.ListPlatformsReturnsOnCall(1, &types.Platforms{Platforms: []types.Platform{{ID: "platform-id", Name: "cf-eu"}}}, nil)
+		})
+
+		It("should create all resources in dependency order", func() {
+			client.ListInstancesReturnsOnCall(1, &types.ServiceInstances{ServiceInstances: []types.ServiceInstance{{ID: "instance-id", Name: "my-instance"}}}, nil)
+
+			err := executeWithArgs("-f", "manifest.yaml")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(client.RegisterPlatformCallCount()).To(Equal(1))
+			_, platform, _ := client.RegisterPlatformArgsForCall(0)
+			Expect(*platform).To(Equal(types.Platform{Name: "cf-eu", Type: "cloudfoundry"}))
+
+			_, broker, _ := client.RegisterBrokerArgsForCall(0)
+			Expect(broker.URL).To(Equal("https://broker.com"))
+			Expect(broker.Credentials.Basic.Password).To(Equal("secret"))
+
+			_, visibility, _ := client.RegisterVisibilityArgsForCall(0)
+			Expect(*visibility).To(Equal(types.Visibility{PlatformID: "platform-id", ServicePlanID: "plan-id"}))
+
+			_, instance, _ := client.ProvisionArgsForCall(0)
+			Expect(instance.Name).To(Equal("my-instance"))
+			Expect(instance.ServiceID).To(Equal("offering-id"))
+			Expect(instance.ServicePlanID).To(Equal("plan-id"))
+			Expect(instance.Parameters).To(MatchJSON(`{"size": 10}`))
+
+			_, binding, _ := client.BindArgsForCall(0)
+			Expect(binding.Name).To(Equal("my-binding"))
+			Expect(binding.ServiceInstanceID).To(Equal("instance-id"))
+
+			Expect(buffer.String()).To(ContainSubstring("platform cf-eu: created\nbroker my-broker: created\nvisibility my-service/small on cf-eu: created\ninstance my-instance: created\nbinding my-binding: created\n"))
+			Expect(buffer.String()).To(ContainSubstring("Apply complete: 5 created, 0 updated, 0 unchanged.\n"))
+		})
+
+		It("should wait for async operations", func() {
+			client.ProvisionReturns(&types.ServiceInstance{}, "/v1/service_instances/instance-id/operations/op-id", nil)
+			client.StatusReturns(&types.Operation{ID: "op-id", State: "succeeded"}, nil)
+			client.ListInstancesReturnsOnCall(1, &types.ServiceInstances{ServiceInstances: []types.ServiceInstance{{ID: "instance-id", Name: "my-instance"}}}, nil)
+
+			err := executeWithArgs("-f", "manifest.yaml")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(client.StatusCallCount()).To(Equal(1))
+			_, location, _ := client.StatusArgsForCall(0)
+			Expect(location).To(Equal("/v1/service_instances/instance-id/operations/op-id"))
+		})
+
+		It("should stop on failed async operation", func() {
+			client.ProvisionReturns(&types.ServiceInstance{}, "/v1/service_instances/instance-id/operations/op-id", nil)
+			client.StatusReturns(&types.Operation{ID: "op-id", State: "failed", Errors: json.RawMessage(`{"description":"broker error"}`)}, nil)
+
+			err := executeWithArgs("-f", "manifest.yaml")
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("could not apply instance my-instance: operation op-id failed"))
+			Expect(client.BindCallCount()).To(BeZero())
+		})
+
+		It("should show the plan on dry run without changes", func() {
+			client.ListOfferingsReturns(&types.ServiceOfferings{}, nil)
+
+			err := executeWithArgs("-f", "manifest.yaml", "--dry-run")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(client.RegisterPlatformCallCount()).To(BeZero())
+			Expect(client.RegisterBrokerCallCount()).To(BeZero())
+			Expect(client.RegisterVisibilityCallCount()).To(BeZero())
+			Expect(client.ProvisionCallCount()).To(BeZero())
+			Expect(client.BindCallCount()).To(BeZero())
+			Expect(buffer.String()).To(ContainSubstring("binding my-binding: create\n"))
+			Expect(buffer.String()).To(ContainSubstring("Plan: 5 to create, 0 to update, 0 unchanged.\n"))
+		})
+
+		Context("when the platform is registered with credentials", func() {
+			BeforeEach(func() {
+				client.RegisterPlatformReturns(&types.Platform{ID: "platform-id", Credentials: &types.Credentials{Basic: types.Basic{User: "user", Password: "pass"}}}, nil)
+				client.ListInstancesReturnsOnCall(1, &types.ServiceInstances{ServiceInstances: []types.ServiceInstance{{ID: "instance-id", Name: "my-instance"}}}, nil)
+			})
+
+			It("should mask them", func() {
+				err := executeWithArgs("-f", "manifest.yaml")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(buffer.String()).To(ContainSubstring("Credentials of platform cf-eu: username [REDACTED], password [REDACTED]\n"))
+				Expect(buffer.String()).To(ContainSubstring("Use --show-credentials to print them."))
+				Expect(buffer.String()).NotTo(ContainSubstring("password pass"))
+			})
+
+			It("should print them with --show-credentials", func() {
+				err := executeWithArgs("-f", "manifest.yaml", "--show-credentials")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(buffer.String()).To(ContainSubstring("Credentials of platform cf-eu: username user, password pass\n"))
+				Expect(buffer.String()).NotTo(ContainSubstring("Use --show-credentials"))
+			})
+		})
+	})
+
+	Context("when all resources exist", func() {
+		BeforeEach(existingState)
+
+		It("should not change anything", func() {
+			err := executeWithArgs("-f", "manifest.yaml")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(client.UpdatePlatformCallCount()).To(BeZero())
+			Expect(client.UpdateBrokerCallCount()).To(BeZero())
+			Expect(client.UpdateInstanceCallCount()).To(BeZero())
+			Expect(client.RegisterVisibilityCallCount()).To(BeZero())
+			Expect(client.BindCallCount()).To(BeZero())
+			Expect(buffer.String()).To(ContainSubstring("Apply complete: 0 created, 0 updated, 5 unchanged.\n"))
+
+			_, instanceQuery := client.ListInstancesArgsForCall(0)
+			Expect(instanceQuery.FieldQuery).To(ConsistOf("name eq 'my-instance'"))
+		})
+
+		It("should update changed resources", func() {
+			client.ListBrokersReturns(&types.Brokers{Brokers: []types.Broker{{ID: "broker-id", Name: "my-broker", URL: "https://old-broker.com"}}}, nil)
+			client.UpdateBrokerReturns(&types.Broker{}, "", nil)
+			client.GetInstanceParametersReturns(map[string]interface{}{"size": float64(5)}, nil)
+			client.UpdateInstanceReturns(&types.ServiceInstance{}, "", nil)
+
+			err := executeWithArgs("-f", "manifest.yaml")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, id, broker, _ := client.UpdateBrokerArgsForCall(0)
+			Expect(id).To(Equal("broker-id"))
+			Expect(broker.URL).To(Equal("https://broker.com"))
+
+			_, id, instance, _ := client.UpdateInstanceArgsForCall(0)
+			Expect(id).To(Equal("instance-id"))
+			Expect(instance.ServicePlanID).To(BeEmpty())
+			Expect(instance.Parameters).To(MatchJSON(`{"size": 10}`))
+
+			Expect(buffer.String()).To(ContainSubstring("Apply complete: 0 created, 2 updated, 3 unchanged.\n"))
+		})
+
+		It("should update changed labels only", func() {
+			client.ListVisibilitiesReturns(&types.Visibilities{Visibilities: []types.Visibility{{ID: "visibility-id", PlatformID: "platform-id", ServicePlanID: "plan-id",
+				Labels: smtypes.Labels{"org": {"org1", "org2"}}}}}, nil)
+			client.ListInstancesReturns(&types.ServiceInstances{ServiceInstances: []types.ServiceInstance{{ID: "instance-id", Name: "my-instance", ServicePlanID: "plan-id",
+				Labels: smtypes.Labels{"team": {"a"}, "owner": {"b"}}}}}, nil)
+			labeled := "kind: visibility\nplatform: cf-eu\noffering: my-service\nplan: small\nlabels:\n  org: [org1, org3]\n" +
+				"---\nkind: instance\nname: my-instance\noffering: my-service\nplan: small\nparameters:\n  size: 10\nlabels:\n  team: [a]\n  env: [dev]\n"
+			Expect(afero.WriteFile(fs, "labels.yaml", []byte(labeled), 0644)).To(Succeed())
+
+			err := executeWithArgs("-f", "labels.yaml")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(client.UpdateInstanceCallCount()).To(BeZero())
+			Expect(client.LabelCallCount()).To(Equal(2))
+			_, url, id, changes, _ := client.LabelArgsForCall(0)
+			Expect(url).To(Equal(web.VisibilitiesURL))
+			Expect(id).To(Equal("visibility-id"))
+			Expect(changes.LabelChanges).To(Equal([]*smtypes.LabelChange{
+				{Operation: smtypes.AddLabelValuesOperation, Key: "org", Values: []string{"org3"}},
+				{Operation: smtypes.RemoveLabelValuesOperation, Key: "org", Values: []string{"org2"}},
+			}))
+			_, url, id, changes, _ = client.LabelArgsForCall(1)
+			Expect(url).To(Equal(web.ServiceInstancesURL))
+			Expect(id).To(Equal("instance-id"))
+			Expect(changes.LabelChanges).To(Equal([]*smtypes.LabelChange{
+				{Operation: smtypes.AddLabelOperation, Key: "env", Values: []string{"dev"}},
+			}))
+			Expect(buffer.String()).To(ContainSubstring("Apply complete: 0 created, 2 updated, 0 unchanged.\n"))
+		})
+
+		Context("when the offering belongs to a broker which is planned to be registered", func() {
+			BeforeEach(func() {
+				client.ListBrokersReturns(&types.Brokers{}, nil)
+				client.ListOfferingsReturns(&types.ServiceOfferings{}, nil)
+				client.GetPlanByIDReturns(&types.ServicePlan{ID: "plan-id", Name: "small", ServiceOfferingID: "offering-id"}, nil)
+				client.GetOfferingByIDReturns(&types.ServiceOffering{ID: "offering-id", Name: "my-service"}, nil)
+			})
+
+			It("should not plan to update an instance with the same plan", func() {
+				err := executeWithArgs("-f", "manifest.yaml", "--dry-run")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(buffer.String()).To(ContainSubstring("instance my-instance: unchanged\n"))
+				_, planID, _ := client.GetPlanByIDArgsForCall(0)
+				Expect(planID).To(Equal("plan-id"))
+			})
+
+			It("should plan to update an instance with another plan", func() {
+				client.GetPlanByIDReturns(&types.ServicePlan{ID: "plan-id", Name: "large", ServiceOfferingID: "offering-id"}, nil)
+				err := executeWithArgs("-f", "manifest.yaml", "--dry-run")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(buffer.String()).To(ContainSubstring("instance my-instance: update\n"))
+			})
+		})
+	})
+
+	Context("when manifest is read from stdin", func() {
+		It("should apply it", func() {
+			existingState()
+			err := executeWithArgs("-f", "-", "--dry-run")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buffer.String()).To(ContainSubstring("Plan: 0 to create, 0 to update, 5 unchanged.\n"))
+		})
+	})
+
+	Context("when manifest is a JSON list", func() {
+		It("should apply each item", func() {
+			Expect(afero.WriteFile(fs, "manifest.json", []byte(`[{"kind": "platform", "name": "cf-eu", "type": "cloudfoundry"}, {"kind": "broker", "name": "my-broker", "broker_url": "https://broker.com"}]`), 0644)).To(Succeed())
+
+			err := executeWithArgs("-f", "manifest.json")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(client.RegisterPlatformCallCount()).To(Equal(1))
+			Expect(client.RegisterBrokerCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when an API call fails", func() {
+		It("should return error", func() {
+			client.ListPlatformsReturns(nil, errors.New("http error"))
+			err := executeWithArgs("-f", "manifest.yaml")
+			Expect(err).To(MatchError("could not apply platform cf-eu: http error"))
+		})
+	})
+
+	Context("when a referenced resource is missing", func() {
+		It("should return error", func() {
+			existingState()
+			client.ListInstancesReturns(&types.ServiceInstances{}, nil)
+			client.ProvisionReturns(nil, "", errors.New("provision failed"))
+			Expect(afero.WriteFile(fs, "binding.yaml", []byte("kind: binding\nname: my-binding\ninstance: missing\n"), 0644)).To(Succeed())
+
+			err := executeWithArgs("-f", "binding.yaml")
+			Expect(err).To(MatchError("could not apply binding my-binding: instance missing not found"))
+		})
+	})
+
+	Context("with invalid manifest", func() {
+		invalidManifests := []struct {
+			content string
+			err     string
+		}{
+			{"kind: space\nname: dev\n", `unsupported kind "space"`},
+			{"kind: broker\nname: my-broker\n", "broker requires broker_url"},
+			{"kind: instance\nname: my-instance\noffering: my-service\n", "instance requires plan"},
+			{"kind: broker\nname: [\n", "document 1"},
+		}
+		for _, entry := range invalidManifests {
+			entry := entry
+			It("should return error for "+entry.err, func() {
+				Expect(afero.WriteFile(fs, "invalid.yaml", []byte(entry.content), 0644)).To(Succeed())
+				err := executeWithArgs("-f", "invalid.yaml")
+				Expect(err).Should(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(entry.err))
+				Expect(client.ListPlatformsCallCount()).To(BeZero())
+			})
+		}
+
+		It("should require a manifest", func() {
+			Expect(executeWithArgs()).To(MatchError("manifest is required, use -f to provide it"))
+		})
+
+		It("should fail for missing file", func() {
+			err := executeWithArgs("-f", "missing.yaml")
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("could not read manifest"))
+		})
+	})
+
+	Context("with general parameters", func() {
+		It("should pass them to the API calls", func() {
+			existingState()
+			err := executeWithArgs("-f", "manifest.yaml", "--param", "key=value")
+			Expect(err).ShouldNot(HaveOccurred())
+			_, platformsQuery := client.ListPlatformsArgsForCall(0)
+			Expect(*platformsQuery).To(Equal(query.Parameters{
+				FieldQuery:    []string{"name eq 'cf-eu'"},
+				GeneralParams: []string{"key=value"},
+			}))
+		})
+	})
+})
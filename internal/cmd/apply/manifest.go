@@ -0,0 +1,185 @@
+/*
+ * Copyright 2018 The Service Manager Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package apply
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/Peripli/service-manager-cli/internal/cmd"
+	"github.com/Peripli/service-manager-cli/pkg/types"
+)
+
+// resource is a single object of a manifest. Content holds the object encoded as JSON.
+type resource struct {
+	Kind    string
+	Source  string
+	Content []byte
+}
+
+// visibilityManifest references the platform, offering and plan of a visibility by name
+type visibilityManifest struct {
+	types.Visibility
+	Platform string `json:"platform"`
+	Broker   string `json:"broker"`
+	Offering string `json:"offering"`
+	Plan     string `json:"plan"`
+}
+
+// instanceManifest references the offering and plan of an instance by name
+type instanceManifest struct {
+	types.ServiceInstance
+	Broker   string `json:"broker"`
+	Offering string `json:"offering"`
+	Plan     string `json:"plan"`
+}
+
+// bindingManifest references the instance of a binding by name
+type bindingManifest struct {
+	types.ServiceBinding
+	Instance string `json:"instance"`
+}
+
+// parseManifest reads the resources of a multi-document YAML or JSON manifest.
+// A document may hold a single resource or a list of resources.
+func parseManifest(source string, reader io.Reader) ([]*resource, error) {
+	var resources []*resource
+	decoder := yaml.NewDecoder(reader)
+	for document := 1; ; document++ {
+		var content interface{}
+		err := decoder.Decode(&content)
+		if errors.Is(err, io.EOF) {
+			return resources, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: document %d: %s", source, document, err)
+		}
+
+		items, isList := content.([]interface{})
+		if !isList {
+			items = []interface{}{content}
+		}
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			location := fmt.Sprintf("%s: document %d", source, document)
+			if isList {
+				location = fmt.Sprintf("%s, item %d", location, i+1)
+			}
+			r, err := newResource(location, item)
+			if err != nil {
+				return nil, err
+			}
+			resources = append(resources, r)
+		}
+	}
+}
+
+func newResource(location string, item interface{}) (*resource, error) {
+	object, ok := item.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s: expected an object", location)
+	}
+	kind, _ := object["kind"].(string)
+	if !isSupportedKind(kind) {
+		return nil, fmt.Errorf("%s: unsupported kind %q, supported kinds are %s", location, kind, strings.Join(cmd.ManifestKinds, ", "))
+	}
+	delete(object, "kind")
+
+	content, err := json.Marshal(object)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", location, err)
+	}
+	r := &resource{Kind: kind, Source: location, Content: content}
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func isSupportedKind(kind string) bool {
+	for _, k := range cmd.ManifestKinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *resource) decode(object interface{}) error {
+	if err := json.Unmarshal(r.Content, object); err != nil {
+		return fmt.Errorf("%s: invalid %s: %s", r.Source, r.Kind, err)
+	}
+	return nil
+}
+
+func (r *resource) validate() error {
+	var missing []string
+	switch r.Kind {
+	case cmd.ManifestKindPlatform:
+		var platform types.Platform
+		if err := r.decode(&platform); err != nil {
+			return err
+		}
+		missing = missingFields(map[string]string{"name": platform.Name, "type": platform.Type})
+	case cmd.ManifestKindBroker:
+		var broker types.Broker
+		if err := r.decode(&broker); err != nil {
+			return err
+		}
+		missing = missingFields(map[string]string{"name": broker.Name, "broker_url": broker.URL})
+	case cmd.ManifestKindVisibility:
+		var visibility visibilityManifest
+		if err := r.decode(&visibility); err != nil {
+			return err
+		}
+		missing = missingFields(map[string]string{"offering": visibility.Offering, "plan": visibility.Plan})
+	case cmd.ManifestKindInstance:
+		var instance instanceManifest
+		if err := r.decode(&instance); err != nil {
+			return err
+		}
+		missing = missingFields(map[string]string{"name": instance.Name, "offering": instance.Offering, "plan": instance.Plan})
+	case cmd.ManifestKindBinding:
+		var binding bindingManifest
+		if err := r.decode(&binding); err != nil {
+			return err
+		}
+		missing = missingFields(map[string]string{"name": binding.Name, "instance": binding.Instance})
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("%s: %s requires %s", r.Source, r.Kind, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+func missingFields(fields map[string]string) []string {
+	var missing []string
+	for _, name := range []string{"name", "type", "broker_url", "offering", "plan", "instance"} {
+		if value, found := fields[name]; found && value == "" {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
@@ -17,13 +17,15 @@
 package binding
 
 import (
-	"encoding/json"
+	"io"
+
 	"github.com/Peripli/service-manager-cli/internal/cmd"
 	"github.com/Peripli/service-manager-cli/internal/output"
 	"github.com/Peripli/service-manager-cli/pkg/query"
 	"github.com/Peripli/service-manager-cli/pkg/types"
 
 	"fmt"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 )
 
@@ -31,16 +33,20 @@ import (
 type BindCmd struct {
 	*cmd.Context
 
+	input io.Reader
+	fs    afero.Fs
+
 	binding        types.ServiceBinding
 	instanceName   string
-	parametersJSON string
+	parameters     cmd.Parameters
+	skipValidation bool
 
 	outputFormat output.Format
 }
 
-// NewBindCmd returns new bind command with context
-func NewBindCmd(context *cmd.Context) *BindCmd {
-	return &BindCmd{Context: context, binding: types.ServiceBinding{}}
+// NewBindCmd returns new bind command with context, stdin and file system for reading parameters
+func NewBindCmd(context *cmd.Context, input io.Reader, fs afero.Fs) *BindCmd {
+	return &BindCmd{Context: context, input: input, fs: fs, binding: types.ServiceBinding{}}
 }
 
 // Prepare returns cobra command
@@ -55,10 +61,15 @@ func (bc *BindCmd) Prepare(prepare cmd.PrepareFunc) *cobra.Command {
 	}
 
 	result.Flags().StringVarP(&bc.binding.ServiceInstanceID, "id", "", "", "ID of the service instance. Required when name is ambiguous")
-	result.Flags().StringVarP(&bc.parametersJSON, "parameters", "c", "", "Valid JSON object containing binding parameters")
+	cmd.AddParametersFlags(result.Flags(), "parameters", "Binding parameters", &bc.parameters)
+	cmd.AddSkipValidationFlag(result.Flags(), &bc.skipValidation)
 	cmd.AddFormatFlag(result.Flags())
 	cmd.AddCommonQueryFlag(result.Flags(), &bc.Parameters)
 	cmd.AddModeFlag(result.Flags(), "async")
+	cmd.AddWaitFlags(result.Flags(), &bc.Wait)
+	cmd.AddShowCredentialsFlag(result.Flags(), &bc.ShowCredentials)
+	result.ValidArgsFunction = cmd.CompleteArgs(bc.Context, cmd.InstanceNames)
+	cmd.CompleteFlag(result, "id", bc.Context, cmd.InstanceIDs)
 
 	return result
 }
@@ -71,13 +82,20 @@ func (bc *BindCmd) Validate(args []string) error {
 
 	bc.instanceName = args[0]
 	bc.binding.Name = args[1]
+
+	parameters, err := bc.parameters.Load(bc.fs, bc.input)
+	if err != nil {
+		return err
+	}
+	bc.binding.Parameters = parameters
 	return nil
 }
 
 // Run runs the command's logic
 func (bc *BindCmd) Run() error {
+	var instance *types.ServiceInstance
 	if bc.binding.ServiceInstanceID == "" {
-		instanceToBind, err := bc.Client.ListInstances(&query.Parameters{
+		instanceToBind, err := bc.Client.ListInstances(bc.Ctx, &query.Parameters{
 			FieldQuery: []string{
 				fmt.Sprintf("name eq '%s'", bc.instanceName),
 			},
@@ -87,31 +105,53 @@ func (bc *BindCmd) Run() error {
 			return err
 		}
 		if len(instanceToBind.ServiceInstances) < 1 {
-			return fmt.Errorf("service instance with name %s not found", bc.instanceName)
+			return cmd.NewError(cmd.ErrorKindNotFound, "service instance with name %s not found", bc.instanceName)
 		}
 		if len(instanceToBind.ServiceInstances) > 1 {
 			return fmt.Errorf("more than one service instance with name %s found. Use --id flag to specify id of the instance to bind", bc.instanceName)
 		}
-		bc.binding.ServiceInstanceID = instanceToBind.ServiceInstances[0].ID
+		instance = &instanceToBind.ServiceInstances[0]
+		bc.binding.ServiceInstanceID = instance.ID
 	}
 
-	bc.binding.Parameters = json.RawMessage(bc.parametersJSON)
-	resultBinding, location, err := bc.Client.Bind(&bc.binding, &bc.Parameters)
+	if len(bc.binding.Parameters) > 0 && !bc.skipValidation {
+		if err := bc.validateParameters(instance); err != nil {
+			return err
+		}
+	}
+
+	resultBinding, location, err := bc.Client.Bind(bc.Ctx, &bc.binding, &bc.Parameters)
 	if err != nil {
 		return err
 	}
 
 	if len(location) != 0 {
-		cmd.CommonHandleAsyncExecution(bc.Context, location, fmt.Sprintf("Service Binding %s successfully scheduled. To see status of the operation use:\n", bc.binding.Name))
-		return nil
+		return cmd.CommonHandleAsyncExecution(bc.Context, location, fmt.Sprintf("Service Binding %s successfully scheduled. To see status of the operation use:\n", bc.binding.Name), bc.outputFormat)
 	}
 
 	resultBinding.ServiceInstanceName = bc.instanceName
-	output.PrintServiceManagerObject(bc.Output, bc.outputFormat, resultBinding)
+	if err := output.PrintServiceManagerObject(bc.Output, bc.outputFormat, cmd.Printable(bc.Context, resultBinding)); err != nil {
+		return err
+	}
 	output.Println(bc.Output)
 	return nil
 }
 
+func (bc *BindCmd) validateParameters(instance *types.ServiceInstance) error {
+	if instance == nil {
+		var err error
+		instance, err = bc.Client.GetInstanceByID(bc.Ctx, bc.binding.ServiceInstanceID, &bc.Parameters)
+		if err != nil {
+			return err
+		}
+	}
+	plan, err := bc.Client.GetPlanByID(bc.Ctx, instance.ServicePlanID, &bc.Parameters)
+	if err != nil {
+		return err
+	}
+	return cmd.ValidateParameters(plan, cmd.BindingCreateSchema, bc.binding.Parameters)
+}
+
 // SetOutputFormat set output format
 func (bc *BindCmd) SetOutputFormat(format output.Format) {
 	bc.outputFormat = format
@@ -2,12 +2,12 @@ package binding
 
 import (
 	"encoding/json"
-	"github.com/Peripli/service-manager/pkg/util"
+	"github.com/Peripli/service-manager-cli/pkg/smclient"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
-	"gopkg.in/yaml.v3"
 	"io/ioutil"
 	"net/http"
+	"strings"
 
 	"bytes"
 	"errors"
@@ -16,6 +16,7 @@ import (
 	"github.com/Peripli/service-manager-cli/pkg/smclient/smclientfakes"
 	"github.com/Peripli/service-manager-cli/pkg/types"
 
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 )
 
@@ -31,7 +32,7 @@ var _ = Describe("Bind Command test", func() {
 		buffer = &bytes.Buffer{}
 		client = &smclientfakes.FakeClient{}
 		context := &cmd.Context{Output: buffer, Client: client}
-		command = NewBindCmd(context)
+		command = NewBindCmd(context, &bytes.Buffer{}, afero.NewMemMapFs())
 	})
 
 	validAsyncBindExecution := func(location string, args ...string) *cobra.Command {
@@ -102,14 +103,53 @@ var _ = Describe("Bind Command test", func() {
 			})
 		})
 
+		Context("With credentials in the response", func() {
+			BeforeEach(func() {
+				binding = &types.ServiceBinding{
+					ID:          "binding-id",
+					Name:        "binding-name",
+					Credentials: json.RawMessage(`{"password":"secret","endpoints":[{"uri":"postgres://host"}]}`),
+				}
+				client.ListInstancesReturns(&types.ServiceInstances{ServiceInstances: []types.ServiceInstance{{ID: "instance-id"}}}, nil)
+				client.BindReturns(binding, "", nil)
+			})
+
+			It("should mask the credential values", func() {
+				err := invalidBindCommandExecution("instance-name", "binding-name", "--mode", "sync")
+
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(buffer.String()).To(ContainSubstring(`{"endpoints":[{"uri":"[REDACTED]"}],"password":"[REDACTED]"}`))
+				Expect(buffer.String()).NotTo(ContainSubstring("secret"))
+			})
+
+			It("should mask the credential values of the binding created asynchronously", func() {
+				client.BindReturns(binding, "location", nil)
+				client.StatusReturns(&types.Operation{State: "succeeded", Type: "create", ResourceType: "/v1/service_bindings", ResourceID: "binding-id"}, nil)
+				client.GetBindingByIDReturns(binding, nil)
+				err := invalidBindCommandExecution("instance-name", "binding-name", "--wait", "--output", "yaml")
+
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(buffer.String()).To(ContainSubstring("password: '[REDACTED]'"))
+				Expect(buffer.String()).NotTo(ContainSubstring("secret"))
+			})
+
+			It("should print the credentials with show credentials flag", func() {
+				err := invalidBindCommandExecution("instance-name", "binding-name", "--mode", "sync", "--show-credentials")
+
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(buffer.String()).To(ContainSubstring(binding.TableData().String()))
+				Expect(buffer.String()).To(ContainSubstring("secret"))
+			})
+		})
+
 		Context("With yaml output flag", func() {
 			It("should be printed in yaml output format", func() {
 				validSyncBindExecution("instance-name", "binding-name", "--output", "yaml")
 
-				yamlByte, _ := yaml.Marshal(binding)
-				yamlOutputExpected := string(yamlByte) + "\n"
+				// the yaml output carries the same fields as the json output
+				jsonByte, _ := json.Marshal(binding)
 
-				Expect(buffer.String()).To(Equal(yamlOutputExpected))
+				Expect(buffer.String()).To(MatchYAML(string(jsonByte)))
 			})
 		})
 
@@ -117,7 +157,7 @@ var _ = Describe("Bind Command test", func() {
 			It("should pass it to SM", func() {
 				validSyncBindExecution("instance-name", "binding-name", "--param", "paramKey=paramValue")
 
-				_, args := client.BindArgsForCall(0)
+				_, _, args := client.BindArgsForCall(0)
 
 				Expect(args.GeneralParams).To(ConsistOf("paramKey=paramValue", "async=false"))
 				Expect(args.FieldQuery).To(BeEmpty())
@@ -129,7 +169,7 @@ var _ = Describe("Bind Command test", func() {
 			It("should pass it to SM", func() {
 				validSyncBindExecution("instance-name", "binding-name")
 
-				_, args := client.BindArgsForCall(0)
+				_, _, args := client.BindArgsForCall(0)
 
 				Expect(args.GeneralParams).To(ConsistOf("async=false"))
 				Expect(args.FieldQuery).To(BeEmpty())
@@ -200,7 +240,7 @@ var _ = Describe("Bind Command test", func() {
 		Context("With http response error from http client", func() {
 			It("should return error's description", func() {
 				body := ioutil.NopCloser(bytes.NewReader([]byte("HTTP response error")))
-				expectedError := util.HandleResponseError(&http.Response{Body: body})
+				expectedError := smclient.NewAPIError(&http.Response{Body: body})
 				client.BindReturns(nil, "", expectedError)
 
 				err := invalidBindCommandExecution("instance-name", "binding-name")
@@ -220,4 +260,79 @@ var _ = Describe("Bind Command test", func() {
 			})
 		})
 	})
+	Describe("Parameters validation", func() {
+		BeforeEach(func() {
+			client.ListInstancesReturns(&types.ServiceInstances{ServiceInstances: []types.ServiceInstance{{ID: "instance-id", Name: "instance-name", ServicePlanID: "plan-id"}}}, nil)
+			client.GetInstanceByIDReturns(&types.ServiceInstance{ID: "instance-id", ServicePlanID: "plan-id"}, nil)
+			client.GetPlanByIDReturns(&types.ServicePlan{ID: "plan-id", Name: "small", Schemas: json.RawMessage(`{
+				"service_binding": {"create": {"parameters": {
+					"type": "object",
+					"properties": {"role": {"type": "string", "enum": ["reader", "writer"]}},
+					"required": ["role"]
+				}}}}`)}, nil)
+			client.BindReturns(&types.ServiceBinding{ID: "binding-id", Name: "binding-name"}, "", nil)
+		})
+
+		It("should bind with parameters matching the plan schema", func() {
+			err := invalidBindCommandExecution("instance-name", "binding-name", "-c", `{"role":"reader"}`)
+
+			Expect(err).ShouldNot(HaveOccurred())
+			_, planID, _ := client.GetPlanByIDArgsForCall(0)
+			Expect(planID).To(Equal("plan-id"))
+			_, bindingArg, _ := client.BindArgsForCall(0)
+			Expect(string(bindingArg.Parameters)).To(Equal(`{"role":"reader"}`))
+		})
+
+		It("should resolve the plan of the instance given with id", func() {
+			err := invalidBindCommandExecution("instance-name", "binding-name", "--id", "instance-id", "-c", `{"role":"reader"}`)
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(client.ListInstancesCallCount()).To(Equal(0))
+			_, instanceID, _ := client.GetInstanceByIDArgsForCall(0)
+			Expect(instanceID).To(Equal("instance-id"))
+		})
+
+		It("should report the violations without binding", func() {
+			err := invalidBindCommandExecution("instance-name", "binding-name", "-c", `{"role":"admin"}`)
+
+			Expect(err).Should(HaveOccurred())
+			Expect(cmd.KindOf(err)).To(Equal(cmd.ErrorKindValidation))
+			Expect(err.Error()).To(ContainSubstring("parameters do not match the service_binding.create schema of plan small"))
+			Expect(err.Error()).To(ContainSubstring("$.role: "))
+			Expect(client.BindCallCount()).To(Equal(0))
+		})
+
+		It("should not validate without parameters", func() {
+			err := invalidBindCommandExecution("instance-name", "binding-name")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(client.GetPlanByIDCallCount()).To(Equal(0))
+		})
+
+		It("should bind without validation with skip validation flag", func() {
+			err := invalidBindCommandExecution("instance-name", "binding-name", "-c", `{"role":"admin"}`, "--skip-validation")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(client.GetPlanByIDCallCount()).To(Equal(0))
+			Expect(client.BindCallCount()).To(Equal(1))
+		})
+
+		It("should validate the parameters read from stdin", func() {
+			command = NewBindCmd(&cmd.Context{Output: buffer, Client: client}, strings.NewReader("role: writer\n"), afero.NewMemMapFs())
+
+			err := invalidBindCommandExecution("instance-name", "binding-name", "-c", "@-")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			_, bindingArg, _ := client.BindArgsForCall(0)
+			Expect(string(bindingArg.Parameters)).To(Equal(`{"role":"writer"}`))
+		})
+
+		It("should reject parameters which are not a JSON object", func() {
+			err := invalidBindCommandExecution("instance-name", "binding-name", "-c", `["role"]`, "--skip-validation")
+
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("parameters must be a JSON or YAML object"))
+			Expect(client.BindCallCount()).To(Equal(0))
+		})
+	})
 })
@@ -0,0 +1,296 @@
+/*
+ * Copyright 2018 The Service Manager Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package binding
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf16"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/Peripli/service-manager-cli/pkg/types"
+)
+
+const (
+	credentialsFormatDotenv     = "dotenv"
+	credentialsFormatVCAP       = "vcap"
+	credentialsFormatSecret     = "k8s-secret"
+	credentialsFormatProperties = "properties"
+)
+
+var credentialsFormats = []string{credentialsFormatDotenv, credentialsFormatVCAP, credentialsFormatSecret, credentialsFormatProperties}
+
+var (
+	invalidEnvCharacters    = regexp.MustCompile(`[^A-Z0-9_]`)
+	invalidSecretCharacters = regexp.MustCompile(`[^-._a-zA-Z0-9]`)
+)
+
+// vcapService holds a binding together with the resources describing it in VCAP_SERVICES
+type vcapService struct {
+	binding  *types.ServiceBinding
+	instance *types.ServiceInstance
+	plan     *types.ServicePlan
+	offering *types.ServiceOffering
+}
+
+type vcapEntry struct {
+	BindingGUID    string          `json:"binding_guid"`
+	BindingName    string          `json:"binding_name"`
+	Credentials    json.RawMessage `json:"credentials"`
+	InstanceGUID   string          `json:"instance_guid"`
+	InstanceName   string          `json:"instance_name"`
+	Label          string          `json:"label"`
+	Name           string          `json:"name"`
+	Plan           string          `json:"plan"`
+	Provider       *string         `json:"provider"`
+	SyslogDrainURL *string         `json:"syslog_drain_url"`
+	Tags           json.RawMessage `json:"tags"`
+	VolumeMounts   json.RawMessage `json:"volume_mounts"`
+}
+
+type secret struct {
+	APIVersion string            `yaml:"apiVersion"`
+	Kind       string            `yaml:"kind"`
+	Metadata   secretMetadata    `yaml:"metadata"`
+	Type       string            `yaml:"type"`
+	Data       map[string]string `yaml:"data"`
+}
+
+type secretMetadata struct {
+	Name string `yaml:"name"`
+}
+
+// formatDotenv renders the credentials as KEY="value" lines.
+// Nested values are flattened and the keys are prefixed with the binding name when several bindings are exported.
+func formatDotenv(bindings []*types.ServiceBinding) ([]byte, error) {
+	var buf bytes.Buffer
+	err := flattenCredentials(bindings, func(binding *types.ServiceBinding, path []string, value string) {
+		key := envVariableName(strings.Join(qualifiedPath(bindings, binding, path), "_"))
+		fmt.Fprintf(&buf, "%s=\"%s\"\n", key, escapeDotenv(value))
+	})
+	return buf.Bytes(), err
+}
+
+// formatProperties renders the credentials as a Java properties file with dot separated keys
+func formatProperties(bindings []*types.ServiceBinding) ([]byte, error) {
+	var buf bytes.Buffer
+	err := flattenCredentials(bindings, func(binding *types.ServiceBinding, path []string, value string) {
+		key := strings.Join(qualifiedPath(bindings, binding, path), ".")
+		fmt.Fprintf(&buf, "%s=%s\n", escapeProperty(key, true), escapeProperty(value, false))
+	})
+	return buf.Bytes(), err
+}
+
+// formatSecret renders the credentials as a Kubernetes Secret manifest.
+// Every top-level credential becomes a key of the secret, nested values are stored as JSON.
+func formatSecret(name string, bindings []*types.ServiceBinding) ([]byte, error) {
+	result := secret{
+		APIVersion: "v1",
+		Kind:       "Secret",
+		Metadata:   secretMetadata{Name: name},
+		Type:       "Opaque",
+		Data:       map[string]string{},
+	}
+	for _, binding := range bindings {
+		credentials, err := decodeCredentials(binding)
+		if err != nil {
+			return nil, err
+		}
+		for key, value := range credentials {
+			if len(bindings) > 1 {
+				key = binding.Name + "." + key
+			}
+			key = invalidSecretCharacters.ReplaceAllString(key, "_")
+			encoded, err := secretValue(value)
+			if err != nil {
+				return nil, err
+			}
+			result.Data[key] = base64.StdEncoding.EncodeToString([]byte(encoded))
+		}
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(result); err != nil {
+		return nil, err
+	}
+	err := encoder.Close()
+	return buf.Bytes(), err
+}
+
+// formatVCAPServices renders the credentials in the format of the VCAP_SERVICES variable of Cloud Foundry,
+// which groups the bindings by the name of their service offering
+func formatVCAPServices(services []vcapService) ([]byte, error) {
+	result := map[string][]vcapEntry{}
+	for _, service := range services {
+		name := service.binding.Name
+		if name == "" {
+			name = service.instance.Name
+		}
+		entry := vcapEntry{
+			BindingGUID:  service.binding.ID,
+			BindingName:  service.binding.Name,
+			Credentials:  rawOrDefault(service.binding.Credentials, "{}"),
+			InstanceGUID: service.instance.ID,
+			InstanceName: service.instance.Name,
+			Label:        service.offering.Name,
+			Name:         name,
+			Plan:         service.plan.Name,
+			Tags:         rawOrDefault(service.offering.Tags, "[]"),
+			VolumeMounts: rawOrDefault(service.binding.VolumeMounts, "[]"),
+		}
+		if service.binding.SyslogDrainURL != "" {
+			entry.SyslogDrainURL = &service.binding.SyslogDrainURL
+		}
+		result[service.offering.Name] = append(result[service.offering.Name], entry)
+	}
+
+	content, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(content, '\n'), nil
+}
+
+// flattenCredentials calls emit for every leaf value of the credentials of the bindings in key order
+func flattenCredentials(bindings []*types.ServiceBinding, emit func(binding *types.ServiceBinding, path []string, value string)) error {
+	for _, binding := range bindings {
+		credentials, err := decodeCredentials(binding)
+		if err != nil {
+			return err
+		}
+		flatten(nil, credentials, func(path []string, value string) {
+			emit(binding, path, value)
+		})
+	}
+	return nil
+}
+
+// qualifiedPath prefixes the path with the binding name when the credentials of several bindings are combined
+func qualifiedPath(bindings []*types.ServiceBinding, binding *types.ServiceBinding, path []string) []string {
+	if len(bindings) > 1 {
+		return append([]string{binding.Name}, path...)
+	}
+	return path
+}
+
+// envVariableName upper-cases the name and replaces the characters which are not allowed in environment variables
+func envVariableName(name string) string {
+	name = invalidEnvCharacters.ReplaceAllString(strings.ToUpper(name), "_")
+	if name != "" && name[0] >= '0' && name[0] <= '9' {
+		name = "_" + name
+	}
+	return name
+}
+
+func flatten(path []string, value interface{}, emit func(path []string, value string)) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			flatten(append(path[:len(path):len(path)], key), v[key], emit)
+		}
+	case []interface{}:
+		for i, item := range v {
+			flatten(append(path[:len(path):len(path)], fmt.Sprint(i)), item, emit)
+		}
+	case nil:
+		emit(path, "")
+	default:
+		emit(path, fmt.Sprint(v))
+	}
+}
+
+// decodeCredentials decodes the credentials of the binding keeping numbers as they are
+func decodeCredentials(binding *types.ServiceBinding) (map[string]interface{}, error) {
+	credentials := map[string]interface{}{}
+	if len(binding.Credentials) == 0 {
+		return credentials, nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(binding.Credentials))
+	decoder.UseNumber()
+	if err := decoder.Decode(&credentials); err != nil {
+		return nil, fmt.Errorf("credentials of service binding %s are not a JSON object: %s", binding.Name, err)
+	}
+	return credentials, nil
+}
+
+func secretValue(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case json.Number:
+		return v.String(), nil
+	default:
+		encoded, err := json.Marshal(v)
+		return string(encoded), err
+	}
+}
+
+func rawOrDefault(raw json.RawMessage, defaultValue string) json.RawMessage {
+	if len(raw) == 0 {
+		return json.RawMessage(defaultValue)
+	}
+	return raw
+}
+
+func escapeDotenv(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "$", `\$`).Replace(value)
+}
+
+// escapeProperty escapes a key or a value of a Java properties file.
+// Control and non-ASCII characters are written as unicode escapes.
+func escapeProperty(value string, isKey bool) string {
+	var buf strings.Builder
+	for i, r := range value {
+		switch {
+		case r == '\\':
+			buf.WriteString(`\\`)
+		case r == '\n':
+			buf.WriteString(`\n`)
+		case r == '\r':
+			buf.WriteString(`\r`)
+		case r == '\t':
+			buf.WriteString(`\t`)
+		case r == '\f':
+			buf.WriteString(`\f`)
+		case r == ' ' && (isKey || i == 0):
+			buf.WriteString(`\ `)
+		case isKey && strings.ContainsRune("=:#!", r):
+			buf.WriteRune('\\')
+			buf.WriteRune(r)
+		case r < 0x20 || r > 0x7e:
+			for _, unit := range utf16.Encode([]rune{r}) {
+				fmt.Fprintf(&buf, `\u%04x`, unit)
+			}
+		default:
+			buf.WriteRune(r)
+		}
+	}
+	return buf.String()
+}
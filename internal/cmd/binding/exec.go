@@ -0,0 +1,237 @@
+/*
+ * Copyright 2018 The Service Manager Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package binding
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"os/signal"
+	"strings"
+	"syscall"
+	"text/template"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Peripli/service-manager-cli/internal/cmd"
+	"github.com/Peripli/service-manager-cli/pkg/types"
+)
+
+// forwardedSignals are passed on to the child process instead of terminating smctl
+var forwardedSignals = []os.Signal{os.Interrupt, syscall.SIGTERM, syscall.SIGHUP, syscall.SIGQUIT}
+
+// ExecCmd wraps the smctl exec command
+type ExecCmd struct {
+	*cmd.Context
+	Input  io.Reader
+	Errors io.Writer
+
+	bindingNames []string
+	prefix       string
+	nameTemplate string
+	vcapServices bool
+	command      []string
+
+	template *template.Template
+}
+
+// variableName holds the values available in the --name-template of the exec command
+type variableName struct {
+	Binding string
+	Key     string
+}
+
+// NewExecCmd returns new exec command with context
+func NewExecCmd(context *cmd.Context, input io.Reader, errors io.Writer) *ExecCmd {
+	return &ExecCmd{Context: context, Input: input, Errors: errors}
+}
+
+// Prepare returns cobra command
+func (ec *ExecCmd) Prepare(prepare cmd.PrepareFunc) *cobra.Command {
+	result := &cobra.Command{
+		Use:   "exec --binding [name|id] -- [command] [args]...",
+		Short: "Runs a command with binding credentials in its environment",
+		Long: `Runs a command with the credentials of service bindings as environment variables.
+Nested credentials are flattened, e.g. TLS_CA, and upper-cased. When several bindings are used, the variables are prefixed with the binding name.
+The credentials are never written to disk. Signals are forwarded to the command and smctl exits with its exit code.`,
+		Example: `smctl exec --binding my-binding -- ./my-app
+smctl exec -b db -b cache --prefix APP_ --vcap-services -- npm start
+smctl exec -b db --name-template 'DATABASE_{{.Key}}' -- ./my-app`,
+
+		PreRunE: prepare(ec, ec.Context),
+		RunE:    cmd.RunE(ec),
+	}
+
+	// flags after the command belong to the command
+	result.Flags().SetInterspersed(false)
+	result.Flags().StringArrayVarP(&ec.bindingNames, "binding", "b", nil, "Name or ID of a binding whose credentials are passed to the command. Can be used multiple times")
+	result.Flags().StringVarP(&ec.prefix, "prefix", "", "", "Prefix of the environment variable names")
+	result.Flags().StringVarP(&ec.nameTemplate, "name-template", "", "", "Go template for the environment variable names with the fields .Binding and .Key")
+	result.Flags().BoolVarP(&ec.vcapServices, "vcap-services", "", false, "Also pass the credentials as a Cloud Foundry-style VCAP_SERVICES variable")
+	cmd.AddCommonQueryFlag(result.Flags(), &ec.Parameters)
+	cmd.CompleteFlag(result, "binding", ec.Context, cmd.BindingNames)
+
+	return result
+}
+
+// Validate validates command's arguments
+func (ec *ExecCmd) Validate(args []string) error {
+	if len(ec.bindingNames) == 0 {
+		return fmt.Errorf("at least one --binding is required")
+	}
+	if len(args) == 0 {
+		return fmt.Errorf("command to run is required")
+	}
+	if ec.nameTemplate != "" {
+		tmpl, err := template.New("name").Option("missingkey=error").Parse(ec.nameTemplate)
+		if err != nil {
+			return fmt.Errorf("invalid name template: %s", err)
+		}
+		ec.template = tmpl
+	}
+
+	ec.command = args
+	return nil
+}
+
+// HideUsage hide command's usage
+func (ec *ExecCmd) HideUsage() bool {
+	return true
+}
+
+// Run runs the command's logic
+func (ec *ExecCmd) Run() error {
+	bindings := make([]*types.ServiceBinding, 0, len(ec.bindingNames))
+	for _, name := range ec.bindingNames {
+		binding, err := getBinding(ec.Context, name)
+		if err != nil {
+			return err
+		}
+		bindings = append(bindings, binding)
+	}
+
+	env, err := ec.environment(bindings)
+	if err != nil {
+		return err
+	}
+	return ec.run(env)
+}
+
+// environment returns the environment variables with the credentials of the bindings
+func (ec *ExecCmd) environment(bindings []*types.ServiceBinding) ([]string, error) {
+	var env []string
+	sources := map[string]string{}
+	var nameErr error
+	err := flattenCredentials(bindings, func(binding *types.ServiceBinding, path []string, value string) {
+		name, err := ec.variableName(bindings, binding, path)
+		if err != nil {
+			nameErr = err
+			return
+		}
+		if source, exists := sources[name]; exists && nameErr == nil {
+			nameErr = fmt.Errorf("credentials of service bindings %s and %s are both passed as %s. Use --name-template to make the names unique", source, binding.Name, name)
+		}
+		sources[name] = binding.Name
+		env = append(env, name+"="+value)
+	})
+	if err == nil {
+		err = nameErr
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if ec.vcapServices {
+		services, err := resolveVCAPServices(ec.Context, bindings)
+		if err != nil {
+			return nil, err
+		}
+		content, err := formatVCAPServices(services)
+		if err != nil {
+			return nil, err
+		}
+		var compact bytes.Buffer
+		if err := json.Compact(&compact, content); err != nil {
+			return nil, err
+		}
+		env = append(env, "VCAP_SERVICES="+compact.String())
+	}
+	return env, nil
+}
+
+func (ec *ExecCmd) variableName(bindings []*types.ServiceBinding, binding *types.ServiceBinding, path []string) (string, error) {
+	name := strings.Join(qualifiedPath(bindings, binding, path), "_")
+	if ec.template != nil {
+		var buf bytes.Buffer
+		if err := ec.template.Execute(&buf, variableName{Binding: binding.Name, Key: strings.Join(path, "_")}); err != nil {
+			return "", fmt.Errorf("could not execute name template: %s", err)
+		}
+		name = buf.String()
+	}
+	return envVariableName(ec.prefix + name), nil
+}
+
+// run runs the command with the additional environment variables.
+// It forwards signals to the command and returns its exit code as *cmd.ExitStatusError.
+func (ec *ExecCmd) run(env []string) error {
+	child := exec.Command(ec.command[0], ec.command[1:]...)
+	child.Env = append(os.Environ(), env...)
+	child.Stdin = ec.Input
+	child.Stdout = ec.Output
+	child.Stderr = ec.Errors
+
+	// registering the signals before starting the command keeps smctl alive until the command exits
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, forwardedSignals...)
+	defer signal.Stop(signals)
+
+	if err := child.Start(); err != nil {
+		return fmt.Errorf("could not run %s: %s", ec.command[0], err)
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			select {
+			case sig := <-signals:
+				_ = child.Process.Signal(sig)
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	err := child.Wait()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return &cmd.ExitStatusError{Code: exitCode(exitErr.ProcessState)}
+	}
+	return err
+}
+
+// exitCode returns the exit code of the process, or 128 plus the signal number like shells do if it was killed by a signal
+func exitCode(state *os.ProcessState) int {
+	if status, ok := state.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+		return 128 + int(status.Signal())
+	}
+	return state.ExitCode()
+}
@@ -0,0 +1,178 @@
+//go:build !windows
+
+package binding
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/Peripli/service-manager-cli/internal/cmd"
+	"github.com/Peripli/service-manager-cli/pkg/smclient/smclientfakes"
+	"github.com/Peripli/service-manager-cli/pkg/types"
+)
+
+// syncBuffer is a bytes.Buffer which can be written by a child process while the test reads it
+type syncBuffer struct {
+	mutex  sync.Mutex
+	buffer bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.buffer.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.buffer.String()
+}
+
+var _ = Describe("Exec command test", func() {
+	var client *smclientfakes.FakeClient
+	var command *ExecCmd
+	var buffer *syncBuffer
+	var errBuffer *bytes.Buffer
+
+	dbBinding := types.ServiceBinding{
+		ID:                "db-binding-id",
+		Name:              "db",
+		ServiceInstanceID: "db-instance-id",
+		Credentials:       json.RawMessage(`{"uri":"postgres://host","tls":{"ca":"line1\nline2"}}`),
+	}
+	cacheBinding := types.ServiceBinding{
+		ID:                "cache-binding-id",
+		Name:              "cache",
+		ServiceInstanceID: "cache-instance-id",
+		Credentials:       json.RawMessage(`{"uri":"redis://host"}`),
+	}
+
+	BeforeEach(func() {
+		buffer = &syncBuffer{}
+		errBuffer = &bytes.Buffer{}
+		client = &smclientfakes.FakeClient{}
+		client.ListBindingsReturnsOnCall(0, &types.ServiceBindings{ServiceBindings: []types.ServiceBinding{dbBinding}}, nil)
+		client.ListBindingsReturnsOnCall(1, &types.ServiceBindings{ServiceBindings: []types.ServiceBinding{cacheBinding}}, nil)
+		client.GetBindingByIDReturnsOnCall(0, &dbBinding, nil)
+		client.GetBindingByIDReturnsOnCall(1, &cacheBinding, nil)
+		context := &cmd.Context{Output: buffer, Client: client}
+		command = NewExecCmd(context, strings.NewReader("input"), errBuffer)
+	})
+
+	executeWithArgs := func(args ...string) error {
+		commandToRun := command.Prepare(cmd.SmPrepare)
+		commandToRun.SetArgs(args)
+
+		return commandToRun.Execute()
+	}
+
+	Describe("Validation", func() {
+		It("should require a binding", func() {
+			Expect(executeWithArgs("--", "true")).To(MatchError("at least one --binding is required"))
+		})
+
+		It("should require a command", func() {
+			Expect(executeWithArgs("-b", "db")).To(MatchError("command to run is required"))
+		})
+
+		It("should reject invalid name templates", func() {
+			Expect(executeWithArgs("-b", "db", "--name-template", "{{.Key", "--", "true")).To(MatchError(ContainSubstring("invalid name template")))
+		})
+	})
+
+	Describe("Environment", func() {
+		It("should pass the flattened credentials", func() {
+			err := executeWithArgs("-b", "db", "--", "sh", "-c", `printf '%s|%s' "$URI" "$TLS_CA"`)
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buffer.String()).To(Equal("postgres://host|line1\nline2"))
+			_, id, _ := client.GetBindingByIDArgsForCall(0)
+			Expect(id).To(Equal("db-binding-id"))
+		})
+
+		It("should prefix the variables with the binding names when several bindings are used", func() {
+			err := executeWithArgs("-b", "db", "-b", "cache", "--prefix", "app_", "--", "sh", "-c", `printf '%s|%s' "$APP_DB_URI" "$APP_CACHE_URI"`)
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buffer.String()).To(Equal("postgres://host|redis://host"))
+		})
+
+		It("should name the variables with the name template", func() {
+			err := executeWithArgs("-b", "db", "--name-template", "{{.Binding}}-secret-{{.Key}}", "--", "sh", "-c", `printf '%s' "$DB_SECRET_URI"`)
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buffer.String()).To(Equal("postgres://host"))
+		})
+
+		It("should fail when several credentials get the same name", func() {
+			err := executeWithArgs("-b", "db", "-b", "cache", "--name-template", "{{.Key}}", "--", "true")
+
+			Expect(err).To(MatchError(ContainSubstring("credentials of service bindings db and cache are both passed as URI")))
+		})
+
+		It("should pass VCAP_SERVICES", func() {
+			client.GetInstanceByIDReturns(&types.ServiceInstance{ID: "db-instance-id", Name: "db-instance", ServicePlanID: "plan-id"}, nil)
+			client.GetPlanByIDReturns(&types.ServicePlan{ID: "plan-id", Name: "standard", ServiceOfferingID: "offering-id"}, nil)
+			client.GetOfferingByIDReturns(&types.ServiceOffering{ID: "offering-id", Name: "postgres"}, nil)
+			err := executeWithArgs("-b", "db", "--vcap-services", "--", "sh", "-c", `printf '%s' "$VCAP_SERVICES"`)
+
+			Expect(err).ShouldNot(HaveOccurred())
+			var vcap map[string][]map[string]interface{}
+			Expect(json.Unmarshal([]byte(buffer.String()), &vcap)).To(Succeed())
+			Expect(vcap["postgres"][0]).To(HaveKeyWithValue("plan", "standard"))
+			Expect(vcap["postgres"][0]["credentials"]).To(HaveKeyWithValue("uri", "postgres://host"))
+		})
+
+		It("should pass the standard streams", func() {
+			err := executeWithArgs("-b", "db", "--", "sh", "-c", `cat; echo error >&2`)
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buffer.String()).To(Equal("input"))
+			Expect(errBuffer.String()).To(Equal("error\n"))
+		})
+	})
+
+	Describe("Exit code", func() {
+		It("should return the exit code of the command", func() {
+			err := executeWithArgs("-b", "db", "--", "sh", "-c", "exit 3")
+
+			Expect(err).To(Equal(&cmd.ExitStatusError{Code: 3}))
+		})
+
+		It("should return 128 plus the signal number if the command is killed", func() {
+			err := executeWithArgs("-b", "db", "--", "sh", "-c", "kill -TERM $$")
+
+			Expect(err).To(Equal(&cmd.ExitStatusError{Code: 128 + int(syscall.SIGTERM)}))
+		})
+
+		It("should fail when the command cannot be started", func() {
+			err := executeWithArgs("-b", "db", "--", "/nonexistent/command")
+
+			Expect(err).To(MatchError(ContainSubstring("could not run /nonexistent/command")))
+		})
+	})
+
+	Describe("Signals", func() {
+		It("should forward them to the command", func() {
+			errs := make(chan error, 1)
+			go func() {
+				defer GinkgoRecover()
+				errs <- executeWithArgs("-b", "db", "--", "sh", "-c", `trap 'echo hangup; exit 5' HUP; echo ready; while true; do sleep 0.1; done`)
+			}()
+			Eventually(buffer.String, 5*time.Second).Should(Equal("ready\n"))
+
+			Expect(syscall.Kill(syscall.Getpid(), syscall.SIGHUP)).To(Succeed())
+
+			Eventually(errs, 5*time.Second).Should(Receive(Equal(&cmd.ExitStatusError{Code: 5})))
+			Expect(buffer.String()).To(Equal("ready\nhangup\n"))
+		})
+	})
+})
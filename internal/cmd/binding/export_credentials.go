@@ -0,0 +1,216 @@
+/*
+ * Copyright 2018 The Service Manager Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package binding
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+
+	"github.com/Peripli/service-manager-cli/internal/cmd"
+	"github.com/Peripli/service-manager-cli/internal/output"
+	"github.com/Peripli/service-manager-cli/pkg/query"
+	"github.com/Peripli/service-manager-cli/pkg/smclient"
+	"github.com/Peripli/service-manager-cli/pkg/types"
+)
+
+// ExportCredentialsCmd wraps the smctl export-credentials command
+type ExportCredentialsCmd struct {
+	*cmd.Context
+	Fs afero.Fs
+
+	bindingNames []string
+	format       string
+	file         string
+	secretName   string
+}
+
+// NewExportCredentialsCmd returns new export-credentials command with context
+func NewExportCredentialsCmd(context *cmd.Context, fs afero.Fs) *ExportCredentialsCmd {
+	return &ExportCredentialsCmd{Context: context, Fs: fs}
+}
+
+// Prepare returns cobra command
+func (ec *ExportCredentialsCmd) Prepare(prepare cmd.PrepareFunc) *cobra.Command {
+	result := &cobra.Command{
+		Use:   "export-credentials [name|id]...",
+		Short: "Exports binding credentials",
+		Long: `Exports the credentials of one or more service bindings as a dotenv file, a VCAP_SERVICES JSON, a Kubernetes Secret manifest or a Java properties file.
+The credentials of all bindings are combined into one output.`,
+		Example: `smctl export-credentials my-binding --format dotenv --file .env
+smctl export-credentials db-binding cache-binding --format k8s-secret --secret-name my-app`,
+
+		PreRunE: prepare(ec, ec.Context),
+		RunE:    cmd.RunE(ec),
+	}
+
+	result.Flags().StringVarP(&ec.format, "format", "", credentialsFormatDotenv, "Format of the exported credentials: "+strings.Join(credentialsFormats, ", "))
+	result.Flags().StringVarP(&ec.file, "file", "f", "", "Write the credentials to this file with 0600 permissions instead of printing them")
+	result.Flags().StringVarP(&ec.secretName, "secret-name", "", "", "Name of the Kubernetes Secret. Defaults to the binding name")
+	cmd.AddCommonQueryFlag(result.Flags(), &ec.Parameters)
+	result.ValidArgsFunction = cmd.CompleteEachArg(ec.Context, cmd.BindingNames)
+
+	return result
+}
+
+// Validate validates command's arguments
+func (ec *ExportCredentialsCmd) Validate(args []string) error {
+	if len(args) < 1 {
+		return fmt.Errorf("binding name or id is required")
+	}
+	if !contains(credentialsFormats, ec.format) {
+		return fmt.Errorf("unsupported format %q, supported formats are %s", ec.format, strings.Join(credentialsFormats, ", "))
+	}
+	if ec.format == credentialsFormatSecret && ec.secretName == "" && len(args) > 1 {
+		return fmt.Errorf("--secret-name is required when exporting the credentials of several bindings")
+	}
+
+	ec.bindingNames = args
+	return nil
+}
+
+// HideUsage hide command's usage
+func (ec *ExportCredentialsCmd) HideUsage() bool {
+	return true
+}
+
+// Run runs the command's logic
+func (ec *ExportCredentialsCmd) Run() error {
+	bindings := make([]*types.ServiceBinding, 0, len(ec.bindingNames))
+	for _, name := range ec.bindingNames {
+		binding, err := getBinding(ec.Context, name)
+		if err != nil {
+			return err
+		}
+		bindings = append(bindings, binding)
+	}
+
+	var content []byte
+	var err error
+	switch ec.format {
+	case credentialsFormatVCAP:
+		var services []vcapService
+		if services, err = resolveVCAPServices(ec.Context, bindings); err == nil {
+			content, err = formatVCAPServices(services)
+		}
+	case credentialsFormatSecret:
+		secretName := ec.secretName
+		if secretName == "" {
+			secretName = bindings[0].Name
+		}
+		content, err = formatSecret(secretName, bindings)
+	case credentialsFormatProperties:
+		content, err = formatProperties(bindings)
+	default:
+		content, err = formatDotenv(bindings)
+	}
+	if err != nil {
+		return err
+	}
+
+	if ec.file == "" {
+		output.PrintMessage(ec.Output, "%s", content)
+		return nil
+	}
+	return ec.writeFile(content)
+}
+
+// getBinding returns the binding with the provided name, or with the provided id if there is no binding with this name
+func getBinding(ctx *cmd.Context, nameOrID string) (*types.ServiceBinding, error) {
+	bindings, err := ctx.Client.ListBindings(ctx.Ctx, &query.Parameters{
+		FieldQuery: []string{
+			fmt.Sprintf("name eq '%s'", nameOrID),
+		},
+		GeneralParams: ctx.Parameters.GeneralParams,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(bindings.ServiceBindings) > 1 {
+		return nil, fmt.Errorf("more than one service binding with name %s found. Use the binding id instead", nameOrID)
+	}
+
+	id := nameOrID
+	if len(bindings.ServiceBindings) == 1 {
+		id = bindings.ServiceBindings[0].ID
+	}
+	binding, err := ctx.Client.GetBindingByID(ctx.Ctx, id, &ctx.Parameters)
+	if err != nil {
+		if smclient.IsNotFound(err) {
+			return nil, cmd.NewError(cmd.ErrorKindNotFound, "no binding found with name or id: %s", nameOrID)
+		}
+		return nil, err
+	}
+	return binding, nil
+}
+
+// resolveVCAPServices resolves the instance, plan and offering of every binding
+func resolveVCAPServices(ctx *cmd.Context, bindings []*types.ServiceBinding) ([]vcapService, error) {
+	services := make([]vcapService, 0, len(bindings))
+	for _, binding := range bindings {
+		instance, err := ctx.Client.GetInstanceByID(ctx.Ctx, binding.ServiceInstanceID, &ctx.Parameters)
+		if err != nil {
+			return nil, fmt.Errorf("could not get service instance of binding %s: %s", binding.Name, err)
+		}
+		plan, err := ctx.Client.GetPlanByID(ctx.Ctx, instance.ServicePlanID, &ctx.Parameters)
+		if err != nil {
+			return nil, fmt.Errorf("could not get service plan of binding %s: %s", binding.Name, err)
+		}
+		offering, err := ctx.Client.GetOfferingByID(ctx.Ctx, plan.ServiceOfferingID, &ctx.Parameters)
+		if err != nil {
+			return nil, fmt.Errorf("could not get service offering of binding %s: %s", binding.Name, err)
+		}
+		services = append(services, vcapService{binding: binding, instance: instance, plan: plan, offering: offering})
+	}
+	return services, nil
+}
+
+func (ec *ExportCredentialsCmd) writeFile(content []byte) error {
+	const ownerAccessOnly = 0600
+
+	f, err := ec.Fs.OpenFile(ec.file, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, ownerAccessOnly)
+	if err != nil {
+		return err
+	}
+	// OpenFile keeps the permissions of an existing file
+	if err := ec.Fs.Chmod(ec.file, ownerAccessOnly); err != nil {
+		f.Close()
+		return err
+	}
+	if _, err := f.Write(content); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	output.PrintMessage(ec.Output, "Credentials of %d service binding(s) written to %s\n", len(ec.bindingNames), ec.file)
+	return nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
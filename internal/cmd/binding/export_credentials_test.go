@@ -0,0 +1,223 @@
+package binding
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	"gopkg.in/yaml.v3"
+
+	"github.com/Peripli/service-manager-cli/internal/cmd"
+	"github.com/Peripli/service-manager-cli/pkg/query"
+	"github.com/Peripli/service-manager-cli/pkg/smclient"
+	"github.com/Peripli/service-manager-cli/pkg/smclient/smclientfakes"
+	"github.com/Peripli/service-manager-cli/pkg/types"
+)
+
+var _ = Describe("Export credentials command test", func() {
+	var client *smclientfakes.FakeClient
+	var command *ExportCredentialsCmd
+	var buffer *bytes.Buffer
+	var fs afero.Fs
+
+	dbBinding := &types.ServiceBinding{
+		ID:                "db-binding-id",
+		Name:              "db",
+		ServiceInstanceID: "db-instance-id",
+		Credentials:       json.RawMessage(`{"uri":"postgres://user:p@ss@host:5432/db","port":5432,"tls":{"enabled":true,"ca":"line1\nline2"}}`),
+	}
+	cacheBinding := &types.ServiceBinding{
+		ID:                "cache-binding-id",
+		Name:              "cache",
+		ServiceInstanceID: "cache-instance-id",
+		Credentials:       json.RawMessage(`{"hosts":["h1","h2"],"password":"pa\"ss$"}`),
+	}
+
+	BeforeEach(func() {
+		buffer = &bytes.Buffer{}
+		fs = afero.NewMemMapFs()
+		client = &smclientfakes.FakeClient{}
+		client.ListBindingsStub = func(_ context.Context, q *query.Parameters) (*types.ServiceBindings, error) {
+			for _, binding := range []*types.ServiceBinding{dbBinding, cacheBinding} {
+				if q.FieldQuery[0] == "name eq '"+binding.Name+"'" {
+					return &types.ServiceBindings{ServiceBindings: []types.ServiceBinding{*binding}}, nil
+				}
+			}
+			return &types.ServiceBindings{}, nil
+		}
+		client.GetBindingByIDStub = func(_ context.Context, id string, _ *query.Parameters) (*types.ServiceBinding, error) {
+			for _, binding := range []*types.ServiceBinding{dbBinding, cacheBinding} {
+				if binding.ID == id {
+					return binding, nil
+				}
+			}
+			body := ioutil.NopCloser(bytes.NewReader([]byte("")))
+			return nil, smclient.NewAPIError(&http.Response{Body: body, StatusCode: http.StatusNotFound})
+		}
+		context := &cmd.Context{Output: buffer, Client: client}
+		command = NewExportCredentialsCmd(context, fs)
+	})
+
+	executeWithArgs := func(args ...string) error {
+		commandToRun := command.Prepare(cmd.SmPrepare)
+		commandToRun.SetArgs(args)
+
+		return commandToRun.Execute()
+	}
+
+	Describe("Validation", func() {
+		It("should require a binding", func() {
+			Expect(executeWithArgs()).To(MatchError("binding name or id is required"))
+		})
+
+		It("should reject unsupported formats", func() {
+			Expect(executeWithArgs("db", "--format", "xml")).To(MatchError(ContainSubstring(`unsupported format "xml"`)))
+		})
+
+		It("should require the secret name for several bindings", func() {
+			err := executeWithArgs("db", "cache", "--format", "k8s-secret")
+
+			Expect(err).To(MatchError(ContainSubstring("--secret-name is required")))
+		})
+	})
+
+	Describe("Binding lookup", func() {
+		It("should get the binding by its id if there is no binding with this name", func() {
+			Expect(executeWithArgs("db-binding-id")).To(Succeed())
+
+			Expect(buffer.String()).To(ContainSubstring(`PORT="5432"`))
+		})
+
+		It("should fail when the binding is not found", func() {
+			err := executeWithArgs("unknown")
+
+			Expect(err).To(MatchError("no binding found with name or id: unknown"))
+			Expect(cmd.KindOf(err)).To(Equal(cmd.ErrorKindNotFound))
+		})
+
+		It("should fail when the name is ambiguous", func() {
+			client.ListBindingsStub = nil
+			client.ListBindingsReturns(&types.ServiceBindings{ServiceBindings: []types.ServiceBinding{*dbBinding, *dbBinding}}, nil)
+
+			Expect(executeWithArgs("db")).To(MatchError(ContainSubstring("more than one service binding with name db found")))
+		})
+	})
+
+	Describe("dotenv format", func() {
+		It("should print flattened and escaped variables", func() {
+			Expect(executeWithArgs("db")).To(Succeed())
+
+			Expect(buffer.String()).To(Equal(`PORT="5432"
+TLS_CA="line1\nline2"
+TLS_ENABLED="true"
+URI="postgres://user:p@ss@host:5432/db"
+`))
+		})
+
+		It("should prefix the variables with the binding names when combining bindings", func() {
+			Expect(executeWithArgs("db", "cache")).To(Succeed())
+
+			Expect(buffer.String()).To(ContainSubstring(`DB_PORT="5432"`))
+			Expect(buffer.String()).To(ContainSubstring(`CACHE_HOSTS_0="h1"`))
+			Expect(buffer.String()).To(ContainSubstring(`CACHE_HOSTS_1="h2"`))
+			Expect(buffer.String()).To(ContainSubstring(`CACHE_PASSWORD="pa\"ss\$"`))
+		})
+	})
+
+	Describe("properties format", func() {
+		It("should print dot separated keys", func() {
+			Expect(executeWithArgs("db", "cache", "--format", "properties")).To(Succeed())
+
+			Expect(buffer.String()).To(ContainSubstring("db.tls.ca=line1\\nline2\n"))
+			Expect(buffer.String()).To(ContainSubstring("db.uri=postgres://user:p@ss@host:5432/db\n"))
+			Expect(buffer.String()).To(ContainSubstring("cache.hosts.0=h1\n"))
+		})
+	})
+
+	Describe("k8s-secret format", func() {
+		It("should print a secret manifest with base64 encoded data", func() {
+			Expect(executeWithArgs("db", "--format", "k8s-secret")).To(Succeed())
+
+			var manifest map[string]interface{}
+			Expect(yaml.Unmarshal(buffer.Bytes(), &manifest)).To(Succeed())
+			Expect(manifest["kind"]).To(Equal("Secret"))
+			Expect(manifest["metadata"]).To(Equal(map[string]interface{}{"name": "db"}))
+			Expect(manifest["data"]).To(Equal(map[string]interface{}{
+				"port": "NTQzMg==",
+				"tls":  "eyJjYSI6ImxpbmUxXG5saW5lMiIsImVuYWJsZWQiOnRydWV9",
+				"uri":  "cG9zdGdyZXM6Ly91c2VyOnBAc3NAaG9zdDo1NDMyL2Ri",
+			}))
+		})
+
+		It("should combine several bindings into one secret", func() {
+			Expect(executeWithArgs("db", "cache", "--format", "k8s-secret", "--secret-name", "my-app")).To(Succeed())
+
+			Expect(buffer.String()).To(ContainSubstring("name: my-app"))
+			Expect(buffer.String()).To(ContainSubstring("db.port: NTQzMg=="))
+			Expect(buffer.String()).To(ContainSubstring("cache.hosts: "))
+		})
+	})
+
+	Describe("vcap format", func() {
+		BeforeEach(func() {
+			client.GetInstanceByIDStub = func(_ context.Context, id string, _ *query.Parameters) (*types.ServiceInstance, error) {
+				return &types.ServiceInstance{ID: id, Name: id + "-name", ServicePlanID: "plan-id"}, nil
+			}
+			client.GetPlanByIDReturns(&types.ServicePlan{ID: "plan-id", Name: "standard", ServiceOfferingID: "offering-id"}, nil)
+			client.GetOfferingByIDReturns(&types.ServiceOffering{ID: "offering-id", Name: "postgres", Tags: json.RawMessage(`["sql"]`)}, nil)
+		})
+
+		It("should group the bindings by offering name", func() {
+			Expect(executeWithArgs("db", "cache", "--format", "vcap")).To(Succeed())
+
+			var vcap map[string][]map[string]interface{}
+			Expect(json.Unmarshal(buffer.Bytes(), &vcap)).To(Succeed())
+			Expect(vcap["postgres"]).To(HaveLen(2))
+			Expect(vcap["postgres"][0]).To(HaveKeyWithValue("name", "db"))
+			Expect(vcap["postgres"][0]).To(HaveKeyWithValue("label", "postgres"))
+			Expect(vcap["postgres"][0]).To(HaveKeyWithValue("plan", "standard"))
+			Expect(vcap["postgres"][0]).To(HaveKeyWithValue("instance_name", "db-instance-id-name"))
+			Expect(vcap["postgres"][0]).To(HaveKeyWithValue("tags", []interface{}{"sql"}))
+			Expect(vcap["postgres"][0]["credentials"]).To(HaveKeyWithValue("port", float64(5432)))
+			Expect(vcap["postgres"][1]).To(HaveKeyWithValue("binding_guid", "cache-binding-id"))
+		})
+
+		It("should fail when the plan cannot be resolved", func() {
+			client.GetPlanByIDReturns(nil, errors.New("plan error"))
+
+			Expect(executeWithArgs("db", "--format", "vcap")).To(MatchError("could not get service plan of binding db: plan error"))
+		})
+	})
+
+	Describe("file output", func() {
+		It("should write the file with 0600 permissions", func() {
+			Expect(executeWithArgs("db", "--file", "/app/.env")).To(Succeed())
+
+			content, err := afero.ReadFile(fs, "/app/.env")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(content)).To(ContainSubstring(`PORT="5432"`))
+			info, err := fs.Stat("/app/.env")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+			Expect(buffer.String()).To(Equal("Credentials of 1 service binding(s) written to /app/.env\n"))
+		})
+
+		It("should restrict the permissions of an existing file", func() {
+			Expect(afero.WriteFile(fs, "/app/.env", []byte("OLD=value\nMORE=values\n"), 0644)).To(Succeed())
+
+			Expect(executeWithArgs("cache", "-f", "/app/.env")).To(Succeed())
+
+			content, _ := afero.ReadFile(fs, "/app/.env")
+			Expect(string(content)).NotTo(ContainSubstring("OLD"))
+			info, _ := fs.Stat("/app/.env")
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+		})
+	})
+})
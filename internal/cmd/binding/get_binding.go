@@ -18,13 +18,13 @@ package binding
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/Peripli/service-manager-cli/pkg/types"
 
 	"github.com/Peripli/service-manager-cli/internal/cmd"
 	"github.com/Peripli/service-manager-cli/internal/output"
 	"github.com/Peripli/service-manager-cli/pkg/query"
+	"github.com/Peripli/service-manager-cli/pkg/smclient"
 	"github.com/spf13/cobra"
 )
 
@@ -44,58 +44,81 @@ func NewGetBindingCmd(context *cmd.Context) *GetBindingCmd {
 
 // Run runs the command's logic
 func (gb *GetBindingCmd) Run() error {
-	bindings, err := gb.Client.ListBindings(&query.Parameters{
+	if *gb.bindingParams {
+		bindings, err := gb.listBindings()
+		if err != nil {
+			return err
+		}
+		return gb.printParameters(bindings)
+	}
+	if gb.Watch.IsSet() {
+		return cmd.WatchObject(gb.Context, gb.outputFormat, gb.getBindings)
+	}
+
+	bindings, err := gb.getBindings()
+	if err != nil {
+		return err
+	}
+	if err := output.PrintServiceManagerObject(gb.Output, gb.outputFormat, bindings); err != nil {
+		return err
+	}
+	output.Println(gb.Output)
+
+	return nil
+}
+
+func (gb *GetBindingCmd) listBindings() (*types.ServiceBindings, error) {
+	bindings, err := gb.Client.ListBindings(gb.Ctx, &query.Parameters{
 		FieldQuery: []string{
 			fmt.Sprintf("name eq '%s'", gb.bindingName),
 		},
 		GeneralParams: gb.Parameters.GeneralParams,
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if len(bindings.ServiceBindings) < 1 {
-		output.PrintMessage(gb.Output, "No binding found with name: %s", gb.bindingName)
-		return nil
+		return nil, cmd.NewError(cmd.ErrorKindNotFound, "no binding found with name: %s", gb.bindingName)
 	}
-	if *gb.bindingParams {
-		return gb.printParameters(bindings)
+	return bindings, nil
+}
+
+func (gb *GetBindingCmd) getBindings() (types.ServiceManagerObject, error) {
+	bindings, err := gb.listBindings()
+	if err != nil {
+		return nil, err
 	}
 
 	resultBindings := &types.ServiceBindings{Vertical: true}
 	for _, binding := range bindings.ServiceBindings {
-		bd, err := gb.Client.GetBindingByID(binding.ID, &gb.Parameters)
+		bd, err := gb.Client.GetBindingByID(gb.Ctx, binding.ID, &gb.Parameters)
 		if err != nil {
 			// The binding could be deleted after List and before Get
-			if strings.Contains(err.Error(), "StatusCode: 404") {
+			if smclient.IsNotFound(err) {
 				continue
 			}
-			return err
+			return nil, err
 		}
-		instance, err := gb.Client.GetInstanceByID(bd.ServiceInstanceID, &gb.Parameters)
+		instance, err := gb.Client.GetInstanceByID(gb.Ctx, bd.ServiceInstanceID, &gb.Parameters)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		bd.ServiceInstanceName = instance.Name
 		resultBindings.ServiceBindings = append(resultBindings.ServiceBindings, *bd)
 	}
 
 	if len(resultBindings.ServiceBindings) < 1 {
-		output.PrintMessage(gb.Output, "No binding found with name: %s", gb.bindingName)
-		return nil
+		return nil, cmd.NewError(cmd.ErrorKindNotFound, "no binding found with name: %s", gb.bindingName)
 	}
-
-	output.PrintServiceManagerObject(gb.Output, gb.outputFormat, resultBindings)
-	output.Println(gb.Output)
-
-	return nil
+	return cmd.Printable(gb.Context, resultBindings), nil
 }
 
 func (gb *GetBindingCmd) printParameters(bindings *types.ServiceBindings) error {
 	for _, binding := range bindings.ServiceBindings {
-		parameters, err := gb.Client.GetBindingParameters(binding.ID, &gb.Parameters)
+		parameters, err := gb.Client.GetBindingParameters(gb.Ctx, binding.ID, &gb.Parameters)
 		if err != nil {
 			// The binding could be deleted after List and before Get
-			if strings.Contains(err.Error(), "StatusCode: 404") {
+			if smclient.IsNotFound(err) {
 				continue
 			}
 			output.PrintMessage(gb.Output, "Unable to show configuration parameters for service binding id: %s\n", binding.ID)
@@ -124,6 +147,9 @@ func (gb *GetBindingCmd) Validate(args []string) error {
 
 	gb.bindingName = args[0]
 
+	if gb.Watch.IsSet() && *gb.bindingParams {
+		return fmt.Errorf("--watch can't be combined with --show-binding-params")
+	}
 	return nil
 }
 
@@ -150,6 +176,9 @@ func (gb *GetBindingCmd) Prepare(prepare cmd.PrepareFunc) *cobra.Command {
 	gb.bindingParams = result.PersistentFlags().Bool("show-binding-params", false, "Show the service binding configuration parameters")
 	cmd.AddFormatFlag(result.Flags())
 	cmd.AddCommonQueryFlag(result.Flags(), &gb.Parameters)
+	cmd.AddShowCredentialsFlag(result.Flags(), &gb.ShowCredentials)
+	cmd.AddWatchFlag(result.Flags(), &gb.Watch)
+	result.ValidArgsFunction = cmd.CompleteArgs(gb.Context, cmd.BindingNames)
 
 	return result
 }
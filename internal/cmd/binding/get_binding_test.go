@@ -6,6 +6,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"bytes"
+	"encoding/json"
 
 	"github.com/Peripli/service-manager-cli/internal/cmd"
 	"github.com/Peripli/service-manager-cli/pkg/smclient/smclientfakes"
@@ -90,8 +91,8 @@ var _ = Describe("Get binding command test", func() {
 				client.ListBindingsReturns(&types.ServiceBindings{}, nil)
 				err := executeWithArgs("unknown")
 
-				Expect(err).ShouldNot(HaveOccurred())
-				Expect(buffer.String()).To(ContainSubstring("No binding found with name: unknown"))
+				Expect(err).To(MatchError("no binding found with name: unknown"))
+				Expect(cmd.KindOf(err)).To(Equal(cmd.ErrorKindNotFound))
 			})
 		})
 
@@ -107,6 +108,39 @@ var _ = Describe("Get binding command test", func() {
 		})
 	})
 
+	Describe("Get service binding credentials", func() {
+		bindingWithCredentials := binding
+		bindingWithCredentials.Credentials = json.RawMessage(`{"username":"admin","password":"secret","port":5432}`)
+
+		BeforeEach(func() {
+			client.GetInstanceByIDReturns(&instance1, nil)
+			client.GetBindingByIDReturns(&bindingWithCredentials, nil)
+		})
+
+		It("should mask the credential values", func() {
+			err := executeWithArgs("binding1")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buffer.String()).To(ContainSubstring(`{"password":"[REDACTED]","port":"[REDACTED]","username":"[REDACTED]"}`))
+			Expect(buffer.String()).NotTo(ContainSubstring("secret"))
+		})
+
+		It("should mask the credential values in json output", func() {
+			err := executeWithArgs("binding1", "-o", "json")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buffer.String()).To(ContainSubstring(`"password": "[REDACTED]"`))
+			Expect(buffer.String()).NotTo(ContainSubstring("secret"))
+		})
+
+		It("should print the credentials with show credentials flag", func() {
+			err := executeWithArgs("binding1", "--show-credentials")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buffer.String()).To(ContainSubstring("secret"))
+		})
+	})
+
 	Describe("Get service binding parameters", func() {
 		bindingParameters1 := map[string]interface{}{"param1":"value1","param2":"value2"}
 		bindingParameters2 := make(map[string]interface{})
@@ -124,8 +158,8 @@ var _ = Describe("Get binding command test", func() {
 				client.ListBindingsReturns(&types.ServiceBindings{}, nil)
 				err := executeWithArgs("unknown", "--show-binding-params")
 
-				Expect(err).ShouldNot(HaveOccurred())
-				Expect(buffer.String()).To(ContainSubstring("No binding found with name: unknown"))
+				Expect(err).To(MatchError("no binding found with name: unknown"))
+				Expect(cmd.KindOf(err)).To(Equal(cmd.ErrorKindNotFound))
 			})
 		})
 
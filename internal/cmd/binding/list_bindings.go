@@ -19,7 +19,11 @@ package binding
 import (
 	"github.com/Peripli/service-manager-cli/internal/cmd"
 	"github.com/Peripli/service-manager-cli/internal/output"
+	"github.com/Peripli/service-manager-cli/pkg/smclient"
+	"github.com/Peripli/service-manager-cli/pkg/types"
 	"github.com/spf13/cobra"
+
+	"github.com/Peripli/service-manager/pkg/web"
 )
 
 // ListBindingsCmd wraps the smctl list-bindings command
@@ -36,22 +40,49 @@ func NewListBindingsCmd(context *cmd.Context) *ListBindingsCmd {
 
 // Run runs the command's logic
 func (li *ListBindingsCmd) Run() error {
-	bindings, err := li.Client.ListBindings(&li.Parameters)
+	if li.Watch.IsSet() {
+		return cmd.WatchList(li.Context, li.outputFormat, li.listBindings)
+	}
+	if li.Paging.IsSet() {
+		return cmd.PrintPages(li.Context, smclient.NewPager(li.Ctx, li.Client, web.ServiceBindingsURL, &li.Parameters, &li.Paging.PageOptions), li.outputFormat, func() (types.ServiceManagerObject, interface{}) {
+			bindings := &types.ServiceBindings{}
+			return bindings, &bindings.ServiceBindings
+		}, li.resolveInstanceNames)
+	}
+
+	bindings, err := li.listBindings()
 	if err != nil {
 		return err
 	}
 
+	if err := output.PrintServiceManagerObject(li.Output, li.outputFormat, cmd.Printable(li.Context, bindings)); err != nil {
+		return err
+	}
+	output.Println(li.Output)
+
+	return nil
+}
+
+func (li *ListBindingsCmd) listBindings() (types.ServiceManagerObject, error) {
+	bindings, err := li.Client.ListBindings(li.Ctx, &li.Parameters)
+	if err != nil {
+		return nil, err
+	}
+	if err := li.resolveInstanceNames(bindings); err != nil {
+		return nil, err
+	}
+	return bindings, nil
+}
+
+func (li *ListBindingsCmd) resolveInstanceNames(list types.ServiceManagerObject) error {
+	bindings := list.(*types.ServiceBindings)
 	for i := range bindings.ServiceBindings {
-		instance, err := li.Client.GetInstanceByID(bindings.ServiceBindings[i].ServiceInstanceID, &li.Parameters)
+		instance, err := li.Client.GetInstanceByID(li.Ctx, bindings.ServiceBindings[i].ServiceInstanceID, &li.Parameters)
 		if err != nil {
 			return err
 		}
 		bindings.ServiceBindings[i].ServiceInstanceName = instance.Name
 	}
-
-	output.PrintServiceManagerObject(li.Output, li.outputFormat, bindings)
-	output.Println(li.Output)
-
 	return nil
 }
 
@@ -79,6 +110,9 @@ func (li *ListBindingsCmd) Prepare(prepare cmd.PrepareFunc) *cobra.Command {
 	cmd.AddFormatFlag(result.Flags())
 	cmd.AddQueryingFlags(result.Flags(), &li.Parameters)
 	cmd.AddCommonQueryFlag(result.Flags(), &li.Parameters)
+	cmd.AddPagingFlags(result.Flags(), &li.Paging)
+	cmd.AddListWatchFlag(result.Flags(), &li.Watch)
+	cmd.AddShowCredentialsFlag(result.Flags(), &li.ShowCredentials)
 
 	return result
 }
@@ -18,9 +18,11 @@ package binding
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"github.com/Peripli/service-manager-cli/internal/cmd"
+	"github.com/Peripli/service-manager-cli/pkg/query"
 	"github.com/Peripli/service-manager-cli/pkg/smclient/smclientfakes"
 	"github.com/Peripli/service-manager-cli/pkg/types"
 	. "github.com/onsi/ginkgo"
@@ -109,7 +111,7 @@ var _ = Describe("List bindings command test", func() {
 			err := executeWithArgs([]string{"--param", param})
 			Expect(err).ShouldNot(HaveOccurred())
 
-			args := client.ListBindingsArgsForCall(0)
+			_, args := client.ListBindingsArgsForCall(0)
 
 			Expect(args.GeneralParams).To(ConsistOf(param))
 			Expect(args.FieldQuery).To(BeEmpty())
@@ -124,7 +126,7 @@ var _ = Describe("List bindings command test", func() {
 			param := "name eq 'binding1'"
 			err := executeWithArgs([]string{"--field-query", param})
 
-			args := client.ListBindingsArgsForCall(0)
+			_, args := client.ListBindingsArgsForCall(0)
 
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(args.FieldQuery).To(ConsistOf(param))
@@ -139,7 +141,7 @@ var _ = Describe("List bindings command test", func() {
 			param := "test eq false"
 			err := executeWithArgs([]string{"--label-query", param})
 
-			args := client.ListBindingsArgsForCall(0)
+			_, args := client.ListBindingsArgsForCall(0)
 
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(args.FieldQuery).To(BeEmpty())
@@ -173,6 +175,78 @@ var _ = Describe("List bindings command test", func() {
 		})
 	})
 
+	Context("when streaming is used", func() {
+		It("should resolve instance names for every page", func() {
+			client.ListPageStub = func(_ context.Context, url string, q *query.Parameters, pageSize int, pageToken string, items interface{}) (string, error) {
+				bindings := items.(*[]types.ServiceBinding)
+				if pageToken == "" {
+					*bindings = []types.ServiceBinding{binding1}
+					return "page2", nil
+				}
+				*bindings = []types.ServiceBinding{binding2}
+				return "", nil
+			}
+			err := executeWithArgs([]string{"--stream", "-o", "json"})
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(client.ListPageCallCount()).To(Equal(2))
+			Expect(client.GetInstanceByIDCallCount()).To(Equal(2))
+			Expect(buffer.String()).To(ContainSubstring("instance-name1"))
+			Expect(buffer.String()).To(ContainSubstring("instance-name2"))
+		})
+	})
+
+	Context("when bindings have credentials", func() {
+		var bindingWithCredentials types.ServiceBinding
+
+		BeforeEach(func() {
+			bindingWithCredentials = binding1
+			bindingWithCredentials.Credentials = json.RawMessage(`{"password":"s3cr3t","username":"usr"}`)
+			client.ListBindingsReturns(&types.ServiceBindings{ServiceBindings: []types.ServiceBinding{bindingWithCredentials}}, nil)
+		})
+
+		It("should mask them", func() {
+			err := executeWithArgs([]string{"-o", "json"})
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buffer.String()).To(ContainSubstring(`"username": "[REDACTED]"`))
+			Expect(buffer.String()).NotTo(ContainSubstring("s3cr3t"))
+		})
+
+		It("should print them with --show-credentials", func() {
+			err := executeWithArgs([]string{"-o", "json", "--show-credentials"})
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buffer.String()).To(ContainSubstring(`"password": "s3cr3t"`))
+		})
+
+		It("should mask them when streaming", func() {
+			client.ListPageStub = func(_ context.Context, url string, q *query.Parameters, pageSize int, pageToken string, items interface{}) (string, error) {
+				*items.(*[]types.ServiceBinding) = []types.ServiceBinding{bindingWithCredentials}
+				return "", nil
+			}
+			err := executeWithArgs([]string{"--stream", "-o", "json"})
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buffer.String()).To(ContainSubstring("[REDACTED]"))
+			Expect(buffer.String()).NotTo(ContainSubstring("s3cr3t"))
+		})
+
+		It("should mask them when watching", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			command.Ctx = ctx
+			client.ListBindingsStub = func(context.Context, *query.Parameters) (*types.ServiceBindings, error) {
+				cancel()
+				return &types.ServiceBindings{ServiceBindings: []types.ServiceBinding{bindingWithCredentials}}, nil
+			}
+			err := executeWithArgs([]string{"--watch=1h"})
+
+			Expect(cmd.KindOf(err)).To(Equal(cmd.ErrorKindInterrupted))
+			Expect(buffer.String()).To(ContainSubstring("[REDACTED]"))
+			Expect(buffer.String()).NotTo(ContainSubstring("s3cr3t"))
+		})
+	})
+
 	Context("when invalid flag is used", func() {
 		It("should handle cobra error", func() {
 			err := executeWithArgs([]string{"--ooutput", "json"})
@@ -63,6 +63,8 @@ func (ubc *UnbindCmd) Prepare(prepare cmd.PrepareFunc) *cobra.Command {
 	result.Flags().StringVarP(&ubc.bindingID, "id", "", "", "ID of the service binding. Required when name is ambiguous.")
 	cmd.AddCommonQueryFlag(result.Flags(), &ubc.Parameters)
 	cmd.AddModeFlag(result.Flags(), "async")
+	cmd.AddWaitFlags(result.Flags(), &ubc.Wait)
+	result.ValidArgsFunction = cmd.CompleteArgs(ubc.Context, cmd.InstanceNames, cmd.BindingNames)
 
 	return result
 }
@@ -85,7 +87,7 @@ func (ubc *UnbindCmd) Validate(args []string) error {
 // Run runs the command's logic
 func (ubc *UnbindCmd) Run() error {
 	if ubc.bindingID == "" {
-		instanceToUnbind, err := ubc.Client.ListInstances(&query.Parameters{
+		instanceToUnbind, err := ubc.Client.ListInstances(ubc.Ctx, &query.Parameters{
 			FieldQuery: []string{
 				fmt.Sprintf("name eq '%s'", ubc.instanceName),
 			},
@@ -95,13 +97,13 @@ func (ubc *UnbindCmd) Run() error {
 			return err
 		}
 		if len(instanceToUnbind.ServiceInstances) < 1 {
-			return fmt.Errorf("service instance with name %s not found", ubc.instanceName)
+			return cmd.NewError(cmd.ErrorKindNotFound, "service instance with name %s not found", ubc.instanceName)
 		}
 		if len(instanceToUnbind.ServiceInstances) > 1 {
 			return fmt.Errorf("more than one service instance with name %s found. Use --id flag to specify id of the binding to be deleted", ubc.instanceName)
 		}
 
-		bindingsToDelete, err := ubc.Client.ListBindings(&query.Parameters{
+		bindingsToDelete, err := ubc.Client.ListBindings(ubc.Ctx, &query.Parameters{
 			FieldQuery: []string{
 				fmt.Sprintf("name eq '%s'", ubc.bindingName),
 				fmt.Sprintf("service_instance_id eq '%s'", instanceToUnbind.ServiceInstances[0].ID),
@@ -112,8 +114,7 @@ func (ubc *UnbindCmd) Run() error {
 			return err
 		}
 		if len(bindingsToDelete.ServiceBindings) < 1 {
-			output.PrintMessage(ubc.Output, "Service Binding with name %s for instance with name %s not found", ubc.bindingName, ubc.instanceName)
-			return nil
+			return cmd.NewError(cmd.ErrorKindNotFound, "service binding with name %s for instance with name %s not found", ubc.bindingName, ubc.instanceName)
 		}
 		ubc.bindingID = bindingsToDelete.ServiceBindings[0].ID
 	}
@@ -123,14 +124,13 @@ func (ubc *UnbindCmd) Run() error {
 		ubc.Parameters.GeneralParams = append(ubc.Parameters.GeneralParams, fmt.Sprintf("%s=%s", web.QueryParamForce, "true"))
 	}
 
-	location, err := ubc.Client.Unbind(ubc.bindingID, &ubc.Parameters)
+	location, err := ubc.Client.Unbind(ubc.Ctx, ubc.bindingID, &ubc.Parameters)
 	if err != nil {
 		output.PrintMessage(ubc.Output, "Could not delete service binding. Reason: ")
 		return err
 	}
 	if len(location) != 0 {
-		cmd.CommonHandleAsyncExecution(ubc.Context, location, fmt.Sprintf("Service Binding %s successfully scheduled for deletion. To see status of the operation use:\n", ubc.bindingName))
-		return nil
+		return cmd.CommonHandleAsyncExecution(ubc.Context, location, fmt.Sprintf("Service Binding %s successfully scheduled for deletion. To see status of the operation use:\n", ubc.bindingName), output.FormatText)
 	}
 	output.PrintMessage(ubc.Output, "Service Binding successfully deleted.\n")
 	return nil
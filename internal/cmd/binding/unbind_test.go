@@ -2,7 +2,7 @@ package binding
 
 import (
 	"fmt"
-	"github.com/Peripli/service-manager/pkg/util"
+	"github.com/Peripli/service-manager-cli/pkg/smclient"
 	"github.com/Peripli/service-manager/pkg/web"
 	"io/ioutil"
 	"net/http"
@@ -87,7 +87,7 @@ var _ = Describe("Unbind command test", func() {
 			err := executeWithArgs("instance-name", "binding-name", "--param", param)
 			Expect(err).ShouldNot(HaveOccurred())
 
-			_, args := client.UnbindArgsForCall(0)
+			_, _, args := client.UnbindArgsForCall(0)
 
 			Expect(args.GeneralParams).To(ConsistOf(param, "async=true"))
 			Expect(args.FieldQuery).To(BeEmpty())
@@ -102,7 +102,7 @@ var _ = Describe("Unbind command test", func() {
 			err := executeWithArgs("instance-name", "binding-name", "--force-delete")
 			Expect(err).ShouldNot(HaveOccurred())
 
-			_, args := client.UnbindArgsForCall(0)
+			_, _, args := client.UnbindArgsForCall(0)
 
 			cascadeParam := fmt.Sprintf("%s=%s", web.QueryParamCascade, "true")
 			forceParam := fmt.Sprintf("%s=%s", web.QueryParamForce, "true")
@@ -120,7 +120,7 @@ var _ = Describe("Unbind command test", func() {
 			err := executeWithArgs("instance-name", "binding-name", "--force")
 			Expect(err).ShouldNot(HaveOccurred())
 
-			_, args := client.UnbindArgsForCall(0)
+			_, _, args := client.UnbindArgsForCall(0)
 
 			cascadeParam := fmt.Sprintf("%s=%s", web.QueryParamCascade, "true")
 			forceParam := fmt.Sprintf("%s=%s", web.QueryParamForce, "true")
@@ -138,7 +138,7 @@ var _ = Describe("Unbind command test", func() {
 			err := executeWithArgs("instance-name", "binding-name", "--mode", "sync")
 			Expect(err).ShouldNot(HaveOccurred())
 
-			_, args := client.UnbindArgsForCall(0)
+			_, _, args := client.UnbindArgsForCall(0)
 
 			Expect(args.GeneralParams).To(ConsistOf("async=false"))
 			Expect(args.FieldQuery).To(BeEmpty())
@@ -153,8 +153,8 @@ var _ = Describe("Unbind command test", func() {
 		It("should return message", func() {
 			err := executeWithArgs("instance-name", "non-existing-name", "-f")
 
-			Expect(err).ShouldNot(HaveOccurred())
-			Expect(buffer.String()).To(SatisfyAll(ContainSubstring("Service Binding"), ContainSubstring("not found")))
+			Expect(err).To(MatchError("service binding with name non-existing-name for instance with name instance-name not found"))
+			Expect(cmd.KindOf(err)).To(Equal(cmd.ErrorKindNotFound))
 		})
 	})
 
@@ -173,7 +173,7 @@ var _ = Describe("Unbind command test", func() {
 	Context("when SM returns error", func() {
 		It("should return error message", func() {
 			body := ioutil.NopCloser(bytes.NewReader([]byte("")))
-			expectedError := util.HandleResponseError(&http.Response{Body: body, StatusCode: http.StatusInternalServerError})
+			expectedError := smclient.NewAPIError(&http.Response{Body: body, StatusCode: http.StatusInternalServerError})
 			client.UnbindReturns("", expectedError)
 			err := executeWithArgs("instance-name", "binding-name", "-f")
 
@@ -55,7 +55,7 @@ func (dbc *DeleteBrokerCmd) Validate(args []string) error {
 
 // Run runs the command's logic
 func (dbc *DeleteBrokerCmd) Run() error {
-	toDeleteBrokers, err := dbc.Client.ListBrokers(&query.Parameters{
+	toDeleteBrokers, err := dbc.Client.ListBrokers(dbc.Ctx, &query.Parameters{
 		FieldQuery: []string{
 			fmt.Sprintf("name eq '%s'", dbc.name),
 		},
@@ -65,17 +65,15 @@ func (dbc *DeleteBrokerCmd) Run() error {
 		return err
 	}
 	if len(toDeleteBrokers.Brokers) < 1 {
-		output.PrintMessage(dbc.Output, "Service Broker not found.\n")
-		return nil
+		return cmd.NewError(cmd.ErrorKindNotFound, "service broker with name %s not found", dbc.name)
 	}
-	location, err := dbc.Client.DeleteBroker(toDeleteBrokers.Brokers[0].ID, &dbc.Parameters)
+	location, err := dbc.Client.DeleteBroker(dbc.Ctx, toDeleteBrokers.Brokers[0].ID, &dbc.Parameters)
 	if err != nil {
 		output.PrintMessage(dbc.Output, "Could not delete broker. Reason: ")
 		return err
 	}
 	if len(location) != 0 {
-		cmd.CommonHandleAsyncExecution(dbc.Context, location, fmt.Sprintf("Service Broker %s successfully scheduled for deletion. To see status of the operation use:\n", dbc.name))
-		return nil
+		return cmd.CommonHandleAsyncExecution(dbc.Context, location, fmt.Sprintf("Service Broker %s successfully scheduled for deletion. To see status of the operation use:\n", dbc.name), output.FormatText)
 	}
 	output.PrintMessage(dbc.Output, "Service Broker successfully deleted.\n")
 	return nil
@@ -114,6 +112,8 @@ func (dbc *DeleteBrokerCmd) Prepare(prepare cmd.PrepareFunc) *cobra.Command {
 	result.Flags().BoolVarP(&dbc.force, "force", "f", false, "Force delete without confirmation")
 	cmd.AddCommonQueryFlag(result.Flags(), &dbc.Parameters)
 	cmd.AddModeFlag(result.Flags(), "sync")
+	cmd.AddWaitFlags(result.Flags(), &dbc.Wait)
+	result.ValidArgsFunction = cmd.CompleteArgs(dbc.Context, cmd.BrokerNames)
 
 	return result
 }
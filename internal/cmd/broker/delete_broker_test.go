@@ -1,7 +1,7 @@
 package broker
 
 import (
-	"github.com/Peripli/service-manager/pkg/util"
+	"github.com/Peripli/service-manager-cli/pkg/smclient"
 	"io/ioutil"
 	"net/http"
 
@@ -82,7 +82,7 @@ var _ = Describe("Delete brokers command test", func() {
 			err := executeWithArgs("broker-name", "--param", param)
 			Expect(err).ShouldNot(HaveOccurred())
 
-			_, args := client.DeleteBrokerArgsForCall(0)
+			_, _, args := client.DeleteBrokerArgsForCall(0)
 
 			Expect(args.GeneralParams).To(ConsistOf(param, "async=false"))
 			Expect(args.FieldQuery).To(BeEmpty())
@@ -98,7 +98,7 @@ var _ = Describe("Delete brokers command test", func() {
 			err := executeWithArgs("broker-name", "--mode", "async")
 			Expect(err).ShouldNot(HaveOccurred())
 
-			_, args := client.DeleteBrokerArgsForCall(0)
+			_, _, args := client.DeleteBrokerArgsForCall(0)
 
 			Expect(args.GeneralParams).To(ConsistOf("async=true"))
 			Expect(args.FieldQuery).To(BeEmpty())
@@ -113,15 +113,15 @@ var _ = Describe("Delete brokers command test", func() {
 		It("should return message", func() {
 			err := executeWithArgs("non-existing-name", "-f")
 
-			Expect(err).ShouldNot(HaveOccurred())
-			Expect(buffer.String()).To(ContainSubstring("Service Broker not found"))
+			Expect(err).To(MatchError("service broker with name non-existing-name not found"))
+			Expect(cmd.KindOf(err)).To(Equal(cmd.ErrorKindNotFound))
 		})
 	})
 
 	Context("when SM returns error", func() {
 		It("should return error message", func() {
 			body := ioutil.NopCloser(bytes.NewReader([]byte("")))
-			expectedError := util.HandleResponseError(&http.Response{Body: body, StatusCode: http.StatusInternalServerError})
+			expectedError := smclient.NewAPIError(&http.Response{Body: body, StatusCode: http.StatusInternalServerError})
 			client.DeleteBrokerReturns("", expectedError)
 			err := executeWithArgs("name", "-f")
 
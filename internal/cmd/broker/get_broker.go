@@ -18,9 +18,10 @@ package broker
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/Peripli/service-manager-cli/pkg/query"
+	"github.com/Peripli/service-manager-cli/pkg/smclient"
+	"github.com/Peripli/service-manager-cli/pkg/types"
 
 	"github.com/Peripli/service-manager-cli/internal/cmd"
 	"github.com/Peripli/service-manager-cli/internal/output"
@@ -43,34 +44,46 @@ func NewGetBrokerCmd(context *cmd.Context) *GetBrokerCmd {
 
 // Run runs the command's logic
 func (gb *GetBrokerCmd) Run() error {
-	brokers, err := gb.Client.ListBrokers(&query.Parameters{
+	if gb.Watch.IsSet() {
+		return cmd.WatchObject(gb.Context, gb.outputFormat, gb.getBroker)
+	}
+
+	broker, err := gb.getBroker()
+	if err != nil {
+		return err
+	}
+	if err := output.PrintServiceManagerObject(gb.Output, gb.outputFormat, broker); err != nil {
+		return err
+	}
+	output.Println(gb.Output)
+
+	return nil
+}
+
+func (gb *GetBrokerCmd) getBroker() (types.ServiceManagerObject, error) {
+	brokers, err := gb.Client.ListBrokers(gb.Ctx, &query.Parameters{
 		FieldQuery: []string{
 			fmt.Sprintf("name eq '%s'", gb.name),
 		},
 		GeneralParams: gb.Parameters.GeneralParams,
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if len(brokers.Brokers) < 1 {
-		output.PrintMessage(gb.Output, "No broker found with name: %s", gb.name)
-		return nil
+		return nil, cmd.NewError(cmd.ErrorKindNotFound, "no broker found with name: %s", gb.name)
 	}
 
 	id := brokers.Brokers[0].ID
-	broker, err := gb.Client.GetBrokerByID(id, &gb.Parameters)
+	broker, err := gb.Client.GetBrokerByID(gb.Ctx, id, &gb.Parameters)
 	if err != nil {
 		// The broker could be deleted after List and before Get
-		if strings.Contains(err.Error(), "StatusCode: 404") {
-			output.PrintMessage(gb.Output, "No broker found with name: %s", gb.name)
-			return nil
+		if smclient.IsNotFound(err) {
+			return nil, cmd.NewError(cmd.ErrorKindNotFound, "no broker found with name: %s", gb.name)
 		}
-		return err
+		return nil, err
 	}
-	output.PrintServiceManagerObject(gb.Output, gb.outputFormat, broker)
-	output.Println(gb.Output)
-
-	return nil
+	return broker, nil
 }
 
 // Validate validates command's arguments
@@ -108,6 +121,8 @@ func (gb *GetBrokerCmd) Prepare(prepare cmd.PrepareFunc) *cobra.Command {
 
 	cmd.AddFormatFlag(result.Flags())
 	cmd.AddCommonQueryFlag(result.Flags(), &gb.Parameters)
+	cmd.AddWatchFlag(result.Flags(), &gb.Watch)
+	result.ValidArgsFunction = cmd.CompleteArgs(gb.Context, cmd.BrokerNames)
 
 	return result
 }
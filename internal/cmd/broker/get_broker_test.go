@@ -5,10 +5,12 @@ import (
 	. "github.com/onsi/gomega"
 
 	"bytes"
+	"strings"
 
 	"github.com/Peripli/service-manager-cli/internal/cmd"
 	"github.com/Peripli/service-manager-cli/pkg/smclient/smclientfakes"
 	"github.com/Peripli/service-manager-cli/pkg/types"
+	smtypes "github.com/Peripli/service-manager/pkg/types"
 )
 
 var _ = Describe("Get broker command test", func() {
@@ -56,8 +58,8 @@ var _ = Describe("Get broker command test", func() {
 			client.ListBrokersReturns(&types.Brokers{}, nil)
 			err := executeWithArgs("unknown")
 
-			Expect(err).ShouldNot(HaveOccurred())
-			Expect(buffer.String()).To(ContainSubstring("No broker found with name: unknown"))
+			Expect(err).To(MatchError("no broker found with name: unknown"))
+			Expect(cmd.KindOf(err)).To(Equal(cmd.ErrorKindNotFound))
 		})
 	})
 
@@ -70,4 +72,36 @@ var _ = Describe("Get broker command test", func() {
 			Expect(buffer.String()).To(ContainSubstring(broker.TableData().String()))
 		})
 	})
+
+	Context("when --watch is used", func() {
+		It("should print events until the operation of the broker is done", func() {
+			registering := broker
+			registering.LastOperation = &smtypes.Operation{Type: smtypes.CREATE, State: smtypes.IN_PROGRESS}
+			registered := broker
+			registered.LastOperation = &smtypes.Operation{Type: smtypes.CREATE, State: smtypes.SUCCEEDED}
+			client.GetBrokerByIDReturnsOnCall(0, &registering, nil)
+			client.GetBrokerByIDReturnsOnCall(1, &registered, nil)
+
+			Expect(executeWithArgs("broker1", "--watch=1ms")).To(Succeed())
+
+			Expect(client.GetBrokerByIDCallCount()).To(Equal(2))
+			lines := strings.Split(strings.TrimSpace(buffer.String()), "\n")
+			Expect(lines).To(HaveLen(2))
+			Expect(lines[0]).To(HavePrefix(`{"type":"ADDED","object":{"id":"id1"`))
+			Expect(lines[1]).To(HavePrefix(`{"type":"MODIFIED","object":{"id":"id1"`))
+		})
+
+		It("should stop when the broker is deleted", func() {
+			deleting := broker
+			deleting.LastOperation = &smtypes.Operation{Type: smtypes.DELETE, State: smtypes.IN_PROGRESS}
+			client.GetBrokerByIDReturns(&deleting, nil)
+			client.ListBrokersReturnsOnCall(1, &types.Brokers{}, nil)
+
+			Expect(executeWithArgs("broker1", "--watch=1ms")).To(Succeed())
+
+			lines := strings.Split(strings.TrimSpace(buffer.String()), "\n")
+			Expect(lines).To(HaveLen(2))
+			Expect(lines[1]).To(HavePrefix(`{"type":"DELETED","object":{"id":"id1"`))
+		})
+	})
 })
@@ -19,7 +19,11 @@ package broker
 import (
 	"github.com/Peripli/service-manager-cli/internal/cmd"
 	"github.com/Peripli/service-manager-cli/internal/output"
+	"github.com/Peripli/service-manager-cli/pkg/smclient"
+	"github.com/Peripli/service-manager-cli/pkg/types"
 	"github.com/spf13/cobra"
+
+	"github.com/Peripli/service-manager/pkg/web"
 )
 
 // ListBrokersCmd wraps the smctl list-brokers command
@@ -37,12 +41,26 @@ func NewListBrokersCmd(context *cmd.Context) *ListBrokersCmd {
 
 // Run runs the command's logic
 func (lb *ListBrokersCmd) Run() error {
-	brokers, err := lb.Client.ListBrokers(&lb.Parameters)
+	if lb.Watch.IsSet() {
+		return cmd.WatchList(lb.Context, lb.outputFormat, func() (types.ServiceManagerObject, error) {
+			return lb.Client.ListBrokers(lb.Ctx, &lb.Parameters)
+		})
+	}
+	if lb.Paging.IsSet() {
+		return cmd.PrintPages(lb.Context, smclient.NewPager(lb.Ctx, lb.Client, web.ServiceBrokersURL, &lb.Parameters, &lb.Paging.PageOptions), lb.outputFormat, func() (types.ServiceManagerObject, interface{}) {
+			brokers := &types.Brokers{}
+			return brokers, &brokers.Brokers
+		}, nil)
+	}
+
+	brokers, err := lb.Client.ListBrokers(lb.Ctx, &lb.Parameters)
 	if err != nil {
 		return err
 	}
 
-	output.PrintServiceManagerObject(lb.Output, lb.outputFormat, brokers)
+	if err := output.PrintServiceManagerObject(lb.Output, lb.outputFormat, brokers); err != nil {
+		return err
+	}
 	output.Println(lb.Output)
 
 	return nil
@@ -73,6 +91,8 @@ func (lb *ListBrokersCmd) Prepare(prepare cmd.PrepareFunc) *cobra.Command {
 	cmd.AddFormatFlag(result.Flags())
 	cmd.AddQueryingFlags(result.Flags(), &lb.Parameters)
 	cmd.AddCommonQueryFlag(result.Flags(), &lb.Parameters)
+	cmd.AddPagingFlags(result.Flags(), &lb.Paging)
+	cmd.AddListWatchFlag(result.Flags(), &lb.Watch)
 
 	return result
 }
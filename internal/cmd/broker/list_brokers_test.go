@@ -1,6 +1,7 @@
 package broker
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	. "github.com/onsi/ginkgo"
@@ -10,6 +11,7 @@ import (
 	"bytes"
 
 	"github.com/Peripli/service-manager-cli/internal/cmd"
+	"github.com/Peripli/service-manager-cli/pkg/query"
 	"github.com/Peripli/service-manager-cli/pkg/smclient/smclientfakes"
 	"github.com/Peripli/service-manager-cli/pkg/types"
 )
@@ -83,7 +85,7 @@ var _ = Describe("List brokers command test", func() {
 			err := executeWithArgs([]string{"--param", param})
 			Expect(err).ShouldNot(HaveOccurred())
 
-			args := client.ListBrokersArgsForCall(0)
+			_, args := client.ListBrokersArgsForCall(0)
 
 			Expect(args.GeneralParams).To(ConsistOf(param))
 			Expect(args.FieldQuery).To(BeEmpty())
@@ -98,7 +100,7 @@ var _ = Describe("List brokers command test", func() {
 			param := "name eq 'broker1'"
 			err := executeWithArgs([]string{"--field-query", param})
 
-			args := client.ListBrokersArgsForCall(0)
+			_, args := client.ListBrokersArgsForCall(0)
 
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(args.FieldQuery).To(ConsistOf(param))
@@ -113,7 +115,7 @@ var _ = Describe("List brokers command test", func() {
 			param := "test eq false"
 			err := executeWithArgs([]string{"--label-query", param})
 
-			args := client.ListBrokersArgsForCall(0)
+			_, args := client.ListBrokersArgsForCall(0)
 
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(args.LabelQuery).To(ConsistOf(param))
@@ -173,4 +175,29 @@ var _ = Describe("List brokers command test", func() {
 		})
 	})
 
+	Context("when --watch is used", func() {
+		It("should keep watching until interrupted", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			command.Ctx = ctx
+			client.ListBrokersStub = func(context.Context, *query.Parameters) (*types.Brokers, error) {
+				if client.ListBrokersCallCount() == 2 {
+					cancel()
+				}
+				return &types.Brokers{Brokers: []types.Broker{broker}}, nil
+			}
+
+			err := executeWithArgs([]string{"--watch=1ms"})
+
+			Expect(cmd.KindOf(err)).To(Equal(cmd.ErrorKindInterrupted))
+			Expect(client.ListBrokersCallCount()).To(Equal(2))
+			Expect(buffer.String()).To(HavePrefix(`{"type":"ADDED","object":{"id":"id1"`))
+		})
+
+		It("should fail with paging flags", func() {
+			err := executeWithArgs([]string{"--watch", "--stream"})
+
+			Expect(err).To(MatchError("--watch can't be combined with paging flags"))
+			Expect(client.ListBrokersCallCount()).To(Equal(0))
+		})
+	})
 })
@@ -60,6 +60,7 @@ func (rbc *RegisterBrokerCmd) Prepare(prepare cmd.PrepareFunc) *cobra.Command {
 	cmd.AddFormatFlag(result.Flags())
 	cmd.AddCommonQueryFlag(result.Flags(), &rbc.Parameters)
 	cmd.AddModeFlag(result.Flags(), "sync")
+	cmd.AddWaitFlags(result.Flags(), &rbc.Wait)
 
 	return result
 }
@@ -86,16 +87,17 @@ func (rbc *RegisterBrokerCmd) Validate(args []string) error {
 
 // Run runs the command's logic
 func (rbc *RegisterBrokerCmd) Run() error {
-	resultBroker, location, err := rbc.Client.RegisterBroker(&rbc.broker, &rbc.Parameters)
+	resultBroker, location, err := rbc.Client.RegisterBroker(rbc.Ctx, &rbc.broker, &rbc.Parameters)
 	if err != nil {
 		return err
 	}
 
 	if len(location) != 0 {
-		cmd.CommonHandleAsyncExecution(rbc.Context, location, fmt.Sprintf("Service Broker %s successfully scheduled for registration. To see status of the operation use:\n", rbc.broker.Name))
-		return nil
+		return cmd.CommonHandleAsyncExecution(rbc.Context, location, fmt.Sprintf("Service Broker %s successfully scheduled for registration. To see status of the operation use:\n", rbc.broker.Name), rbc.outputFormat)
+	}
+	if err := output.PrintServiceManagerObject(rbc.Output, rbc.outputFormat, resultBroker); err != nil {
+		return err
 	}
-	output.PrintServiceManagerObject(rbc.Output, rbc.outputFormat, resultBroker)
 	output.Println(rbc.Output)
 	return nil
 }
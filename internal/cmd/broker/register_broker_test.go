@@ -2,7 +2,7 @@ package broker
 
 import (
 	"encoding/json"
-	"github.com/Peripli/service-manager/pkg/util"
+	"github.com/Peripli/service-manager-cli/pkg/smclient"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"gopkg.in/yaml.v3"
@@ -121,7 +121,7 @@ var _ = Describe("Register Broker Command test", func() {
 			It("should pass it to SM", func() {
 				validSyncRegisterBrokerExecution("validName", "validType", "validDescription", "--basic", "user:password", "--param", "paramKey=paramValue")
 
-				_, args := client.RegisterBrokerArgsForCall(0)
+				_, _, args := client.RegisterBrokerArgsForCall(0)
 
 				Expect(args.GeneralParams).To(ConsistOf("paramKey=paramValue", "async=false"))
 				Expect(args.FieldQuery).To(BeEmpty())
@@ -133,7 +133,7 @@ var _ = Describe("Register Broker Command test", func() {
 			It("should pass it to SM", func() {
 				validSyncRegisterBrokerExecution("validName", "validType", "validDescription", "--basic", "user:password", "--mode", "async")
 
-				_, args := client.RegisterBrokerArgsForCall(0)
+				_, _, args := client.RegisterBrokerArgsForCall(0)
 
 				Expect(args.GeneralParams).To(ConsistOf("async=true"))
 				Expect(args.FieldQuery).To(BeEmpty())
@@ -172,7 +172,7 @@ var _ = Describe("Register Broker Command test", func() {
 		Context("With http response error from http client", func() {
 			It("should return error's description", func() {
 				body := ioutil.NopCloser(bytes.NewReader([]byte("HTTP response error")))
-				expectedError := util.HandleResponseError(&http.Response{Body: body})
+				expectedError := smclient.NewAPIError(&http.Response{Body: body})
 				client.RegisterBrokerReturns(nil, "", expectedError)
 
 				err := invalidRegisterBrokerCommandExecution("validName", "validType", "--basic", "user:password")
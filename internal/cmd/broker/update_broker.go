@@ -63,7 +63,7 @@ func (ubc *UpdateBrokerCmd) Validate(args []string) error {
 
 // Run runs the command's logic
 func (ubc *UpdateBrokerCmd) Run() error {
-	toUpdateBrokers, err := ubc.Client.ListBrokers(&query.Parameters{
+	toUpdateBrokers, err := ubc.Client.ListBrokers(ubc.Ctx, &query.Parameters{
 		FieldQuery: []string{
 			fmt.Sprintf("name eq '%s'", ubc.name),
 		},
@@ -73,18 +73,19 @@ func (ubc *UpdateBrokerCmd) Run() error {
 		return err
 	}
 	if len(toUpdateBrokers.Brokers) < 1 {
-		return fmt.Errorf("broker with name %s not found", ubc.name)
+		return cmd.NewError(cmd.ErrorKindNotFound, "broker with name %s not found", ubc.name)
 	}
 	toUpdateBroker := toUpdateBrokers.Brokers[0]
-	result, location, err := ubc.Client.UpdateBroker(toUpdateBroker.ID, ubc.updatedBroker, &ubc.Parameters)
+	result, location, err := ubc.Client.UpdateBroker(ubc.Ctx, toUpdateBroker.ID, ubc.updatedBroker, &ubc.Parameters)
 	if err != nil {
 		return err
 	}
 	if len(location) != 0 {
-		cmd.CommonHandleAsyncExecution(ubc.Context, location, fmt.Sprintf("Service Broker %s successfully scheduled for update. To see status of the operation use:\n", toUpdateBroker.Name))
-		return nil
+		return cmd.CommonHandleAsyncExecution(ubc.Context, location, fmt.Sprintf("Service Broker %s successfully scheduled for update. To see status of the operation use:\n", toUpdateBroker.Name), ubc.outputFormat)
+	}
+	if err := output.PrintServiceManagerObject(ubc.Output, ubc.outputFormat, result); err != nil {
+		return err
 	}
-	output.PrintServiceManagerObject(ubc.Output, ubc.outputFormat, result)
 	output.Println(ubc.Output)
 	return nil
 }
@@ -110,6 +111,8 @@ smctl update-broker broker '{"name": "new-name", "description": "new-description
 	cmd.AddFormatFlag(result.Flags())
 	cmd.AddCommonQueryFlag(result.Flags(), &ubc.Parameters)
 	cmd.AddModeFlag(result.Flags(), "sync")
+	cmd.AddWaitFlags(result.Flags(), &ubc.Wait)
+	result.ValidArgsFunction = cmd.CompleteArgs(ubc.Context, cmd.BrokerNames)
 
 	return result
 }
@@ -78,7 +78,7 @@ var _ = Describe("Update broker command test", func() {
 			It("argument values should be as expected", func() {
 				err := validSyncUpdateBrokerExecution("broker1", `{"description":"newDescription"}`)
 
-				id, broker, _ := client.UpdateBrokerArgsForCall(0)
+				_, id, broker, _ := client.UpdateBrokerArgsForCall(0)
 
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(id).To(Equal("id"))
@@ -115,7 +115,7 @@ var _ = Describe("Update broker command test", func() {
 				err := validSyncUpdateBrokerExecution("broker1", `{"description":"newDescription"}`, "--param", "paramKey=paramValue")
 				Expect(err).ShouldNot(HaveOccurred())
 
-				_, _, args := client.UpdateBrokerArgsForCall(0)
+				_, _, _, args := client.UpdateBrokerArgsForCall(0)
 
 				Expect(args.GeneralParams).To(ConsistOf("paramKey=paramValue", "async=false"))
 				Expect(args.FieldQuery).To(BeEmpty())
@@ -128,7 +128,7 @@ var _ = Describe("Update broker command test", func() {
 				err := validSyncUpdateBrokerExecution("broker1", `{"description":"newDescription"}`, "--mode", "async")
 				Expect(err).ShouldNot(HaveOccurred())
 
-				_, _, args := client.UpdateBrokerArgsForCall(0)
+				_, _, _, args := client.UpdateBrokerArgsForCall(0)
 
 				Expect(args.GeneralParams).To(ConsistOf("async=true"))
 				Expect(args.FieldQuery).To(BeEmpty())
@@ -18,15 +18,16 @@ package cmd
 
 import (
 	"bufio"
+	"context"
 	"errors"
 	"fmt"
 	"io"
-	"os"
+	"io/fs"
 	"strings"
-	"syscall"
 
 	"github.com/Peripli/service-manager-cli/pkg/query"
 
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
@@ -34,14 +35,10 @@ import (
 	"github.com/Peripli/service-manager-cli/pkg/auth"
 	"github.com/Peripli/service-manager-cli/pkg/auth/oidc"
 	"github.com/Peripli/service-manager-cli/pkg/smclient"
+	"github.com/Peripli/service-manager-cli/pkg/types"
+	smtypes "github.com/Peripli/service-manager/pkg/types"
 )
 
-var supportedFormats = map[string]output.Format{
-	"text": output.FormatText,
-	"json": output.FormatJSON,
-	"yaml": output.FormatYAML,
-}
-
 // CommandPreparator used to wrap CLI commands
 type CommandPreparator interface {
 	Prepare(PrepareFunc) *cobra.Command
@@ -82,7 +79,7 @@ type ConfirmedCommand interface {
 type PrepareFunc func(cmd Command, ctx *Context) func(*cobra.Command, []string) error
 
 func newMissingLoginError() error {
-	return errors.New(`no logged user, use "smctl login" to log in`)
+	return NewError(ErrorKindUnauthorized, `no logged user, use "smctl login" to log in`)
 }
 
 // SmPrepare creates a SM client for SM commands
@@ -100,74 +97,84 @@ func SmPrepare(cmd Command, ctx *Context) func(*cobra.Command, []string) error {
 			ctx.Parameters.GeneralParams = append(ctx.Parameters.GeneralParams, fmt.Sprintf("async=%t", mode == "async"))
 		}
 
-		if ctx.Client == nil {
-			settings, err := ctx.Configuration.Load()
-			if err != nil {
-				if isNotExistError(err) {
-					return newMissingLoginError()
-				}
-				return err // error is descriptive enough, no need to wrap it
-			}
-			if settings.AccessToken == "" {
-				return newMissingLoginError()
-			}
-
-			oidcClient, err := oidc.NewClient(&auth.Options{
-				AuthorizationEndpoint: settings.AuthorizationEndpoint,
-				TokenEndpoint:         settings.TokenEndpoint,
-				ClientID:              settings.ClientID,
-				ClientSecret:          settings.ClientSecret,
-				IssuerURL:             settings.IssuerURL,
-				SSLDisabled:           settings.SSLDisabled,
-				TokenBasicAuth:        settings.TokenBasicAuth,
-			}, &settings.Token)
-			if err != nil {
-				return err
-			}
+		return EnsureClient(ctx)
+	}
+}
 
-			token, err := oidcClient.Token()
-			if err != nil {
-				if err == oidc.ErrTokenExpired {
-					return errors.New(`access token has expired, use "smctl login" to log in`)
-				}
-				return fmt.Errorf("error refreshing token: %s", err)
-			}
-			if settings.AccessToken != token.AccessToken {
-				settings.Token = *token
-				if saveErr := ctx.Configuration.Save(settings); saveErr != nil {
-					return fmt.Errorf("error saving configuration: %s", saveErr)
-				}
-			}
+// EnsureClient creates the SM client of the context from the settings of the logged in user, unless the context already has a client
+func EnsureClient(ctx *Context) error {
+	if ctx.Client != nil {
+		return nil
+	}
 
-			ctx.Client = smclient.NewClient(ctx.Ctx, oidcClient, settings.URL)
+	settings, err := ctx.Configuration.Load()
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return newMissingLoginError()
 		}
+		return err // error is descriptive enough, no need to wrap it
+	}
+	if settings.AccessToken == "" {
+		return newMissingLoginError()
+	}
 
-		return nil
+	oidcClient, err := oidc.NewClient(&auth.Options{
+		AuthorizationEndpoint: settings.AuthorizationEndpoint,
+		TokenEndpoint:         settings.TokenEndpoint,
+		ClientID:              settings.ClientID,
+		ClientSecret:          settings.ClientSecret,
+		IssuerURL:             settings.IssuerURL,
+		SSLDisabled:           settings.SSLDisabled,
+		TokenBasicAuth:        settings.TokenBasicAuth,
+	}, &settings.Token)
+	if err != nil {
+		return err
 	}
-}
 
-func isNotExistError(err error) bool {
-	e, ok := err.(*os.PathError)
-	if ok {
-		errno, ok := e.Err.(syscall.Errno)
-		return ok && errno == syscall.ENOENT
+	token, err := oidcClient.Token()
+	if err != nil {
+		if err == oidc.ErrTokenExpired {
+			return NewError(ErrorKindUnauthorized, `access token has expired, use "smctl login" to log in`)
+		}
+		return fmt.Errorf("error refreshing token: %s", err)
 	}
-	return false
+	if settings.AccessToken != token.AccessToken {
+		settings.Token = *token
+		if saveErr := ctx.Configuration.Save(settings); saveErr != nil {
+			return fmt.Errorf("error saving configuration: %s", saveErr)
+		}
+	}
+
+	ctx.Client = smclient.NewClientWithConfig(oidcClient, &smclient.ClientConfig{
+		URL:   settings.URL,
+		Retry: smclient.RetryPolicy{MaxRetries: ctx.Retries},
+	})
+	return nil
 }
 
 // CommonPrepare provides common pre-run logic for SM commands
 func CommonPrepare(cmd Command, ctx *Context) func(*cobra.Command, []string) error {
 	return func(c *cobra.Command, args []string) error {
+		if ctx.Ctx == nil {
+			ctx.Ctx = context.Background()
+		}
+
+		if ctx.Target != "" && ctx.Configuration != nil {
+			if err := ctx.Configuration.SelectTarget(ctx.Target); err != nil {
+				return err
+			}
+		}
+
 		if valCmd, ok := cmd.(ValidatedCommand); ok {
 			if err := valCmd.Validate(args); err != nil {
-				return err
+				return newValidationError(err)
 			}
 		}
 
 		if fmtCmd, ok := cmd.(FormattedCommand); ok {
-			outputFormat, err := getOutputFormat(c.Flags())
+			outputFormat, err := getOutputFormat(c.Flags(), ctx.Fs)
 			if err != nil {
-				return err
+				return newValidationError(err)
 			}
 			fmtCmd.SetOutputFormat(outputFormat)
 		}
@@ -197,14 +204,15 @@ func RunE(cmd Command) func(*cobra.Command, []string) error {
 	}
 }
 
-// AddFormatFlag adds the --output (-o) flag.
+// AddFormatFlag adds the --output (-o) and --template-file flags.
 func AddFormatFlag(flags *pflag.FlagSet) {
-	flags.StringP("output", "o", "", "output format")
+	AddFormatFlagDefault(flags, "")
 }
 
 // AddFormatFlagDefault is same as AddFormatFlag but allows to set default value.
 func AddFormatFlagDefault(flags *pflag.FlagSet, defValue string) {
 	flags.StringP("output", "o", defValue, "output format")
+	flags.StringP("template-file", "", "", "Path to a file with the template for -o go-template or -o jsonpath")
 }
 
 // AddQueryingFlags adds --field-query (-f) and --label-query (-l) flags
@@ -226,10 +234,58 @@ func AddModeFlag(flags *pflag.FlagSet, defValue string) {
 	flags.StringP("mode", "", defValue, "How calls to SM are performed sync or async")
 }
 
-// CommonHandleAsyncExecution handles async execution of SM calls
-func CommonHandleAsyncExecution(ctx *Context, location string, message string) {
-	output.PrintMessage(ctx.Output, message)
-	output.PrintMessage(ctx.Output, "smctl status %s\n", location)
+// AddWaitFlags adds the --wait and --timeout flags for commands that may start async operations
+func AddWaitFlags(flags *pflag.FlagSet, options *WaitOptions) {
+	flags.BoolVarP(&options.Enabled, "wait", "", false, "Wait for the asynchronous operation to complete")
+	flags.DurationVarP(&options.Timeout, "timeout", "", DefaultWaitTimeout, "Maximum time to wait for the asynchronous operation when --wait is used")
+}
+
+// AddShowCredentialsFlag adds the --show-credentials flag for commands printing credentials
+func AddShowCredentialsFlag(flags *pflag.FlagSet, showCredentials *bool) {
+	flags.BoolVarP(showCredentials, "show-credentials", "", false, "Show credentials in the output instead of masking them")
+}
+
+// Printable returns the object to print, with masked credentials unless --show-credentials is used
+func Printable(ctx *Context, object types.ServiceManagerObject) types.ServiceManagerObject {
+	if redactor, ok := object.(types.CredentialsRedactor); ok && !ctx.ShowCredentials {
+		return redactor.WithRedactedCredentials()
+	}
+	return object
+}
+
+// CommonHandleAsyncExecution handles async execution of SM calls.
+// If --wait is used, it polls the operation until it completes and prints the resulting resource, or the operation for deletions, in the provided format.
+func CommonHandleAsyncExecution(ctx *Context, location string, message string, outputFormat output.Format) error {
+	if !ctx.Wait.Enabled {
+		output.PrintMessage(ctx.Output, message)
+		output.PrintMessage(ctx.Output, "smctl status %s\n", location)
+		return nil
+	}
+
+	operation, err := WaitForOperation(ctx, location)
+	if err != nil {
+		return err
+	}
+	if operation.State == string(smtypes.FAILED) {
+		if err := output.PrintServiceManagerObject(ctx.Output, outputFormat, operation); err != nil {
+			return err
+		}
+		output.Println(ctx.Output)
+		return NewError(ErrorKindOperationFailed, "operation %s failed", operation.ID)
+	}
+
+	resource, err := getOperationResource(ctx, operation)
+	if err != nil {
+		return err
+	}
+	if resource == nil {
+		resource = operation
+	}
+	if err := output.PrintServiceManagerObject(ctx.Output, outputFormat, Printable(ctx, resource)); err != nil {
+		return err
+	}
+	output.Println(ctx.Output)
+	return nil
 }
 
 //CommonConfirmationPrompt provides common logic for confirmation of an operation
@@ -260,16 +316,25 @@ func CommonPrintDeclineMessage(wr io.Writer) {
 	output.PrintMessage(wr, "Delete declined")
 }
 
-func getOutputFormat(flags *pflag.FlagSet) (output.Format, error) {
+func getOutputFormat(flags *pflag.FlagSet, fs afero.Fs) (output.Format, error) {
 	outputFormat, _ := flags.GetString("output")
-	outputFormat = strings.ToLower(outputFormat)
+	templateFile, _ := flags.GetString("template-file")
+	if templateFile != "" {
+		if outputFormat == "" {
+			outputFormat = "go-template"
+		}
+		if format := strings.ToLower(outputFormat); format != "go-template" && format != "jsonpath" {
+			return output.FormatUnknown, errors.New("--template-file can be used only with -o go-template or -o jsonpath")
+		}
+		template, err := afero.ReadFile(fs, templateFile)
+		if err != nil {
+			return output.FormatUnknown, fmt.Errorf("could not read template file: %s", err)
+		}
+		outputFormat += "=" + string(template)
+	}
 
 	if outputFormat == "" {
 		return output.FormatText, nil
 	}
-	format, exists := supportedFormats[outputFormat]
-	if !exists {
-		return output.FormatUnknown, errors.New("unknown output: " + outputFormat)
-	}
-	return format, nil
+	return output.ParseFormat(outputFormat)
 }
@@ -0,0 +1,405 @@
+/*
+ * Copyright 2018 The Service Manager Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/Peripli/service-manager-cli/pkg/query"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+// CompletionCacheTTL is how long completion candidates are cached
+const CompletionCacheTTL = 30 * time.Second
+
+// CompletionSource lists the candidates for completing a positional argument or a flag value.
+// It gets the positional arguments already given. A candidate may be followed by a tab and a description.
+type CompletionSource func(ctx *Context, args []string) ([]string, error)
+
+var (
+	// InstanceNames lists the names of the service instances
+	InstanceNames = cachedSource("instance-names", func(ctx *Context) ([]string, error) {
+		instances, err := ctx.Client.ListInstances(ctx.Ctx, &query.Parameters{})
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		for _, instance := range instances.ServiceInstances {
+			names = append(names, instance.Name)
+		}
+		return names, nil
+	})
+
+	// InstanceIDs lists the IDs of the service instances, described by their names
+	InstanceIDs = cachedSource("instance-ids", func(ctx *Context) ([]string, error) {
+		instances, err := ctx.Client.ListInstances(ctx.Ctx, &query.Parameters{})
+		if err != nil {
+			return nil, err
+		}
+		var ids []string
+		for _, instance := range instances.ServiceInstances {
+			ids = append(ids, describedCandidate(instance.ID, instance.Name))
+		}
+		return ids, nil
+	})
+
+	// BindingNames lists the names of the service bindings
+	BindingNames = cachedSource("binding-names", func(ctx *Context) ([]string, error) {
+		bindings, err := ctx.Client.ListBindings(ctx.Ctx, &query.Parameters{})
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		for _, binding := range bindings.ServiceBindings {
+			names = append(names, binding.Name)
+		}
+		return names, nil
+	})
+
+	// BrokerNames lists the names of the brokers
+	BrokerNames = cachedSource("broker-names", func(ctx *Context) ([]string, error) {
+		brokers, err := ctx.Client.ListBrokers(ctx.Ctx, &query.Parameters{})
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		for _, broker := range brokers.Brokers {
+			names = append(names, broker.Name)
+		}
+		return names, nil
+	})
+
+	// BrokerIDs lists the IDs of the brokers, described by their names
+	BrokerIDs = cachedSource("broker-ids", func(ctx *Context) ([]string, error) {
+		brokers, err := ctx.Client.ListBrokers(ctx.Ctx, &query.Parameters{})
+		if err != nil {
+			return nil, err
+		}
+		var ids []string
+		for _, broker := range brokers.Brokers {
+			ids = append(ids, describedCandidate(broker.ID, broker.Name))
+		}
+		return ids, nil
+	})
+
+	// PlatformNames lists the names of the platforms
+	PlatformNames = cachedSource("platform-names", func(ctx *Context) ([]string, error) {
+		platforms, err := ctx.Client.ListPlatforms(ctx.Ctx, &query.Parameters{})
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		for _, platform := range platforms.Platforms {
+			names = append(names, platform.Name)
+		}
+		return names, nil
+	})
+
+	// PlatformIDs lists the IDs of the platforms, described by their names
+	PlatformIDs = cachedSource("platform-ids", func(ctx *Context) ([]string, error) {
+		platforms, err := ctx.Client.ListPlatforms(ctx.Ctx, &query.Parameters{})
+		if err != nil {
+			return nil, err
+		}
+		var ids []string
+		for _, platform := range platforms.Platforms {
+			ids = append(ids, describedCandidate(platform.ID, platform.Name))
+		}
+		return ids, nil
+	})
+
+	// OfferingNames lists the names of the service offerings
+	OfferingNames = cachedSource("offering-names", func(ctx *Context) ([]string, error) {
+		offerings, err := ctx.Client.ListOfferings(ctx.Ctx, &query.Parameters{})
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		for _, offering := range offerings.ServiceOfferings {
+			names = append(names, offering.Name)
+		}
+		return names, nil
+	})
+
+	// PlanNames lists the names of the service plans of all offerings
+	PlanNames = cachedSource("plan-names", func(ctx *Context) ([]string, error) {
+		plans, err := ctx.Client.ListPlans(ctx.Ctx, &query.Parameters{})
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		for _, plan := range plans.ServicePlans {
+			names = append(names, plan.Name)
+		}
+		return names, nil
+	})
+
+	// PlanIDs lists the IDs of the service plans, described by their names
+	PlanIDs = cachedSource("plan-ids", func(ctx *Context) ([]string, error) {
+		plans, err := ctx.Client.ListPlans(ctx.Ctx, &query.Parameters{})
+		if err != nil {
+			return nil, err
+		}
+		var ids []string
+		for _, plan := range plans.ServicePlans {
+			ids = append(ids, describedCandidate(plan.ID, plan.Name))
+		}
+		return ids, nil
+	})
+)
+
+// PlanNamesOfOffering lists the names of the plans of the offering given as the positional argument at offeringArg.
+// The names of the plans of all offerings are listed if this argument is not given yet.
+func PlanNamesOfOffering(offeringArg int) CompletionSource {
+	return func(ctx *Context, args []string) ([]string, error) {
+		if offeringArg >= len(args) {
+			return PlanNames(ctx, args)
+		}
+		offering := args[offeringArg]
+		return cachedCandidates(ctx, "plan-names/"+offering, func() ([]string, error) {
+			offerings, err := ctx.Client.ListOfferings(ctx.Ctx, &query.Parameters{
+				FieldQuery: []string{fmt.Sprintf("name eq '%s'", offering)},
+			})
+			if err != nil {
+				return nil, err
+			}
+			var names []string
+			for _, offering := range offerings.ServiceOfferings {
+				plans, err := ctx.Client.ListPlans(ctx.Ctx, &query.Parameters{
+					FieldQuery: []string{fmt.Sprintf("service_offering_id eq '%s'", offering.ID)},
+				})
+				if err != nil {
+					return nil, err
+				}
+				for _, plan := range plans.ServicePlans {
+					names = append(names, plan.Name)
+				}
+			}
+			return names, nil
+		})
+	}
+}
+
+// StaticCompletion lists the given values
+func StaticCompletion(values ...string) CompletionSource {
+	return func(*Context, []string) ([]string, error) {
+		return values, nil
+	}
+}
+
+// CompleteArgs returns a completion function completing each positional argument with the source at the same index.
+// Arguments without a source, or with a nil one, are not completed.
+func CompleteArgs(ctx *Context, sources ...CompletionSource) cobra.CompletionFunc {
+	return func(_ *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		if len(args) >= len(sources) || sources[len(args)] == nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return complete(ctx, sources[len(args)], args, toComplete)
+	}
+}
+
+// CompleteEachArg returns a completion function completing any number of positional arguments with the source.
+// Values already given are not offered again.
+func CompleteEachArg(ctx *Context, source CompletionSource) cobra.CompletionFunc {
+	return func(_ *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		completions, directive := complete(ctx, source, args, toComplete)
+		remaining := completions[:0]
+		for _, completion := range completions {
+			if !contains(args, strings.SplitN(completion, "\t", 2)[0]) {
+				remaining = append(remaining, completion)
+			}
+		}
+		return remaining, directive
+	}
+}
+
+// CompleteFlag completes the values of the flag of the command with the source
+func CompleteFlag(command *cobra.Command, flag string, ctx *Context, source CompletionSource) {
+	err := command.RegisterFlagCompletionFunc(flag, func(_ *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		return complete(ctx, source, args, toComplete)
+	})
+	if err != nil {
+		// registration only fails for unknown or already completed flags
+		panic(err)
+	}
+}
+
+// DefaultCompletionCacheDir returns the directory for caching completion candidates in the user cache directory,
+// or an empty string if there is no such directory
+func DefaultCompletionCacheDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "smctl", "completion")
+}
+
+func complete(ctx *Context, source CompletionSource, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	candidates, err := source(ctx, args)
+	if err != nil {
+		// the shell shows no candidates instead of the error, which is left for the actual command
+		cobra.CompDebugln(fmt.Sprintf("listing completion candidates failed: %s", err), false)
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	var completions []cobra.Completion
+	for _, candidate := range candidates {
+		if strings.HasPrefix(candidate, toComplete) {
+			completions = append(completions, candidate)
+		}
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+func cachedSource(key string, list func(ctx *Context) ([]string, error)) CompletionSource {
+	return func(ctx *Context, _ []string) ([]string, error) {
+		return cachedCandidates(ctx, key, func() ([]string, error) {
+			return list(ctx)
+		})
+	}
+}
+
+// cachedCandidates returns the candidates cached under the key for the logged in user, unless they are older than CompletionCacheTTL.
+// Otherwise the candidates are listed with a client created on demand and cached.
+func cachedCandidates(ctx *Context, key string, list func() ([]string, error)) ([]string, error) {
+	if ctx.Ctx == nil {
+		ctx.Ctx = context.Background()
+	}
+	if err := ctx.ensureConfiguration(); err != nil {
+		return nil, err
+	}
+	if ctx.Target != "" && ctx.Configuration != nil {
+		if err := ctx.Configuration.SelectTarget(ctx.Target); err != nil {
+			return nil, err
+		}
+	}
+
+	file := completionCacheFile(ctx, key)
+	if candidates, ok := readCompletionCache(ctx.Fs, file); ok {
+		return candidates, nil
+	}
+
+	if err := EnsureClient(ctx); err != nil {
+		return nil, err
+	}
+	candidates, err := list()
+	if err != nil {
+		return nil, err
+	}
+	candidates = uniqueCandidates(candidates)
+	if err := writeCompletionCache(ctx.Fs, file, candidates); err != nil {
+		cobra.CompDebugln(fmt.Sprintf("caching completion candidates failed: %s", err), false)
+	}
+	return candidates, nil
+}
+
+// completionCacheFile returns the cache file of the key, which is specific to the Service Manager and user logged in
+func completionCacheFile(ctx *Context, key string) string {
+	if ctx.CompletionCacheDir == "" || ctx.Fs == nil {
+		return ""
+	}
+	var user string
+	if ctx.Configuration != nil {
+		if settings, err := ctx.Configuration.Load(); err == nil {
+			user = strings.Join([]string{settings.URL, settings.User, settings.ClientID}, "\n")
+		}
+	}
+	hash := sha256.Sum256([]byte(user + "\n" + key))
+	return filepath.Join(ctx.CompletionCacheDir, hex.EncodeToString(hash[:16])+".json")
+}
+
+func readCompletionCache(fs afero.Fs, file string) ([]string, bool) {
+	if file == "" {
+		return nil, false
+	}
+	info, err := fs.Stat(file)
+	if err != nil || time.Since(info.ModTime()) > CompletionCacheTTL {
+		return nil, false
+	}
+	content, err := afero.ReadFile(fs, file)
+	if err != nil {
+		return nil, false
+	}
+	var candidates []string
+	if err := json.Unmarshal(content, &candidates); err != nil {
+		return nil, false
+	}
+	return candidates, true
+}
+
+func writeCompletionCache(fs afero.Fs, file string, candidates []string) error {
+	if file == "" {
+		return nil
+	}
+	content, err := json.Marshal(candidates)
+	if err != nil {
+		return err
+	}
+	if err := fs.MkdirAll(filepath.Dir(file), 0700); err != nil {
+		return err
+	}
+	// written to a temporary file first, so concurrent completions never read a partial file
+	tmp, err := afero.TempFile(fs, filepath.Dir(file), "*.tmp")
+	if err != nil {
+		return err
+	}
+	defer fs.Remove(tmp.Name())
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return fs.Rename(tmp.Name(), file)
+}
+
+func describedCandidate(value, description string) string {
+	if description == "" {
+		return value
+	}
+	return value + "\t" + description
+}
+
+func uniqueCandidates(candidates []string) []string {
+	seen := make(map[string]bool, len(candidates))
+	var unique []string
+	for _, candidate := range candidates {
+		if candidate != "" && !seen[candidate] {
+			seen[candidate] = true
+			unique = append(unique, candidate)
+		}
+	}
+	return unique
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
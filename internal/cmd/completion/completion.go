@@ -0,0 +1,99 @@
+/*
+ * Copyright 2018 The Service Manager Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package completion
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Peripli/service-manager-cli/internal/cmd"
+)
+
+var shells = []string{"bash", "zsh", "fish", "powershell"}
+
+// Cmd wraps the smctl completion command
+type Cmd struct {
+	*cmd.Context
+
+	command *cobra.Command
+	shell   string
+}
+
+// NewCompletionCmd returns new completion command with context
+func NewCompletionCmd(context *cmd.Context) *Cmd {
+	return &Cmd{Context: context}
+}
+
+// Prepare returns cobra command
+func (cc *Cmd) Prepare(prepare cmd.PrepareFunc) *cobra.Command {
+	cc.command = &cobra.Command{
+		Use:   "completion [bash|zsh|fish|powershell]",
+		Short: "Generates a shell completion script",
+		Long: `Generates a completion script for the given shell. Besides commands and flags, the script completes the names of
+instances, bindings, brokers, platforms, offerings and plans from the Service Manager the user is logged in to.
+The names are cached for a short time.
+
+To load the completions in the current bash session:
+  source <(smctl completion bash)
+
+To load them in every new session, add this line to ~/.bashrc, or the corresponding line to the startup file of your shell:
+  source <(smctl completion zsh)
+  smctl completion fish | source
+  smctl completion powershell | Out-String | Invoke-Expression`,
+		ValidArgs: shells,
+
+		PreRunE: prepare(cc, cc.Context),
+		RunE:    cmd.RunE(cc),
+	}
+
+	return cc.command
+}
+
+// Validate validates command's arguments
+func (cc *Cmd) Validate(args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("single [shell] is required")
+	}
+	for _, shell := range shells {
+		if args[0] == shell {
+			cc.shell = shell
+			return nil
+		}
+	}
+	return fmt.Errorf("unsupported shell %s, supported shells are bash, zsh, fish and powershell", args[0])
+}
+
+// Run runs the command's logic
+func (cc *Cmd) Run() error {
+	root := cc.command.Root()
+	switch cc.shell {
+	case "bash":
+		return root.GenBashCompletionV2(cc.Output, true)
+	case "zsh":
+		return root.GenZshCompletion(cc.Output)
+	case "fish":
+		return root.GenFishCompletion(cc.Output, true)
+	default:
+		return root.GenPowerShellCompletionWithDesc(cc.Output)
+	}
+}
+
+// HideUsage hide command's usage
+func (cc *Cmd) HideUsage() bool {
+	return true
+}
@@ -0,0 +1,183 @@
+/*
+ * Copyright 2018 The Service Manager Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package completion
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+
+	"github.com/Peripli/service-manager-cli/internal/cmd"
+	"github.com/Peripli/service-manager-cli/internal/cmd/instance"
+	"github.com/Peripli/service-manager-cli/internal/configuration"
+	"github.com/Peripli/service-manager-cli/internal/configuration/configurationfakes"
+	"github.com/Peripli/service-manager-cli/pkg/smclient/smclientfakes"
+	"github.com/Peripli/service-manager-cli/pkg/types"
+)
+
+func TestCompletionCmd(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "")
+}
+
+var _ = Describe("Completion command test", func() {
+	var client *smclientfakes.FakeClient
+	var config *configurationfakes.FakeConfiguration
+	var cmdContext *cmd.Context
+	var buffer *bytes.Buffer
+	var fs afero.Fs
+	var cacheDir string
+
+	buildRoot := func() *cobra.Command {
+		root := cmd.BuildRootCommand(cmdContext)
+		root.AddCommand(NewCompletionCmd(cmdContext).Prepare(cmd.CommonPrepare))
+		root.AddCommand(instance.NewGetInstanceCmd(cmdContext).Prepare(cmd.SmPrepare))
+		root.SetOut(buffer)
+		return root
+	}
+
+	execute := func(args ...string) error {
+		root := buildRoot()
+		root.SetArgs(args)
+		return root.Execute()
+	}
+
+	BeforeEach(func() {
+		fs = afero.NewMemMapFs()
+		cacheDir = "/cache/smctl/completion"
+
+		buffer = &bytes.Buffer{}
+		client = &smclientfakes.FakeClient{}
+		client.ListInstancesReturns(&types.ServiceInstances{ServiceInstances: []types.ServiceInstance{
+			{ID: "1", Name: "my-instance"},
+			{ID: "2", Name: "other-instance"},
+		}}, nil)
+		config = &configurationfakes.FakeConfiguration{}
+		config.LoadReturns(&configuration.Settings{URL: "http://sm.com", User: "admin"}, nil)
+		cmdContext = &cmd.Context{
+			Ctx:                context.Background(),
+			Output:             buffer,
+			Client:             client,
+			Configuration:      config,
+			Fs:                 fs,
+			CompletionCacheDir: cacheDir,
+		}
+	})
+
+	Describe("Script generation", func() {
+		DescribeTable("should print the completion script of the shell",
+			func(shell, header string) {
+				Expect(execute("completion", shell)).To(Succeed())
+				Expect(buffer.String()).To(ContainSubstring(header))
+			},
+			Entry("bash", "bash", "bash completion V2 for smctl"),
+			Entry("zsh", "zsh", "#compdef smctl"),
+			Entry("fish", "fish", "fish completion for smctl"),
+			Entry("powershell", "powershell", "powershell completion for smctl"),
+		)
+
+		It("should complete the supported shells", func() {
+			Expect(execute("__complete", "completion", "")).To(Succeed())
+			Expect(buffer.String()).To(ContainSubstring("bash\nzsh\nfish\npowershell\n"))
+		})
+
+		It("should fail for an unsupported shell", func() {
+			err := execute("completion", "tcsh")
+			Expect(err).To(MatchError("unsupported shell tcsh, supported shells are bash, zsh, fish and powershell"))
+		})
+
+		It("should fail without a shell", func() {
+			Expect(execute("completion")).To(MatchError("single [shell] is required"))
+		})
+	})
+
+	Describe("Resource names", func() {
+		It("should complete the names with the given prefix", func() {
+			Expect(execute("__complete", "get-instance", "my")).To(Succeed())
+			Expect(buffer.String()).To(HavePrefix("my-instance\n:4\n"))
+		})
+
+		It("should not complete further arguments", func() {
+			Expect(execute("__complete", "get-instance", "my-instance", "")).To(Succeed())
+			Expect(buffer.String()).To(HavePrefix(":4\n"))
+			Expect(client.ListInstancesCallCount()).To(Equal(0))
+		})
+
+		It("should complete nothing when listing fails", func() {
+			client.ListInstancesReturns(nil, errors.New("unauthorized"))
+			Expect(execute("__complete", "get-instance", "")).To(Succeed())
+			Expect(buffer.String()).To(HavePrefix(":4\n"))
+		})
+
+		Context("when the names are cached", func() {
+			BeforeEach(func() {
+				Expect(execute("__complete", "get-instance", "")).To(Succeed())
+				Expect(client.ListInstancesCallCount()).To(Equal(1))
+				buffer.Reset()
+			})
+
+			It("should not list them again", func() {
+				Expect(execute("__complete", "get-instance", "other")).To(Succeed())
+				Expect(buffer.String()).To(HavePrefix("other-instance\n:4\n"))
+				Expect(client.ListInstancesCallCount()).To(Equal(1))
+			})
+
+			It("should list them again when the cache has expired", func() {
+				files, err := afero.Glob(fs, filepath.Join(cacheDir, "*.json"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(files).To(HaveLen(1))
+				expired := time.Now().Add(-cmd.CompletionCacheTTL - time.Second)
+				Expect(fs.Chtimes(files[0], expired, expired)).To(Succeed())
+
+				Expect(execute("__complete", "get-instance", "")).To(Succeed())
+				Expect(client.ListInstancesCallCount()).To(Equal(2))
+			})
+
+			It("should list them again for another user", func() {
+				config.LoadReturns(&configuration.Settings{URL: "http://sm.com", User: "other"}, nil)
+				Expect(execute("__complete", "get-instance", "")).To(Succeed())
+				Expect(client.ListInstancesCallCount()).To(Equal(2))
+			})
+
+			It("should keep the cache private", func() {
+				files, err := afero.Glob(fs, filepath.Join(cacheDir, "*.json"))
+				Expect(err).ToNot(HaveOccurred())
+				info, err := fs.Stat(files[0])
+				Expect(err).ToNot(HaveOccurred())
+				Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+			})
+		})
+
+		It("should not cache without a cache directory", func() {
+			cmdContext.CompletionCacheDir = ""
+			Expect(execute("__complete", "get-instance", "")).To(Succeed())
+			Expect(execute("__complete", "get-instance", "")).To(Succeed())
+			Expect(client.ListInstancesCallCount()).To(Equal(2))
+		})
+	})
+})
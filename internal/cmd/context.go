@@ -21,6 +21,8 @@ import (
 	"github.com/Peripli/service-manager-cli/pkg/query"
 	"io"
 
+	"github.com/spf13/afero"
+
 	"github.com/Peripli/service-manager-cli/internal/configuration"
 	"github.com/Peripli/service-manager-cli/pkg/smclient"
 )
@@ -38,5 +40,42 @@ type Context struct {
 
 	Configuration configuration.Configuration
 
+	// Target is the name of the login target selected for this invocation. If empty, the current target is used.
+	Target string
+
 	Parameters query.Parameters
+
+	Wait WaitOptions
+
+	Watch WatchOptions
+
+	// ShowCredentials disables the masking of credentials in the output
+	ShowCredentials bool
+
+	// Retries is the maximum number of retries of idempotent requests failing with transient errors
+	Retries int
+
+	Paging PagingOptions
+
+	// Fs is the file system of the files given in the flags common to all commands, e.g. --template-file,
+	// and of the completion cache
+	Fs afero.Fs
+
+	// CompletionCacheDir is the directory where shell completion candidates are cached. Nothing is cached if it is empty or Fs is nil.
+	CompletionCacheDir string
+
+	// newConfiguration creates the configuration according to the global flags, if the context has none
+	newConfiguration func() (configuration.Configuration, error)
+}
+
+func (ctx *Context) ensureConfiguration() error {
+	if ctx.Configuration != nil || ctx.newConfiguration == nil {
+		return nil
+	}
+	configuration, err := ctx.newConfiguration()
+	if err != nil {
+		return err
+	}
+	ctx.Configuration = configuration
+	return nil
 }
@@ -107,7 +107,7 @@ func (c *Cmd) Run() error {
 		reader = bytes.NewReader([]byte(c.body))
 	}
 
-	resp, err := c.Client.Call(c.method, c.path, reader, &c.Parameters)
+	resp, err := c.Client.Call(c.Ctx, c.method, c.path, reader, &c.Parameters)
 	if err != nil {
 		return err
 	}
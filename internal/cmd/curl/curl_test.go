@@ -55,7 +55,7 @@ var _ = Describe("Curl command test", func() {
 		Expect(buffer.String()).To(Equal(expectedOutput))
 
 		lastCallIndex := client.CallCallCount() - 1
-		method, path, reader, _ := client.CallArgsForCall(lastCallIndex)
+		_, method, path, reader, _ := client.CallArgsForCall(lastCallIndex)
 		Expect(method).To(Equal(expectedMethod))
 		Expect(path).To(Equal(expectedPath))
 		if expectedMethod != http.MethodGet {
@@ -104,7 +104,7 @@ var _ = Describe("Curl command test", func() {
 				err := executeWithArgs(cmdArgs)
 				Expect(err).ToNot(HaveOccurred())
 
-				_, _, _, args := client.CallArgsForCall(0)
+				_, _, _, _, args := client.CallArgsForCall(0)
 
 				Expect(args.GeneralParams).To(ConsistOf(param))
 				Expect(args.FieldQuery).To(BeEmpty())
@@ -0,0 +1,200 @@
+/*
+ * Copyright 2018 The Service Manager Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Peripli/service-manager-cli/internal/output"
+	"github.com/Peripli/service-manager-cli/pkg/auth/oidc"
+	"github.com/Peripli/service-manager-cli/pkg/smclient"
+)
+
+// Exit codes of smctl. Every error kind has its own exit code.
+const (
+	ExitCodeError           = 1
+	ExitCodeValidation      = 2
+	ExitCodeNotFound        = 3
+	ExitCodeConflict        = 4
+	ExitCodeUnauthorized    = 5
+	ExitCodeOperationFailed = 6
+	ExitCodeTimeout         = 7
+	ExitCodeInterrupted     = 130
+)
+
+// ErrorKind classifies the errors of commands
+type ErrorKind string
+
+const (
+	// ErrorKindError is any error which has no specific kind
+	ErrorKindError ErrorKind = "Error"
+	// ErrorKindValidation is an invalid command line or a request rejected by Service Manager as invalid
+	ErrorKindValidation ErrorKind = "ValidationError"
+	// ErrorKindNotFound is a missing resource
+	ErrorKindNotFound ErrorKind = "NotFound"
+	// ErrorKindConflict is a conflict with the current state of a resource
+	ErrorKindConflict ErrorKind = "Conflict"
+	// ErrorKindUnauthorized is a missing or expired login
+	ErrorKindUnauthorized ErrorKind = "Unauthorized"
+	// ErrorKindOperationFailed is an asynchronous operation which completed in failed state
+	ErrorKindOperationFailed ErrorKind = "OperationFailed"
+	// ErrorKindTimeout is an asynchronous operation which did not complete in time
+	ErrorKindTimeout ErrorKind = "Timeout"
+	// ErrorKindInterrupted is a command aborted by SIGINT or SIGTERM
+	ErrorKindInterrupted ErrorKind = "Interrupted"
+)
+
+var exitCodes = map[ErrorKind]int{
+	ErrorKindError:           ExitCodeError,
+	ErrorKindValidation:      ExitCodeValidation,
+	ErrorKindNotFound:        ExitCodeNotFound,
+	ErrorKindConflict:        ExitCodeConflict,
+	ErrorKindUnauthorized:    ExitCodeUnauthorized,
+	ErrorKindOperationFailed: ExitCodeOperationFailed,
+	ErrorKindTimeout:         ExitCodeTimeout,
+	ErrorKindInterrupted:     ExitCodeInterrupted,
+}
+
+// ExitCode returns the exit code for errors of the kind
+func (k ErrorKind) ExitCode() int {
+	if code, ok := exitCodes[k]; ok {
+		return code
+	}
+	return ExitCodeError
+}
+
+// Error is a command error of a known kind
+type Error struct {
+	Kind ErrorKind
+	Err  error
+}
+
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// NewError returns an error of the provided kind. The message is formatted like fmt.Errorf does.
+func NewError(kind ErrorKind, format string, a ...interface{}) error {
+	return &Error{Kind: kind, Err: fmt.Errorf(format, a...)}
+}
+
+func newValidationError(err error) error {
+	return &Error{Kind: ErrorKindValidation, Err: err}
+}
+
+// ExitStatusError makes smctl exit with Code without printing an error, e.g. to pass on the exit code of a child process
+type ExitStatusError struct {
+	Code int
+}
+
+func (e *ExitStatusError) Error() string {
+	return fmt.Sprintf("exit status %d", e.Code)
+}
+
+// KindOf returns the kind of err
+func KindOf(err error) ErrorKind {
+	var cmdErr *Error
+	if errors.As(err, &cmdErr) {
+		return cmdErr.Kind
+	}
+	if errors.Is(err, context.Canceled) {
+		return ErrorKindInterrupted
+	}
+	if errors.Is(err, oidc.ErrTokenExpired) {
+		return ErrorKindUnauthorized
+	}
+	// the output template provided by the user does not fit the printed object
+	var templateErr *output.TemplateError
+	if errors.As(err, &templateErr) {
+		return ErrorKindValidation
+	}
+
+	var apiErr *smclient.APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.StatusCode {
+		case http.StatusBadRequest:
+			return ErrorKindValidation
+		case http.StatusUnauthorized:
+			return ErrorKindUnauthorized
+		case http.StatusNotFound:
+			return ErrorKindNotFound
+		case http.StatusConflict:
+			return ErrorKindConflict
+		}
+	}
+	return ErrorKindError
+}
+
+// errorObject is the machine-readable representation of a command error
+type errorObject struct {
+	Error         ErrorKind `json:"error" yaml:"error"`
+	Description   string    `json:"description" yaml:"description"`
+	ExitCode      int       `json:"exit_code" yaml:"exit_code"`
+	StatusCode    int       `json:"status_code,omitempty" yaml:"status_code,omitempty"`
+	ErrorCode     string    `json:"error_code,omitempty" yaml:"error_code,omitempty"`
+	CorrelationID string    `json:"correlation_id,omitempty" yaml:"correlation_id,omitempty"`
+}
+
+// PrintCommandError prints err as a json or yaml object if the output format of the failed command is json or yaml.
+// Otherwise it prints err as text. It returns the exit code for err.
+func PrintCommandError(wr io.Writer, c *cobra.Command, err error) int {
+	kind := KindOf(err)
+	// errors of aborted requests do not always keep context.Canceled in their chain
+	if ctx := c.Context(); ctx != nil && ctx.Err() != nil {
+		kind = ErrorKindInterrupted
+	}
+
+	errObject := &errorObject{
+		Error:       kind,
+		Description: err.Error(),
+		ExitCode:    kind.ExitCode(),
+	}
+	var apiErr *smclient.APIError
+	if errors.As(err, &apiErr) {
+		errObject.StatusCode = apiErr.StatusCode
+		errObject.ErrorCode = apiErr.ErrorCode
+		errObject.CorrelationID = apiErr.CorrelationID
+	}
+
+	if !output.PrintErrorObject(wr, commandOutputFormat(c), errObject) {
+		output.PrintError(wr, err)
+	}
+	return errObject.ExitCode
+}
+
+func commandOutputFormat(c *cobra.Command) output.Format {
+	flag := c.Flags().Lookup("output")
+	if flag == nil {
+		return output.FormatText
+	}
+	format, err := output.ParseFormat(flag.Value.String())
+	if err != nil {
+		return output.FormatText
+	}
+	return format
+}
@@ -0,0 +1,367 @@
+/*
+ * Copyright 2018 The Service Manager Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/Peripli/service-manager/pkg/log"
+	smtypes "github.com/Peripli/service-manager/pkg/types"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/Peripli/service-manager-cli/internal/cmd"
+	"github.com/Peripli/service-manager-cli/internal/output"
+	"github.com/Peripli/service-manager-cli/pkg/query"
+	"github.com/Peripli/service-manager-cli/pkg/types"
+)
+
+type platform struct {
+	Kind        string         `json:"kind" yaml:"kind"`
+	Name        string         `json:"name" yaml:"name"`
+	Type        string         `json:"type" yaml:"type"`
+	Description string         `json:"description,omitempty" yaml:"description,omitempty"`
+	Labels      smtypes.Labels `json:"labels,omitempty" yaml:"labels,omitempty"`
+}
+
+type broker struct {
+	Kind        string         `json:"kind" yaml:"kind"`
+	Name        string         `json:"name" yaml:"name"`
+	URL         string         `json:"broker_url" yaml:"broker_url"`
+	Description string         `json:"description,omitempty" yaml:"description,omitempty"`
+	Labels      smtypes.Labels `json:"labels,omitempty" yaml:"labels,omitempty"`
+}
+
+type visibility struct {
+	Kind     string         `json:"kind" yaml:"kind"`
+	Platform string         `json:"platform,omitempty" yaml:"platform,omitempty"`
+	Broker   string         `json:"broker" yaml:"broker"`
+	Offering string         `json:"offering" yaml:"offering"`
+	Plan     string         `json:"plan" yaml:"plan"`
+	Labels   smtypes.Labels `json:"labels,omitempty" yaml:"labels,omitempty"`
+}
+
+type instance struct {
+	Kind       string                 `json:"kind" yaml:"kind"`
+	Name       string                 `json:"name" yaml:"name"`
+	Broker     string                 `json:"broker" yaml:"broker"`
+	Offering   string                 `json:"offering" yaml:"offering"`
+	Plan       string                 `json:"plan" yaml:"plan"`
+	Parameters map[string]interface{} `json:"parameters,omitempty" yaml:"parameters,omitempty"`
+	Labels     smtypes.Labels         `json:"labels,omitempty" yaml:"labels,omitempty"`
+}
+
+type binding struct {
+	Kind        string                 `json:"kind" yaml:"kind"`
+	Name        string                 `json:"name" yaml:"name"`
+	Instance    string                 `json:"instance" yaml:"instance"`
+	Labels      smtypes.Labels         `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Credentials map[string]interface{} `json:"credentials,omitempty" yaml:"credentials,omitempty"`
+}
+
+// Cmd wraps the smctl export command
+type Cmd struct {
+	*cmd.Context
+
+	kinds        []string
+	outputFormat output.Format
+
+	platforms map[string]string
+	brokers   map[string]string
+	offerings map[string]types.ServiceOffering
+	plans     map[string]types.ServicePlan
+}
+
+// NewExportCmd returns new export command with context
+func NewExportCmd(context *cmd.Context) *Cmd {
+	return &Cmd{Context: context}
+}
+
+// Prepare returns cobra command
+func (ec *Cmd) Prepare(prepare cmd.PrepareFunc) *cobra.Command {
+	result := &cobra.Command{
+		Use:   "export",
+		Short: "Exports resources into a manifest",
+		Long: `Exports platforms, brokers, visibilities, instances and bindings into a manifest which can be applied with smctl apply.
+Binding credentials are masked unless --show-credentials is used.`,
+
+		PreRunE: prepare(ec, ec.Context),
+		RunE:    cmd.RunE(ec),
+	}
+
+	result.Flags().StringSliceVarP(&ec.kinds, "kind", "k", cmd.ManifestKinds, "Kinds of resources to export")
+	cmd.AddShowCredentialsFlag(result.Flags(), &ec.ShowCredentials)
+	cmd.AddFormatFlagDefault(result.Flags(), "yaml")
+	cmd.AddQueryingFlags(result.Flags(), &ec.Parameters)
+	cmd.AddCommonQueryFlag(result.Flags(), &ec.Parameters)
+
+	return result
+}
+
+// Validate validates command's arguments
+func (ec *Cmd) Validate(args []string) error {
+	for _, kind := range ec.kinds {
+		if !contains(cmd.ManifestKinds, kind) {
+			return fmt.Errorf("unsupported kind %q, supported kinds are %s", kind, strings.Join(cmd.ManifestKinds, ", "))
+		}
+	}
+	// the fields differ per kind, labels are supported by all kinds
+	if len(ec.Parameters.FieldQuery) > 0 && len(ec.kinds) != 1 {
+		return fmt.Errorf("--field-query requires a single --kind, as the fields differ per kind")
+	}
+	return nil
+}
+
+// SetOutputFormat set output format
+func (ec *Cmd) SetOutputFormat(format output.Format) {
+	ec.outputFormat = format
+}
+
+// HideUsage hide command's usage
+func (ec *Cmd) HideUsage() bool {
+	return true
+}
+
+// Run runs the command's logic
+func (ec *Cmd) Run() error {
+	if ec.outputFormat != output.FormatYAML && ec.outputFormat != output.FormatJSON {
+		return fmt.Errorf("only yaml and json outputs are supported")
+	}
+
+	var resources []interface{}
+	for _, kind := range cmd.ManifestKinds {
+		if !contains(ec.kinds, kind) {
+			continue
+		}
+		exported, err := ec.export(kind)
+		if err != nil {
+			return fmt.Errorf("could not export %ss: %s", kind, err)
+		}
+		resources = append(resources, exported...)
+	}
+
+	if ec.outputFormat == output.FormatJSON {
+		if resources == nil {
+			resources = []interface{}{}
+		}
+		content, err := json.MarshalIndent(resources, "", "  ")
+		if err != nil {
+			return err
+		}
+		output.PrintMessage(ec.Output, "%s\n", content)
+		return nil
+	}
+
+	encoder := yaml.NewEncoder(ec.Output)
+	encoder.SetIndent(2)
+	for _, resource := range resources {
+		if err := encoder.Encode(resource); err != nil {
+			return err
+		}
+	}
+	return encoder.Close()
+}
+
+func (ec *Cmd) export(kind string) ([]interface{}, error) {
+	switch kind {
+	case cmd.ManifestKindPlatform:
+		return ec.exportPlatforms()
+	case cmd.ManifestKindBroker:
+		return ec.exportBrokers()
+	case cmd.ManifestKindVisibility:
+		return ec.exportVisibilities()
+	case cmd.ManifestKindInstance:
+		return ec.exportInstances()
+	default:
+		return ec.exportBindings()
+	}
+}
+
+func (ec *Cmd) exportPlatforms() ([]interface{}, error) {
+	platforms, err := ec.Client.ListPlatforms(ec.Ctx, ec.filteredQuery())
+	if err != nil {
+		return nil, err
+	}
+	var result []interface{}
+	for _, p := range platforms.Platforms {
+		if p.ID == smtypes.SMPlatform {
+			continue
+		}
+		result = append(result, &platform{Kind: cmd.ManifestKindPlatform, Name: p.Name, Type: p.Type, Description: p.Description, Labels: p.Labels})
+	}
+	return result, nil
+}
+
+func (ec *Cmd) exportBrokers() ([]interface{}, error) {
+	brokers, err := ec.Client.ListBrokers(ec.Ctx, ec.filteredQuery())
+	if err != nil {
+		return nil, err
+	}
+	var result []interface{}
+	for _, b := range brokers.Brokers {
+		result = append(result, &broker{Kind: cmd.ManifestKindBroker, Name: b.Name, URL: b.URL, Description: b.Description, Labels: b.Labels})
+	}
+	return result, nil
+}
+
+func (ec *Cmd) exportVisibilities() ([]interface{}, error) {
+	if err := ec.loadCatalog(); err != nil {
+		return nil, err
+	}
+	if err := ec.loadPlatforms(); err != nil {
+		return nil, err
+	}
+	visibilities, err := ec.Client.ListVisibilities(ec.Ctx, ec.filteredQuery())
+	if err != nil {
+		return nil, err
+	}
+
+	var result []interface{}
+	for _, v := range visibilities.Visibilities {
+		exported := &visibility{Kind: cmd.ManifestKindVisibility, Platform: ec.platforms[v.PlatformID], Labels: v.Labels}
+		exported.Broker, exported.Offering, exported.Plan = ec.planNames(v.ServicePlanID)
+		result = append(result, exported)
+	}
+	return result, nil
+}
+
+func (ec *Cmd) exportInstances() ([]interface{}, error) {
+	if err := ec.loadCatalog(); err != nil {
+		return nil, err
+	}
+	instances, err := ec.Client.ListInstances(ec.Ctx, ec.filteredQuery())
+	if err != nil {
+		return nil, err
+	}
+
+	var result []interface{}
+	for _, i := range instances.ServiceInstances {
+		exported := &instance{Kind: cmd.ManifestKindInstance, Name: i.Name, Labels: i.Labels}
+		exported.Broker, exported.Offering, exported.Plan = ec.planNames(i.ServicePlanID)
+		if exported.Parameters, err = ec.Client.GetInstanceParameters(ec.Ctx, i.ID, ec.query()); err != nil {
+			// parameters of instances of offerings which are not retrievable cannot be exported
+			log.D().Debugf("could not get parameters of instance %s: %s", i.Name, err)
+		}
+		result = append(result, exported)
+	}
+	return result, nil
+}
+
+func (ec *Cmd) exportBindings() ([]interface{}, error) {
+	instances, err := ec.Client.ListInstances(ec.Ctx, ec.query())
+	if err != nil {
+		return nil, err
+	}
+	instanceNames := make(map[string]string)
+	for _, i := range instances.ServiceInstances {
+		instanceNames[i.ID] = i.Name
+	}
+	bindings, err := ec.Client.ListBindings(ec.Ctx, ec.filteredQuery())
+	if err != nil {
+		return nil, err
+	}
+
+	var result []interface{}
+	for _, b := range bindings.ServiceBindings {
+		exported := &binding{Kind: cmd.ManifestKindBinding, Name: b.Name, Instance: instanceNames[b.ServiceInstanceID], Labels: b.Labels}
+		if credentials := cmd.Printable(ec.Context, &b).(*types.ServiceBinding).Credentials; len(credentials) > 0 {
+			if err := json.Unmarshal(credentials, &exported.Credentials); err != nil {
+				return nil, err
+			}
+		}
+		result = append(result, exported)
+	}
+	return result, nil
+}
+
+// loadCatalog loads all brokers, offerings and plans to resolve the names of plans referenced by visibilities and instances
+func (ec *Cmd) loadCatalog() error {
+	if ec.plans != nil {
+		return nil
+	}
+
+	brokers, err := ec.Client.ListBrokers(ec.Ctx, ec.query())
+	if err != nil {
+		return err
+	}
+	offerings, err := ec.Client.ListOfferings(ec.Ctx, ec.query())
+	if err != nil {
+		return err
+	}
+	plans, err := ec.Client.ListPlans(ec.Ctx, ec.query())
+	if err != nil {
+		return err
+	}
+
+	ec.brokers = make(map[string]string)
+	for _, b := range brokers.Brokers {
+		ec.brokers[b.ID] = b.Name
+	}
+	ec.offerings = make(map[string]types.ServiceOffering)
+	for _, o := range offerings.ServiceOfferings {
+		ec.offerings[o.ID] = o
+	}
+	ec.plans = make(map[string]types.ServicePlan)
+	for _, p := range plans.ServicePlans {
+		ec.plans[p.ID] = p
+	}
+	return nil
+}
+
+func (ec *Cmd) loadPlatforms() error {
+	platforms, err := ec.Client.ListPlatforms(ec.Ctx, ec.query())
+	if err != nil {
+		return err
+	}
+	ec.platforms = make(map[string]string)
+	for _, p := range platforms.Platforms {
+		ec.platforms[p.ID] = p.Name
+	}
+	return nil
+}
+
+// planNames returns the names of the broker, offering and plan of the plan with the given ID
+func (ec *Cmd) planNames(planID string) (string, string, string) {
+	plan := ec.plans[planID]
+	offering := ec.offerings[plan.ServiceOfferingID]
+	return ec.brokers[offering.BrokerID], offering.Name, plan.Name
+}
+
+// filteredQuery returns the query for the exported resources, including the field and label queries.
+// Field queries are only used when a single kind is exported.
+func (ec *Cmd) filteredQuery() *query.Parameters {
+	return &query.Parameters{
+		FieldQuery:    ec.Parameters.FieldQuery,
+		LabelQuery:    ec.Parameters.LabelQuery,
+		GeneralParams: ec.Parameters.GeneralParams,
+	}
+}
+
+func (ec *Cmd) query() *query.Parameters {
+	return &query.Parameters{GeneralParams: ec.Parameters.GeneralParams}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,178 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+
+	smtypes "github.com/Peripli/service-manager/pkg/types"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/Peripli/service-manager-cli/internal/cmd"
+	"github.com/Peripli/service-manager-cli/pkg/query"
+	"github.com/Peripli/service-manager-cli/pkg/smclient/smclientfakes"
+	"github.com/Peripli/service-manager-cli/pkg/types"
+)
+
+var _ = Describe("Export command test", func() {
+	var client *smclientfakes.FakeClient
+	var command *Cmd
+	var buffer *bytes.Buffer
+
+	executeWithArgs := func(args ...string) error {
+		commandToRun := command.Prepare(cmd.SmPrepare)
+		commandToRun.SetArgs(args)
+		return commandToRun.Execute()
+	}
+
+	BeforeEach(func() {
+		buffer = &bytes.Buffer{}
+		client = &smclientfakes.FakeClient{}
+		context := &cmd.Context{Output: buffer, Client: client}
+		command = NewExportCmd(context)
+
+		client.ListPlatformsReturns(&types.Platforms{Platforms: []types.Platform{
+			{ID: smtypes.SMPlatform, Name: "service-manager", Type: "service-manager"},
+			{ID: "platform-id", Name: "cf-eu", Type: "cloudfoundry", Description: "CF EU"},
+		}}, nil)
+		client.ListBrokersReturns(&types.Brokers{Brokers: []types.Broker{{ID: "broker-id", Name: "my-broker", URL: "https://broker.com"}}}, nil)
+		client.ListOfferingsReturns(&types.ServiceOfferings{ServiceOfferings: []types.ServiceOffering{{ID: "offering-id", Name: "my-service", BrokerID: "broker-id"}}}, nil)
+		client.ListPlansReturns(&types.ServicePlans{ServicePlans: []types.ServicePlan{{ID: "plan-id", Name: "small", ServiceOfferingID: "offering-id"}}}, nil)
+		client.ListVisibilitiesReturns(&types.Visibilities{Visibilities: []types.Visibility{{ID: "visibility-id", PlatformID: "platform-id", ServicePlanID: "plan-id"}}}, nil)
+		client.ListInstancesReturns(&types.ServiceInstances{ServiceInstances: []types.ServiceInstance{{ID: "instance-id", Name: "my-instance", ServicePlanID: "plan-id"}}}, nil)
+		client.GetInstanceParametersReturns(map[string]interface{}{"size": 10}, nil)
+		client.ListBindingsReturns(&types.ServiceBindings{ServiceBindings: []types.ServiceBinding{{
+			ID:                "binding-id",
+			Name:              "my-binding",
+			ServiceInstanceID: "instance-id",
+			Credentials:       json.RawMessage(`{"password": "secret"}`),
+		}}}, nil)
+	})
+
+	Context("when exporting all resources", func() {
+		It("should print a manifest in yaml", func() {
+			err := executeWithArgs()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buffer.String()).To(Equal(`kind: platform
+name: cf-eu
+type: cloudfoundry
+description: CF EU
+---
+kind: broker
+name: my-broker
+broker_url: https://broker.com
+---
+kind: visibility
+platform: cf-eu
+broker: my-broker
+offering: my-service
+plan: small
+---
+kind: instance
+name: my-instance
+broker: my-broker
+offering: my-service
+plan: small
+parameters:
+  size: 10
+---
+kind: binding
+name: my-binding
+instance: my-instance
+credentials:
+  password: '[REDACTED]'
+`))
+		})
+
+		It("should print a manifest in json", func() {
+			err := executeWithArgs("-o", "json", "--kind", "broker,binding")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buffer.String()).To(MatchJSON(`[
+				{"kind": "broker", "name": "my-broker", "broker_url": "https://broker.com"},
+				{"kind": "binding", "name": "my-binding", "instance": "my-instance", "credentials": {"password": "[REDACTED]"}}
+			]`))
+		})
+	})
+
+	Context("when credentials should be shown", func() {
+		It("should include binding credentials", func() {
+			err := executeWithArgs("-o", "json", "--kind", "binding", "--show-credentials")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buffer.String()).To(MatchJSON(`[{"kind": "binding", "name": "my-binding", "instance": "my-instance", "credentials": {"password": "secret"}}]`))
+		})
+	})
+
+	Context("when instance parameters cannot be retrieved", func() {
+		It("should export the instance without parameters", func() {
+			client.GetInstanceParametersReturns(nil, errors.New("not retrievable"))
+			err := executeWithArgs("--kind", "instance")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buffer.String()).ToNot(ContainSubstring("parameters"))
+		})
+	})
+
+	Context("when filters are used", func() {
+		It("should apply them to the exported resources only", func() {
+			err := executeWithArgs("--kind", "instance", "-l", "team eq 'a'", "--param", "key=value")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, instancesQuery := client.ListInstancesArgsForCall(0)
+			Expect(*instancesQuery).To(Equal(query.Parameters{
+				LabelQuery:    []string{"team eq 'a'"},
+				GeneralParams: []string{"key=value"},
+			}))
+			_, plansQuery := client.ListPlansArgsForCall(0)
+			Expect(*plansQuery).To(Equal(query.Parameters{GeneralParams: []string{"key=value"}}))
+		})
+
+		It("should apply field queries to the single exported kind", func() {
+			err := executeWithArgs("--kind", "broker", "-f", "name eq 'my-broker'")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, brokersQuery := client.ListBrokersArgsForCall(0)
+			Expect(brokersQuery.FieldQuery).To(Equal([]string{"name eq 'my-broker'"}))
+		})
+
+		It("should reject field queries for several kinds", func() {
+			err := executeWithArgs("--kind", "instance,binding", "-f", "name eq 'my-instance'")
+			Expect(err).To(MatchError("--field-query requires a single --kind, as the fields differ per kind"))
+			Expect(cmd.KindOf(err)).To(Equal(cmd.ErrorKindValidation))
+			Expect(client.ListInstancesCallCount()).To(BeZero())
+		})
+
+		It("should apply label queries to every exported kind", func() {
+			err := executeWithArgs("--kind", "instance,binding", "-l", "team eq 'a'")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, instancesQuery := client.ListInstancesArgsForCall(0)
+			Expect(instancesQuery.LabelQuery).To(Equal([]string{"team eq 'a'"}))
+			_, bindingsQuery := client.ListBindingsArgsForCall(0)
+			Expect(bindingsQuery.LabelQuery).To(Equal([]string{"team eq 'a'"}))
+		})
+	})
+
+	Context("when the output format is not supported", func() {
+		It("should return error", func() {
+			err := executeWithArgs("-o", "text")
+			Expect(err).To(MatchError("only yaml and json outputs are supported"))
+		})
+	})
+
+	Context("when kind is not supported", func() {
+		It("should return error", func() {
+			err := executeWithArgs("--kind", "space")
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`unsupported kind "space"`))
+		})
+	})
+
+	Context("when listing fails", func() {
+		It("should return error", func() {
+			client.ListBrokersReturns(nil, errors.New("http error"))
+			err := executeWithArgs("--kind", "broker")
+			Expect(err).To(MatchError("could not export brokers: http error"))
+		})
+	})
+})
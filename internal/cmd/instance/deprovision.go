@@ -59,7 +59,7 @@ func (dbc *DeprovisionCmd) Validate(args []string) error {
 // Run runs the command's logic
 func (dbc *DeprovisionCmd) Run() error {
 	if dbc.id == "" {
-		toDeprovision, err := dbc.Client.ListInstances(&query.Parameters{
+		toDeprovision, err := dbc.Client.ListInstances(dbc.Ctx, &query.Parameters{
 			FieldQuery: []string{
 				fmt.Sprintf("name eq '%s'", dbc.name),
 			},
@@ -69,8 +69,7 @@ func (dbc *DeprovisionCmd) Run() error {
 			return err
 		}
 		if len(toDeprovision.ServiceInstances) < 1 {
-			output.PrintMessage(dbc.Output, "Service Instance not found.\n")
-			return nil
+			return cmd.NewError(cmd.ErrorKindNotFound, "service instance with name %s not found", dbc.name)
 		}
 		if len(toDeprovision.ServiceInstances) > 1 {
 			return fmt.Errorf("more than one service instance with name %s found. Use --id flag to specify id of the instance to be deleted", dbc.name)
@@ -83,14 +82,13 @@ func (dbc *DeprovisionCmd) Run() error {
 		dbc.Parameters.GeneralParams = append(dbc.Parameters.GeneralParams, fmt.Sprintf("%s=%s", web.QueryParamCascade, "true"))
 		dbc.Parameters.GeneralParams = append(dbc.Parameters.GeneralParams, fmt.Sprintf("%s=%s", web.QueryParamForce, "true"))
 	}
-	location, err := dbc.Client.Deprovision(dbc.id, &dbc.Parameters)
+	location, err := dbc.Client.Deprovision(dbc.Ctx, dbc.id, &dbc.Parameters)
 	if err != nil {
 		output.PrintMessage(dbc.Output, "Could not delete service instance. Reason: ")
 		return err
 	}
 	if len(location) != 0 {
-		cmd.CommonHandleAsyncExecution(dbc.Context, location, fmt.Sprintf("Service Instance %s successfully scheduled for deletion. To see status of the operation use:\n", dbc.name))
-		return nil
+		return cmd.CommonHandleAsyncExecution(dbc.Context, location, fmt.Sprintf("Service Instance %s successfully scheduled for deletion. To see status of the operation use:\n", dbc.name), output.FormatText)
 	}
 	output.PrintMessage(dbc.Output, "Service Instance successfully deleted.\n")
 	return nil
@@ -132,6 +130,9 @@ func (dbc *DeprovisionCmd) Prepare(prepare cmd.PrepareFunc) *cobra.Command {
 	result.Flags().StringVarP(&dbc.id, "id", "", "", "ID of the service instance. Required when name is ambiguous.")
 	cmd.AddCommonQueryFlag(result.Flags(), &dbc.Parameters)
 	cmd.AddModeFlag(result.Flags(), "async")
+	cmd.AddWaitFlags(result.Flags(), &dbc.Wait)
+	result.ValidArgsFunction = cmd.CompleteArgs(dbc.Context, cmd.InstanceNames)
+	cmd.CompleteFlag(result, "id", dbc.Context, cmd.InstanceIDs)
 
 	return result
 }
@@ -6,7 +6,7 @@ import (
 	"io/ioutil"
 	"net/http"
 
-	"github.com/Peripli/service-manager/pkg/util"
+	"github.com/Peripli/service-manager-cli/pkg/smclient"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -77,6 +77,19 @@ var _ = Describe("Deprovision command test", func() {
 		})
 	})
 
+	Context("when wait flag is used", func() {
+		It("should print the succeeded delete operation", func() {
+			client.DeprovisionReturns("location", nil)
+			client.StatusReturns(&types.Operation{ID: "op-id", Type: "delete", State: "succeeded", ResourceID: "1234", ResourceType: "/v1/service_instances"}, nil)
+			err := executeWithArgs("instance-name", "-f", "--wait")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(client.GetInstanceByIDCallCount()).To(Equal(0))
+			Expect(buffer.String()).To(ContainSubstring("succeeded"))
+			Expect(buffer.String()).ToNot(ContainSubstring("smctl status"))
+		})
+	})
+
 	Context("when generic parameter flag is used", func() {
 		It("should pass it to SM", func() {
 			client.DeprovisionReturns("", nil)
@@ -85,7 +98,7 @@ var _ = Describe("Deprovision command test", func() {
 			err := executeWithArgs("instance-name", "--param", param)
 			Expect(err).ShouldNot(HaveOccurred())
 
-			_, args := client.DeprovisionArgsForCall(0)
+			_, _, args := client.DeprovisionArgsForCall(0)
 
 			Expect(args.GeneralParams).To(ConsistOf(param, "async=true"))
 			Expect(args.FieldQuery).To(BeEmpty())
@@ -100,7 +113,7 @@ var _ = Describe("Deprovision command test", func() {
 			err := executeWithArgs("instance-name", "--force-delete")
 			Expect(err).ShouldNot(HaveOccurred())
 
-			_, args := client.DeprovisionArgsForCall(0)
+			_, _, args := client.DeprovisionArgsForCall(0)
 
 			cascadeParam := fmt.Sprintf("%s=%s", web.QueryParamCascade, "true")
 			forceParam := fmt.Sprintf("%s=%s", web.QueryParamForce, "true")
@@ -117,7 +130,7 @@ var _ = Describe("Deprovision command test", func() {
 			err := executeWithArgs("instance-name", "--force")
 			Expect(err).ShouldNot(HaveOccurred())
 
-			_, args := client.DeprovisionArgsForCall(0)
+			_, _, args := client.DeprovisionArgsForCall(0)
 
 			cascadeParam := fmt.Sprintf("%s=%s", web.QueryParamCascade, "true")
 			forceParam := fmt.Sprintf("%s=%s", web.QueryParamForce, "true")
@@ -135,7 +148,7 @@ var _ = Describe("Deprovision command test", func() {
 			err := executeWithArgs("instance-name", "--mode", "sync")
 			Expect(err).ShouldNot(HaveOccurred())
 
-			_, args := client.DeprovisionArgsForCall(0)
+			_, _, args := client.DeprovisionArgsForCall(0)
 
 			Expect(args.GeneralParams).To(ConsistOf("async=false"))
 			Expect(args.FieldQuery).To(BeEmpty())
@@ -150,8 +163,8 @@ var _ = Describe("Deprovision command test", func() {
 		It("should return message", func() {
 			err := executeWithArgs("non-existing-name", "-f")
 
-			Expect(err).ShouldNot(HaveOccurred())
-			Expect(buffer.String()).To(ContainSubstring("Service Instance not found"))
+			Expect(err).To(MatchError("service instance with name non-existing-name not found"))
+			Expect(cmd.KindOf(err)).To(Equal(cmd.ErrorKindNotFound))
 		})
 	})
 
@@ -170,7 +183,7 @@ var _ = Describe("Deprovision command test", func() {
 	Context("when SM returns error", func() {
 		It("should return error message", func() {
 			body := ioutil.NopCloser(bytes.NewReader([]byte("")))
-			expectedError := util.HandleResponseError(&http.Response{Body: body, StatusCode: http.StatusInternalServerError})
+			expectedError := smclient.NewAPIError(&http.Response{Body: body, StatusCode: http.StatusInternalServerError})
 			client.DeprovisionReturns("", expectedError)
 			err := executeWithArgs("name", "-f")
 
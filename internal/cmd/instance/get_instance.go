@@ -18,13 +18,13 @@ package instance
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/Peripli/service-manager-cli/pkg/types"
 
 	"github.com/Peripli/service-manager-cli/internal/cmd"
 	"github.com/Peripli/service-manager-cli/internal/output"
 	"github.com/Peripli/service-manager-cli/pkg/query"
+	"github.com/Peripli/service-manager-cli/pkg/smclient"
 	"github.com/spf13/cobra"
 )
 
@@ -43,54 +43,77 @@ func NewGetInstanceCmd(context *cmd.Context) *GetInstanceCmd {
 }
 
 // Run runs the command's logic
-func (gb *GetInstanceCmd) Run() error {	
-	instances, err := gb.Client.ListInstances(&query.Parameters{
+func (gb *GetInstanceCmd) Run() error {
+	if *gb.instanceParams {
+		instances, err := gb.listInstances()
+		if err != nil {
+			return err
+		}
+		return gb.printParameters(instances)
+	}
+	if gb.Watch.IsSet() {
+		return cmd.WatchObject(gb.Context, gb.outputFormat, gb.getInstances)
+	}
+
+	instances, err := gb.getInstances()
+	if err != nil {
+		return err
+	}
+	if err := output.PrintServiceManagerObject(gb.Output, gb.outputFormat, instances); err != nil {
+		return err
+	}
+	output.Println(gb.Output)
+	return nil
+}
+
+func (gb *GetInstanceCmd) listInstances() (*types.ServiceInstances, error) {
+	instances, err := gb.Client.ListInstances(gb.Ctx, &query.Parameters{
 		FieldQuery: []string{
 			fmt.Sprintf("name eq '%s'", gb.instanceName),
 		},
 		GeneralParams: gb.Parameters.GeneralParams,
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if len(instances.ServiceInstances) < 1 {
-		output.PrintMessage(gb.Output, "No instance found with name: %s", gb.instanceName)
-		return nil
+		return nil, cmd.NewError(cmd.ErrorKindNotFound, "no instance found with name: %s", gb.instanceName)
 	}
-	if *gb.instanceParams {
-		return gb.printParameters(instances)
+	return instances, nil
+}
+
+func (gb *GetInstanceCmd) getInstances() (types.ServiceManagerObject, error) {
+	instances, err := gb.listInstances()
+	if err != nil {
+		return nil, err
 	}
 
 	resultInstances := &types.ServiceInstances{Vertical: true}
 	for _, instance := range instances.ServiceInstances {
-		inst, err := gb.Client.GetInstanceByID(instance.ID, &gb.Parameters)
+		inst, err := gb.Client.GetInstanceByID(gb.Ctx, instance.ID, &gb.Parameters)
 		if err != nil {
 			// The instance could be deleted after List and before Get
-			if strings.Contains(err.Error(), "StatusCode: 404") {
+			if smclient.IsNotFound(err) {
 				continue
 			}
-			return err
+			return nil, err
 		}
 		resultInstances.ServiceInstances = append(resultInstances.ServiceInstances, *inst)
 	}
 
 	if len(resultInstances.ServiceInstances) < 1 {
-		output.PrintMessage(gb.Output, "No instance found with name: %s", gb.instanceName)
-		return nil
+		return nil, cmd.NewError(cmd.ErrorKindNotFound, "no instance found with name: %s", gb.instanceName)
 	}
-
-	output.PrintServiceManagerObject(gb.Output, gb.outputFormat, resultInstances)
-	output.Println(gb.Output)
-	return nil
+	return resultInstances, nil
 }
 
 func (gb *GetInstanceCmd) printParameters(instances *types.ServiceInstances) error {
 
 	for _, instance := range instances.ServiceInstances {
-		parameters, err := gb.Client.GetInstanceParameters(instance.ID, &gb.Parameters)
+		parameters, err := gb.Client.GetInstanceParameters(gb.Ctx, instance.ID, &gb.Parameters)
 		if err != nil {
 			// The instance could be deleted after List and before Get
-			if strings.Contains(err.Error(), "StatusCode: 404") {
+			if smclient.IsNotFound(err) {
 				continue
 			}
 			output.PrintMessage(gb.Output, "Unable to show configuration parameters for service instance id: %s\n", instance.ID)
@@ -119,6 +142,9 @@ func (gb *GetInstanceCmd) Validate(args []string) error {
 
 	gb.instanceName = args[0]
 
+	if gb.Watch.IsSet() && *gb.instanceParams {
+		return fmt.Errorf("--watch can't be combined with --show-instance-params")
+	}
 	return nil
 }
 
@@ -145,6 +171,8 @@ func (gb *GetInstanceCmd) Prepare(prepare cmd.PrepareFunc) *cobra.Command {
 	gb.instanceParams = result.PersistentFlags().Bool("show-instance-params", false, "Show the service instance configuration parameters")
 	cmd.AddFormatFlag(result.Flags())
 	cmd.AddCommonQueryFlag(result.Flags(), &gb.Parameters)
+	cmd.AddWatchFlag(result.Flags(), &gb.Watch)
+	result.ValidArgsFunction = cmd.CompleteArgs(gb.Context, cmd.InstanceNames)
 
 	return result
 }
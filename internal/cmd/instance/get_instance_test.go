@@ -2,6 +2,9 @@ package instance
 
 import (
 	"bytes"
+	"strings"
+
+	"github.com/spf13/afero"
 	"github.com/Peripli/service-manager-cli/internal/output"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -9,6 +12,7 @@ import (
 	"github.com/Peripli/service-manager-cli/internal/cmd"
 	"github.com/Peripli/service-manager-cli/pkg/smclient/smclientfakes"
 	"github.com/Peripli/service-manager-cli/pkg/types"
+	smtypes "github.com/Peripli/service-manager/pkg/types"
 )
 
 var _ = Describe("Get instance command test", func() {
@@ -74,8 +78,8 @@ var _ = Describe("Get instance command test", func() {
 				client.ListInstancesReturns(&types.ServiceInstances{}, nil)
 				err := executeWithArgs("unknown")
 
-				Expect(err).ShouldNot(HaveOccurred())
-				Expect(buffer.String()).To(ContainSubstring("No instance found with name: unknown"))
+				Expect(err).To(MatchError("no instance found with name: unknown"))
+				Expect(cmd.KindOf(err)).To(Equal(cmd.ErrorKindNotFound))
 			})
 		})
 
@@ -91,6 +95,70 @@ var _ = Describe("Get instance command test", func() {
 		})
 	})
 
+	Describe("Get service instance with template output", func() {
+		BeforeEach(func() {
+			client.GetInstanceByIDReturns(&instance, nil)
+		})
+
+		It("should print the jsonpath result", func() {
+			err := executeWithArgs("instance1", "-o", "jsonpath={.items[0].id}")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buffer.String()).To(HavePrefix("id1"))
+		})
+
+		It("should print the go-template result", func() {
+			err := executeWithArgs("instance1", "-o", "go-template={{range .items}}{{.name}}:{{.platform_id}}{{end}}")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buffer.String()).To(HavePrefix("instance1:platformID1"))
+		})
+
+		It("should read the template from a file", func() {
+			command.Fs = afero.NewMemMapFs()
+			Expect(afero.WriteFile(command.Fs, "template.txt", []byte("{range .items[*]}{.name}{end}"), 0644)).To(Succeed())
+
+			err := executeWithArgs("instance1", "-o", "jsonpath", "--template-file", "template.txt")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buffer.String()).To(HavePrefix("instance1"))
+		})
+
+		It("should return error for missing template file", func() {
+			command.Fs = afero.NewMemMapFs()
+
+			err := executeWithArgs("instance1", "-o", "jsonpath", "--template-file", "template.txt")
+
+			Expect(err).To(MatchError(ContainSubstring("could not read template file")))
+			Expect(cmd.KindOf(err)).To(Equal(cmd.ErrorKindValidation))
+		})
+
+		It("should return error when the go-template fails for the instance", func() {
+			err := executeWithArgs("instance1", "-o", `go-template={{template "missing"}}`)
+
+			Expect(err).To(MatchError(ContainSubstring("error executing go-template")))
+			Expect(cmd.KindOf(err)).To(Equal(cmd.ErrorKindValidation))
+		})
+
+		It("should return error for invalid jsonpath", func() {
+			err := executeWithArgs("instance1", "-o", "jsonpath={.items[}")
+
+			Expect(err).To(MatchError(ContainSubstring("invalid jsonpath")))
+		})
+
+		It("should return error for invalid go-template", func() {
+			err := executeWithArgs("instance1", "-o", "go-template={{.name")
+
+			Expect(err).To(MatchError(ContainSubstring("invalid go-template")))
+		})
+
+		It("should return error when template file is used with another format", func() {
+			err := executeWithArgs("instance1", "-o", "json", "--template-file", "template.txt")
+
+			Expect(err).To(MatchError("--template-file can be used only with -o go-template or -o jsonpath"))
+		})
+	})
+
 	Describe("Get service instance parameters", func() {
 		instanceParameters1 := map[string]interface{}{"param1":"value1","param2":"value2"}
 		instanceParameters2 := make(map[string]interface{})
@@ -108,8 +176,8 @@ var _ = Describe("Get instance command test", func() {
 				client.ListInstancesReturns(&types.ServiceInstances{}, nil)
 				err := executeWithArgs("unknown", "--show-instance-params")
 
-				Expect(err).ShouldNot(HaveOccurred())
-				Expect(buffer.String()).To(ContainSubstring("No instance found with name: unknown"))
+				Expect(err).To(MatchError("no instance found with name: unknown"))
+				Expect(cmd.KindOf(err)).To(Equal(cmd.ErrorKindNotFound))
 			})
 		})
 
@@ -152,4 +220,34 @@ var _ = Describe("Get instance command test", func() {
 			})
 		})
 	})
+
+	Describe("Watch service instance", func() {
+		It("should stop with a deleted event when the instance is deleted", func() {
+			deleting := instance
+			deleting.LastOperation = &smtypes.Operation{Type: smtypes.DELETE, State: smtypes.IN_PROGRESS}
+			client.GetInstanceByIDReturns(&deleting, nil)
+			client.ListInstancesReturnsOnCall(1, &types.ServiceInstances{}, nil)
+
+			Expect(executeWithArgs("instance1", "--watch=1ms")).To(Succeed())
+
+			lines := strings.Split(strings.TrimSpace(buffer.String()), "\n")
+			Expect(lines).To(HaveLen(2))
+			Expect(lines[0]).To(HavePrefix(`{"type":"ADDED","object":{"id":"id1"`))
+			Expect(lines[1]).To(HavePrefix(`{"type":"DELETED","object":{"id":"id1"`))
+		})
+
+		It("should fail if the instance does not exist", func() {
+			client.ListInstancesReturns(&types.ServiceInstances{}, nil)
+
+			err := executeWithArgs("instance1", "--watch")
+
+			Expect(err).To(MatchError("no instance found with name: instance1"))
+		})
+
+		It("should fail with --show-instance-params", func() {
+			err := executeWithArgs("instance1", "--watch", "--show-instance-params")
+
+			Expect(err).To(MatchError("--watch can't be combined with --show-instance-params"))
+		})
+	})
 })
@@ -19,6 +19,7 @@ package instance
 import (
 	"github.com/Peripli/service-manager-cli/internal/cmd"
 	"github.com/Peripli/service-manager-cli/internal/output"
+	"github.com/Peripli/service-manager-cli/pkg/types"
 	"github.com/spf13/cobra"
 )
 
@@ -36,12 +37,26 @@ func NewListInstancesCmd(context *cmd.Context) *ListInstancesCmd {
 
 // Run runs the command's logic
 func (li *ListInstancesCmd) Run() error {
-	instances, err := li.Client.ListInstances(&li.Parameters)
+	if li.Watch.IsSet() {
+		return cmd.WatchList(li.Context, li.outputFormat, func() (types.ServiceManagerObject, error) {
+			return li.Client.ListInstances(li.Ctx, &li.Parameters)
+		})
+	}
+	if li.Paging.IsSet() {
+		return cmd.PrintPages(li.Context, li.Client.ListInstancesPaged(li.Ctx, &li.Parameters, &li.Paging.PageOptions).Pager, li.outputFormat, func() (types.ServiceManagerObject, interface{}) {
+			instances := &types.ServiceInstances{}
+			return instances, &instances.ServiceInstances
+		}, nil)
+	}
+
+	instances, err := li.Client.ListInstances(li.Ctx, &li.Parameters)
 	if err != nil {
 		return err
 	}
 
-	output.PrintServiceManagerObject(li.Output, li.outputFormat, instances)
+	if err := output.PrintServiceManagerObject(li.Output, li.outputFormat, instances); err != nil {
+		return err
+	}
 	output.Println(li.Output)
 
 	return nil
@@ -71,6 +86,8 @@ func (li *ListInstancesCmd) Prepare(prepare cmd.PrepareFunc) *cobra.Command {
 	cmd.AddFormatFlag(result.Flags())
 	cmd.AddQueryingFlags(result.Flags(), &li.Parameters)
 	cmd.AddCommonQueryFlag(result.Flags(), &li.Parameters)
+	cmd.AddPagingFlags(result.Flags(), &li.Paging)
+	cmd.AddListWatchFlag(result.Flags(), &li.Watch)
 
 	return result
 }
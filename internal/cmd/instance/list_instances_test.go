@@ -18,11 +18,17 @@ package instance
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
+	"strings"
 	"github.com/Peripli/service-manager-cli/internal/cmd"
+	"github.com/Peripli/service-manager-cli/pkg/query"
+	"github.com/Peripli/service-manager-cli/pkg/smclient"
 	"github.com/Peripli/service-manager-cli/pkg/smclient/smclientfakes"
 	"github.com/Peripli/service-manager-cli/pkg/types"
+	smtypes "github.com/Peripli/service-manager/pkg/types"
+	"github.com/Peripli/service-manager/pkg/web"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"gopkg.in/yaml.v3"
@@ -100,7 +106,7 @@ var _ = Describe("List instances command test", func() {
 			err := executeWithArgs([]string{"--param", param})
 			Expect(err).ShouldNot(HaveOccurred())
 
-			args := client.ListInstancesArgsForCall(0)
+			_, args := client.ListInstancesArgsForCall(0)
 
 			Expect(args.GeneralParams).To(ConsistOf(param))
 			Expect(args.FieldQuery).To(BeEmpty())
@@ -115,7 +121,7 @@ var _ = Describe("List instances command test", func() {
 			param := "name eq 'instance1'"
 			err := executeWithArgs([]string{"--field-query", param})
 
-			args := client.ListInstancesArgsForCall(0)
+			_, args := client.ListInstancesArgsForCall(0)
 
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(args.FieldQuery).To(ConsistOf(param))
@@ -130,7 +136,7 @@ var _ = Describe("List instances command test", func() {
 			param := "test eq false"
 			err := executeWithArgs([]string{"--label-query", param})
 
-			args := client.ListInstancesArgsForCall(0)
+			_, args := client.ListInstancesArgsForCall(0)
 
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(args.FieldQuery).To(BeEmpty())
@@ -164,6 +170,81 @@ var _ = Describe("List instances command test", func() {
 		})
 	})
 
+	Context("when paging flags are used", func() {
+		BeforeEach(func() {
+			client.ListInstancesPagedStub = func(ctx context.Context, q *query.Parameters, options *smclient.PageOptions) *smclient.InstancesPager {
+				return &smclient.InstancesPager{Pager: smclient.NewPager(ctx, client, web.ServiceInstancesURL, q, options)}
+			}
+			client.ListPageStub = func(_ context.Context, url string, q *query.Parameters, pageSize int, pageToken string, items interface{}) (string, error) {
+				instances := items.(*[]types.ServiceInstance)
+				if pageToken == "" {
+					*instances = []types.ServiceInstance{instance1}
+					return "page2", nil
+				}
+				*instances = []types.ServiceInstance{instance2}
+				return "", nil
+			}
+		})
+
+		It("should load all pages with the requested page size", func() {
+			err := executeWithArgs([]string{"--page-size", "1"})
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(client.ListPageCallCount()).To(Equal(2))
+			_, url, _, pageSize, _, _ := client.ListPageArgsForCall(0)
+			Expect(url).To(Equal(web.ServiceInstancesURL))
+			Expect(pageSize).To(Equal(1))
+			result := &types.ServiceInstances{ServiceInstances: []types.ServiceInstance{instance1, instance2}}
+			Expect(buffer.String()).To(ContainSubstring(result.TableData().String()))
+			Expect(buffer.String()).ToNot(ContainSubstring("--page-token"))
+		})
+
+		It("should stop after max items and print the next page token", func() {
+			err := executeWithArgs([]string{"--max-items", "1"})
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(client.ListPageCallCount()).To(Equal(1))
+			Expect(buffer.String()).To(ContainSubstring("instance1"))
+			Expect(buffer.String()).ToNot(ContainSubstring("instance2"))
+			Expect(buffer.String()).To(ContainSubstring("--page-token page2"))
+		})
+
+		It("should start from the provided page token", func() {
+			err := executeWithArgs([]string{"--page-token", "page2"})
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(client.ListPageCallCount()).To(Equal(1))
+			_, _, _, _, pageToken, _ := client.ListPageArgsForCall(0)
+			Expect(pageToken).To(Equal("page2"))
+			Expect(buffer.String()).To(ContainSubstring("instance2"))
+			Expect(buffer.String()).ToNot(ContainSubstring("instance1"))
+		})
+
+		It("should stream table rows printing the headers once", func() {
+			err := executeWithArgs([]string{"--stream"})
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buffer.String()).To(ContainSubstring("instance1"))
+			Expect(buffer.String()).To(ContainSubstring("instance2"))
+			Expect(strings.Count(buffer.String(), "Name")).To(Equal(1))
+		})
+
+		It("should stream json items one per line", func() {
+			err := executeWithArgs([]string{"--stream", "-o", "json"})
+
+			Expect(err).ShouldNot(HaveOccurred())
+			line1, _ := json.Marshal(instance1)
+			line2, _ := json.Marshal(instance2)
+			Expect(buffer.String()).To(Equal(string(line1) + "\n" + string(line2) + "\n"))
+		})
+
+		It("should reject negative values", func() {
+			err := executeWithArgs([]string{"--max-items", "-1"})
+
+			Expect(err).To(MatchError("--max-items must not be negative"))
+		})
+	})
+
 	Context("when invalid flag is used", func() {
 		It("should handle cobra error", func() {
 			err := executeWithArgs([]string{"--ooutput", "json"})
@@ -188,4 +269,72 @@ var _ = Describe("List instances command test", func() {
 			Expect(err).To(MatchError(expectedErr))
 		})
 	})
+
+	Context("when --watch is used", func() {
+		withState := func(instance types.ServiceInstance, state smtypes.OperationState) types.ServiceInstance {
+			instance.LastOperation = &smtypes.Operation{Type: smtypes.CREATE, State: state}
+			return instance
+		}
+
+		It("should print events for the changed instances until interrupted", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			command.Ctx = ctx
+			responses := []*types.ServiceInstances{
+				{ServiceInstances: []types.ServiceInstance{withState(instance1, smtypes.IN_PROGRESS), withState(instance2, smtypes.IN_PROGRESS)}},
+				{ServiceInstances: []types.ServiceInstance{withState(instance1, smtypes.SUCCEEDED), withState(instance2, smtypes.IN_PROGRESS)}},
+				{ServiceInstances: []types.ServiceInstance{withState(instance1, smtypes.SUCCEEDED)}},
+			}
+			client.ListInstancesStub = func(context.Context, *query.Parameters) (*types.ServiceInstances, error) {
+				call := client.ListInstancesCallCount() - 1
+				if call == len(responses)-1 {
+					cancel()
+				}
+				return responses[call], nil
+			}
+
+			err := executeWithArgs([]string{"--watch=1ms"})
+
+			Expect(cmd.KindOf(err)).To(Equal(cmd.ErrorKindInterrupted))
+
+			Expect(client.ListInstancesCallCount()).To(Equal(3))
+			var events []map[string]interface{}
+			for _, line := range strings.Split(strings.TrimSpace(buffer.String()), "\n") {
+				event := map[string]interface{}{}
+				Expect(json.Unmarshal([]byte(line), &event)).To(Succeed())
+				events = append(events, event)
+			}
+			Expect(events).To(HaveLen(4))
+			expectEvent := func(event map[string]interface{}, eventType, id string) {
+				Expect(event).To(HaveKeyWithValue("type", eventType))
+				Expect(event["object"]).To(HaveKeyWithValue("id", id))
+			}
+			expectEvent(events[0], "ADDED", "id1")
+			expectEvent(events[1], "ADDED", "id2")
+			expectEvent(events[2], "MODIFIED", "id1")
+			expectEvent(events[3], "DELETED", "id2")
+		})
+
+		It("should fail with paging flags", func() {
+			err := executeWithArgs([]string{"--watch", "--stream"})
+
+			Expect(err).To(MatchError("--watch can't be combined with paging flags"))
+			Expect(client.ListInstancesCallCount()).To(Equal(0))
+		})
+
+		It("should keep watching when no operation is in progress", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			command.Ctx = ctx
+			client.ListInstancesStub = func(context.Context, *query.Parameters) (*types.ServiceInstances, error) {
+				if client.ListInstancesCallCount() == 2 {
+					cancel()
+				}
+				return &types.ServiceInstances{ServiceInstances: []types.ServiceInstance{withState(instance1, smtypes.SUCCEEDED)}}, nil
+			}
+
+			err := executeWithArgs([]string{"--watch=1ms"})
+
+			Expect(cmd.KindOf(err)).To(Equal(cmd.ErrorKindInterrupted))
+			Expect(client.ListInstancesCallCount()).To(Equal(2))
+		})
+	})
 })
@@ -17,13 +17,15 @@
 package instance
 
 import (
-	"encoding/json"
 	"github.com/Peripli/service-manager-cli/internal/cmd"
 	"github.com/Peripli/service-manager-cli/internal/output"
 	"github.com/Peripli/service-manager-cli/pkg/query"
 	"github.com/Peripli/service-manager-cli/pkg/types"
 
 	"fmt"
+	"io"
+
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 )
 
@@ -31,20 +33,25 @@ import (
 type ProvisionCmd struct {
 	*cmd.Context
 
+	input io.Reader
+	fs    afero.Fs
+
 	instance       types.ServiceInstance
 	offeringName   string
 	planName       string
 	brokerName     string
-	parametersJSON string
+	parameters     cmd.Parameters
+	skipValidation bool
+	interactive    bool
 
 	outputFormat output.Format
 }
 
 const FORMAT = "name eq '%s'"
 
-// NewProvisionCmd returns new provision command with context
-func NewProvisionCmd(context *cmd.Context) *ProvisionCmd {
-	return &ProvisionCmd{Context: context, instance: types.ServiceInstance{}}
+// NewProvisionCmd returns new provision command with context, input reader for the interactive mode and file system for saving parameters
+func NewProvisionCmd(context *cmd.Context, input io.Reader, fs afero.Fs) *ProvisionCmd {
+	return &ProvisionCmd{Context: context, input: input, fs: fs, instance: types.ServiceInstance{}}
 }
 
 // Prepare returns cobra command
@@ -59,16 +66,25 @@ func (pi *ProvisionCmd) Prepare(prepare cmd.PrepareFunc) *cobra.Command {
 	}
 
 	result.Flags().StringVarP(&pi.brokerName, "broker-name", "b", "", "Name of the broker which provides the service offering. Required when offering name is ambiguous")
-	result.Flags().StringVarP(&pi.parametersJSON, "parameters", "c", "", "Valid JSON object containing instance parameters")
+	cmd.AddParametersFlags(result.Flags(), "parameters", "Instance parameters", &pi.parameters)
+	cmd.AddSkipValidationFlag(result.Flags(), &pi.skipValidation)
+	result.Flags().BoolVarP(&pi.interactive, "interactive", "i", false, "Select the offering and plan and enter the parameters interactively. Missing arguments are prompted for")
+	result.Flags().StringVarP(&pi.Parameters.IdempotencyKey, "idempotency-key", "", "", "Unique key of the provision request, which makes it safe to retry on transient errors")
 	cmd.AddFormatFlag(result.Flags())
 	cmd.AddCommonQueryFlag(result.Flags(), &pi.Parameters)
 	cmd.AddModeFlag(result.Flags(), "async")
+	cmd.AddWaitFlags(result.Flags(), &pi.Wait)
+	result.ValidArgsFunction = cmd.CompleteArgs(pi.Context, nil, cmd.OfferingNames, cmd.PlanNamesOfOffering(1))
+	cmd.CompleteFlag(result, "broker-name", pi.Context, cmd.BrokerNames)
 
 	return result
 }
 
 // Validate validates command's arguments
 func (pi *ProvisionCmd) Validate(args []string) error {
+	if pi.interactive {
+		return pi.validateInteractive(args)
+	}
 	if len(args) < 3 {
 		return fmt.Errorf("name, offering and plan are required")
 	}
@@ -77,12 +93,22 @@ func (pi *ProvisionCmd) Validate(args []string) error {
 	pi.offeringName = args[1]
 	pi.planName = args[2]
 
+	parameters, err := pi.parameters.Load(pi.fs, pi.input)
+	if err != nil {
+		return err
+	}
+	pi.instance.Parameters = parameters
+
 	return nil
 }
 
 // Run runs the command's logic
 func (pi *ProvisionCmd) Run() error {
-	offerings, err := pi.Client.ListOfferings(&query.Parameters{
+	if pi.interactive {
+		return pi.runInteractive()
+	}
+
+	offerings, err := pi.Client.ListOfferings(pi.Ctx, &query.Parameters{
 		FieldQuery: []string{
 			fmt.Sprintf(FORMAT, pi.offeringName),
 		},
@@ -92,7 +118,7 @@ func (pi *ProvisionCmd) Run() error {
 		return err
 	}
 	if len(offerings.ServiceOfferings) == 0 {
-		return fmt.Errorf("service offering with name %s not found", pi.offeringName)
+		return cmd.NewError(cmd.ErrorKindNotFound, "service offering with name %s not found", pi.offeringName)
 	}
 
 	pi.instance.ServiceID = offerings.ServiceOfferings[0].ID
@@ -102,7 +128,7 @@ func (pi *ProvisionCmd) Run() error {
 			return fmt.Errorf("more than one service offering with name %s found. Use -b flag to specify broker name", pi.offeringName)
 		}
 
-		brokers, err := pi.Client.ListBrokers(&query.Parameters{
+		brokers, err := pi.Client.ListBrokers(pi.Ctx, &query.Parameters{
 			FieldQuery: []string{
 				fmt.Sprintf(FORMAT, pi.brokerName),
 			},
@@ -122,7 +148,7 @@ func (pi *ProvisionCmd) Run() error {
 		}
 	}
 
-	plans, err := pi.Client.ListPlans(&query.Parameters{
+	plans, err := pi.Client.ListPlans(pi.Ctx, &query.Parameters{
 		FieldQuery: []string{
 			fmt.Sprintf(FORMAT, pi.planName),
 			fmt.Sprintf("service_offering_id eq '%s'", pi.instance.ServiceID),
@@ -133,22 +159,31 @@ func (pi *ProvisionCmd) Run() error {
 		return err
 	}
 	if len(plans.ServicePlans) != 1 {
-		return fmt.Errorf("exactly one service plan with name %s for offering with id %s expected", pi.planName, pi.instance.ServiceID)
+		return cmd.NewError(cmd.ErrorKindNotFound, "exactly one service plan with name %s for offering with id %s expected", pi.planName, pi.instance.ServiceID)
 	}
 
 	pi.instance.ServicePlanID = plans.ServicePlans[0].ID
-	pi.instance.Parameters = json.RawMessage(pi.parametersJSON)
+	if !pi.skipValidation {
+		if err := cmd.ValidateParameters(&plans.ServicePlans[0], cmd.InstanceCreateSchema, pi.instance.Parameters); err != nil {
+			return err
+		}
+	}
 
-	resultInstance, location, err := pi.Client.Provision(&pi.instance, &pi.Parameters)
+	return pi.provision()
+}
+
+func (pi *ProvisionCmd) provision() error {
+	resultInstance, location, err := pi.Client.Provision(pi.Ctx, &pi.instance, &pi.Parameters)
 	if err != nil {
 		return err
 	}
 
 	if len(location) != 0 {
-		cmd.CommonHandleAsyncExecution(pi.Context, location, fmt.Sprintf("Service Instance %s successfully scheduled for provisioning. To see status of the operation use:\n", pi.instance.Name))
-		return nil
+		return cmd.CommonHandleAsyncExecution(pi.Context, location, fmt.Sprintf("Service Instance %s successfully scheduled for provisioning. To see status of the operation use:\n", pi.instance.Name), pi.outputFormat)
+	}
+	if err := output.PrintServiceManagerObject(pi.Output, pi.outputFormat, resultInstance); err != nil {
+		return err
 	}
-	output.PrintServiceManagerObject(pi.Output, pi.outputFormat, resultInstance)
 	output.Println(pi.Output)
 	return nil
 }
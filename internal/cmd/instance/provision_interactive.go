@@ -0,0 +1,448 @@
+/*
+ * Copyright 2018 The Service Manager Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package instance
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/afero"
+
+	"github.com/Peripli/service-manager-cli/internal/cmd"
+	"github.com/Peripli/service-manager-cli/internal/output"
+	"github.com/Peripli/service-manager-cli/pkg/query"
+	"github.com/Peripli/service-manager-cli/pkg/types"
+)
+
+// validateInteractive accepts the name, offering and plan as optional arguments. The missing ones are prompted for.
+func (pi *ProvisionCmd) validateInteractive(args []string) error {
+	if len(args) > 3 {
+		return fmt.Errorf("at most name, offering and plan can be provided with --interactive")
+	}
+	if len(pi.parameters.Sources) > 0 {
+		return fmt.Errorf("--parameters cannot be used with --interactive")
+	}
+
+	fields := []*string{&pi.instance.Name, &pi.offeringName, &pi.planName}
+	for i, arg := range args {
+		*fields[i] = arg
+	}
+	return nil
+}
+
+// runInteractive lets the user select the offering and plan and enter the parameters before provisioning
+func (pi *ProvisionCmd) runInteractive() error {
+	p := &prompter{input: bufio.NewReader(pi.input), output: pi.Output}
+
+	if pi.instance.Name == "" {
+		name, err := p.readRequired("Instance name: ")
+		if err != nil {
+			return err
+		}
+		pi.instance.Name = name
+	}
+
+	offering, err := pi.selectOffering(p)
+	if err != nil {
+		return err
+	}
+	plan, err := pi.selectPlan(p, offering)
+	if err != nil {
+		return err
+	}
+	pi.instance.ServiceID = offering.ID
+	pi.instance.ServicePlanID = plan.ID
+
+	parameters, err := promptParameters(p, plan)
+	if err != nil {
+		return err
+	}
+	pi.instance.Parameters = parameters
+	if !pi.skipValidation {
+		if err := cmd.ValidateParameters(plan, cmd.InstanceCreateSchema, pi.instance.Parameters); err != nil {
+			return err
+		}
+	}
+
+	request, err := json.MarshalIndent(pi.instance, "", "  ")
+	if err != nil {
+		return err
+	}
+	output.PrintMessage(pi.Output, "\nRequest:\n%s\n\n", request)
+
+	if err := pi.saveParameters(p); err != nil {
+		return err
+	}
+
+	confirmed, err := cmd.CommonConfirmationPrompt(fmt.Sprintf("Provision service instance %s? [y/N]: ", pi.instance.Name), pi.Context, p.input)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		output.PrintMessage(pi.Output, "Provisioning declined\n")
+		return nil
+	}
+	return pi.provision()
+}
+
+// selectOffering selects the offering by name from the marketplace. The broker is selected when the name is ambiguous.
+func (pi *ProvisionCmd) selectOffering(p *prompter) (*types.ServiceOffering, error) {
+	marketplace, err := pi.Client.Marketplace(pi.Ctx, &query.Parameters{
+		GeneralParams: pi.Parameters.GeneralParams,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var names, descriptions []string
+	offeringsByName := make(map[string][]*types.ServiceOffering)
+	for i := range marketplace.ServiceOfferings {
+		offering := &marketplace.ServiceOfferings[i]
+		if _, found := offeringsByName[offering.Name]; !found {
+			names = append(names, offering.Name)
+			descriptions = append(descriptions, offering.Description)
+		}
+		offeringsByName[offering.Name] = append(offeringsByName[offering.Name], offering)
+	}
+	if len(names) == 0 {
+		return nil, cmd.NewError(cmd.ErrorKindNotFound, "no service offerings found in the marketplace")
+	}
+
+	name := pi.offeringName
+	if name == "" {
+		i, err := p.choose("Service offerings:", "service offering", names, descriptions)
+		if err != nil {
+			return nil, err
+		}
+		name = names[i]
+	}
+
+	offerings, found := offeringsByName[name]
+	if !found {
+		return nil, cmd.NewError(cmd.ErrorKindNotFound, "service offering with name %s not found", name)
+	}
+	if len(offerings) == 1 {
+		return offerings[0], nil
+	}
+	return pi.selectBroker(p, offerings)
+}
+
+func (pi *ProvisionCmd) selectBroker(p *prompter, offerings []*types.ServiceOffering) (*types.ServiceOffering, error) {
+	brokers, err := pi.Client.ListBrokers(pi.Ctx, &query.Parameters{
+		GeneralParams: pi.Parameters.GeneralParams,
+	})
+	if err != nil {
+		return nil, err
+	}
+	brokerNames := make(map[string]string)
+	for _, broker := range brokers.Brokers {
+		brokerNames[broker.ID] = broker.Name
+	}
+
+	names := make([]string, len(offerings))
+	for i, offering := range offerings {
+		names[i] = brokerNames[offering.BrokerID]
+		if names[i] == "" {
+			names[i] = offering.BrokerID
+		}
+	}
+
+	if pi.brokerName != "" {
+		for i, name := range names {
+			if name == pi.brokerName {
+				return offerings[i], nil
+			}
+		}
+		return nil, cmd.NewError(cmd.ErrorKindNotFound, "service offering with name %s of broker %s not found", offerings[0].Name, pi.brokerName)
+	}
+
+	i, err := p.choose(fmt.Sprintf("More than one service offering with name %s found. Brokers:", offerings[0].Name), "broker", names, nil)
+	if err != nil {
+		return nil, err
+	}
+	return offerings[i], nil
+}
+
+func (pi *ProvisionCmd) selectPlan(p *prompter, offering *types.ServiceOffering) (*types.ServicePlan, error) {
+	if len(offering.Plans) == 0 {
+		return nil, cmd.NewError(cmd.ErrorKindNotFound, "service offering with name %s has no service plans", offering.Name)
+	}
+
+	if pi.planName != "" {
+		for i := range offering.Plans {
+			if offering.Plans[i].Name == pi.planName {
+				return &offering.Plans[i], nil
+			}
+		}
+		return nil, cmd.NewError(cmd.ErrorKindNotFound, "service plan with name %s for offering %s not found", pi.planName, offering.Name)
+	}
+
+	names := make([]string, len(offering.Plans))
+	descriptions := make([]string, len(offering.Plans))
+	for i, plan := range offering.Plans {
+		names[i] = plan.Name
+		descriptions[i] = plan.Description
+	}
+	i, err := p.choose("Service plans:", "service plan", names, descriptions)
+	if err != nil {
+		return nil, err
+	}
+	return &offering.Plans[i], nil
+}
+
+// saveParameters writes the parameters to the file entered by the user
+func (pi *ProvisionCmd) saveParameters(p *prompter) error {
+	file, err := p.readLine("Save the parameters to a file (leave empty to skip): ")
+	if err != nil {
+		return err
+	}
+	file = strings.TrimSpace(file)
+	if file == "" {
+		return nil
+	}
+
+	parameters := pi.instance.Parameters
+	if len(parameters) == 0 {
+		parameters = json.RawMessage("{}")
+	}
+	var content bytes.Buffer
+	if err := json.Indent(&content, parameters, "", "  "); err != nil {
+		return err
+	}
+	content.WriteString("\n")
+	if err := afero.WriteFile(pi.fs, file, content.Bytes(), 0644); err != nil {
+		return fmt.Errorf("could not save the parameters: %s", err)
+	}
+	output.PrintMessage(pi.Output, "Parameters saved to %s. Use -c @%s to provision with them again\n", file, file)
+	return nil
+}
+
+// promptParameters prompts for each property of the create schema of the plan.
+// Plans without a schema accept the parameters as a JSON object.
+func promptParameters(p *prompter, plan *types.ServicePlan) (json.RawMessage, error) {
+	schema, err := cmd.InstanceCreateSchema.Of(plan)
+	if err != nil {
+		return nil, err
+	}
+	if schema == nil {
+		for {
+			text, err := p.readLine("Parameters as JSON object (leave empty for none): ")
+			if err != nil {
+				return nil, err
+			}
+			parameters, err := cmd.ParseParameters(text)
+			if err == nil {
+				return parameters, nil
+			}
+			output.PrintMessage(p.output, "%s\n", err)
+		}
+	}
+
+	parsed, err := cmd.ParseSchema(schema)
+	if err != nil {
+		return nil, fmt.Errorf("could not read the parameters schema of plan %s: %s", plan.Name, err)
+	}
+	if len(parsed.Properties) == 0 {
+		return nil, nil
+	}
+
+	output.PrintMessage(p.output, "Parameters of plan %s:\n", plan.Name)
+	parameters := make(map[string]interface{})
+	for _, property := range parsed.Properties {
+		value, err := p.promptProperty(property)
+		if err != nil {
+			return nil, err
+		}
+		if value != nil {
+			parameters[property.Name] = value
+		}
+	}
+	if len(parameters) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(parameters)
+}
+
+// propertyPrompt describes the property with its type, allowed values and default
+func propertyPrompt(property cmd.SchemaProperty) string {
+	var details []string
+	if property.Type != "" {
+		details = append(details, property.Type)
+	}
+	if property.Required {
+		details = append(details, "required")
+	}
+	if len(property.Enum) > 0 {
+		details = append(details, "one of: "+cmd.FormatSchemaValues(property.Enum))
+	}
+
+	prompt := property.Name
+	if len(details) > 0 {
+		prompt += " (" + strings.Join(details, ", ") + ")"
+	}
+	if property.Description != "" {
+		prompt += " - " + property.Description
+	}
+	if property.Default != nil {
+		prompt += " [" + cmd.FormatSchemaValue(property.Default) + "]"
+	}
+	return prompt + ": "
+}
+
+// parsePropertyValue converts the entered text to a value of the property type
+func parsePropertyValue(property cmd.SchemaProperty, text string) (interface{}, error) {
+	if len(property.Enum) > 0 {
+		for _, value := range property.Enum {
+			if cmd.FormatSchemaValue(value) == text {
+				return value, nil
+			}
+		}
+		return nil, fmt.Errorf("expected one of %s", cmd.FormatSchemaValues(property.Enum))
+	}
+
+	trimmed := strings.TrimSpace(text)
+	switch property.Type {
+	case "string":
+		return text, nil
+	case "integer":
+		value, err := strconv.ParseInt(trimmed, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("expected an integer")
+		}
+		return value, nil
+	case "number":
+		if _, err := strconv.ParseFloat(trimmed, 64); err != nil || !json.Valid([]byte(trimmed)) {
+			return nil, fmt.Errorf("expected a number")
+		}
+		return json.Number(trimmed), nil
+	case "boolean":
+		switch strings.ToLower(trimmed) {
+		case "y", "yes":
+			return true, nil
+		case "n", "no":
+			return false, nil
+		}
+		value, err := strconv.ParseBool(trimmed)
+		if err != nil {
+			return nil, fmt.Errorf("expected true or false")
+		}
+		return value, nil
+	default:
+		var value interface{}
+		if err := json.Unmarshal([]byte(trimmed), &value); err != nil {
+			if property.Type == "" {
+				return text, nil
+			}
+			return nil, fmt.Errorf("expected a JSON %s", property.Type)
+		}
+		return value, nil
+	}
+}
+
+// prompter reads the answers of the interactive mode line by line
+type prompter struct {
+	input  *bufio.Reader
+	output io.Writer
+}
+
+func (p *prompter) readLine(prompt string) (string, error) {
+	output.PrintMessage(p.output, "%s", prompt)
+	line, err := p.input.ReadString('\n')
+	if err == io.EOF && len(line) > 0 {
+		err = nil
+	}
+	if err == io.EOF {
+		return "", fmt.Errorf("unexpected end of input")
+	}
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+func (p *prompter) readRequired(prompt string) (string, error) {
+	for {
+		text, err := p.readLine(prompt)
+		if err != nil {
+			return "", err
+		}
+		if text = strings.TrimSpace(text); text != "" {
+			return text, nil
+		}
+	}
+}
+
+func (p *prompter) promptProperty(property cmd.SchemaProperty) (interface{}, error) {
+	for {
+		text, err := p.readLine(propertyPrompt(property))
+		if err != nil {
+			return nil, err
+		}
+		if strings.TrimSpace(text) == "" {
+			if property.Default != nil || !property.Required {
+				return property.Default, nil
+			}
+			output.PrintMessage(p.output, "%s is required\n", property.Name)
+			continue
+		}
+		value, err := parsePropertyValue(property, text)
+		if err == nil {
+			return value, nil
+		}
+		output.PrintMessage(p.output, "Invalid value for %s: %s\n", property.Name, err)
+	}
+}
+
+// choose prints the numbered options and returns the index of the option selected by number or by name
+func (p *prompter) choose(title, option string, names, descriptions []string) (int, error) {
+	width := 0
+	for _, name := range names {
+		if len(name) > width {
+			width = len(name)
+		}
+	}
+	output.PrintMessage(p.output, "%s\n", title)
+	for i, name := range names {
+		if i < len(descriptions) && descriptions[i] != "" {
+			output.PrintMessage(p.output, "  %d. %-*s  %s\n", i+1, width, name, descriptions[i])
+		} else {
+			output.PrintMessage(p.output, "  %d. %s\n", i+1, name)
+		}
+	}
+
+	for {
+		text, err := p.readLine(fmt.Sprintf("Select a %s [1-%d]: ", option, len(names)))
+		if err != nil {
+			return 0, err
+		}
+		text = strings.TrimSpace(text)
+		if number, err := strconv.Atoi(text); err == nil && number >= 1 && number <= len(names) {
+			return number - 1, nil
+		}
+		for i, name := range names {
+			if name == text {
+				return i, nil
+			}
+		}
+		output.PrintMessage(p.output, "Enter a number between 1 and %d or the name of the %s\n", len(names), option)
+	}
+}
@@ -0,0 +1,172 @@
+package instance
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	"github.com/Peripli/service-manager-cli/internal/cmd"
+	"github.com/Peripli/service-manager-cli/pkg/smclient/smclientfakes"
+	"github.com/Peripli/service-manager-cli/pkg/types"
+)
+
+var _ = Describe("Provision interactive", func() {
+	var client *smclientfakes.FakeClient
+	var buffer *bytes.Buffer
+	var fs afero.Fs
+	var marketplace *types.Marketplace
+
+	schemas := json.RawMessage(`{"service_instance": {"create": {"parameters": {
+		"type": "object",
+		"properties": {
+			"size": {"type": "integer", "description": "Number of nodes", "minimum": 1},
+			"tier": {"type": "string", "enum": ["small", "large"], "default": "small"},
+			"backup": {"type": "boolean"},
+			"tags": {"type": "array", "items": {"type": "string"}}
+		},
+		"required": ["size"]
+	}}}}`)
+
+	BeforeEach(func() {
+		buffer = &bytes.Buffer{}
+		client = &smclientfakes.FakeClient{}
+		fs = afero.NewMemMapFs()
+		marketplace = &types.Marketplace{ServiceOfferings: []types.ServiceOffering{
+			{ID: "redis-id", Name: "redis", Description: "Redis cache", BrokerID: "broker-id", Plans: []types.ServicePlan{
+				{ID: "redis-plan-id", Name: "default"},
+			}},
+			{ID: "postgres-id", Name: "postgres", Description: "PostgreSQL database", BrokerID: "broker-id", Plans: []types.ServicePlan{
+				{ID: "free-id", Name: "free", Description: "No parameters"},
+				{ID: "standard-id", Name: "standard", Description: "Configurable", Schemas: schemas},
+			}},
+		}}
+		client.MarketplaceReturns(marketplace, nil)
+		client.ProvisionReturns(&types.ServiceInstance{ID: "instance-id", Name: "my-db"}, "", nil)
+	})
+
+	executeWithInput := func(input string, args ...string) error {
+		context := &cmd.Context{Output: buffer, Client: client}
+		command := NewProvisionCmd(context, strings.NewReader(input), fs)
+		provisionCmd := command.Prepare(cmd.SmPrepare)
+		provisionCmd.SetArgs(append([]string{"--interactive", "--mode", "sync"}, args...))
+		return provisionCmd.Execute()
+	}
+
+	provisionedInstance := func() *types.ServiceInstance {
+		Expect(client.ProvisionCallCount()).To(Equal(1))
+		_, instance, _ := client.ProvisionArgsForCall(0)
+		return instance
+	}
+
+	It("should provision the selected plan with the entered parameters", func() {
+		input := strings.Join([]string{
+			"my-db",      // name
+			"2",          // offering
+			"standard",   // plan
+			"",           // size is required
+			"two",        // size is not an integer
+			"3",          // size
+			"medium",     // tier is not in the enum
+			"",           // tier default
+			"maybe",      // backup is not a boolean
+			"yes",        // backup
+			`["a", "b"]`, // tags
+			"params.json",
+			"y",
+		}, "\n") + "\n"
+
+		err := executeWithInput(input)
+
+		Expect(err).ShouldNot(HaveOccurred())
+		instance := provisionedInstance()
+		Expect(instance.Name).To(Equal("my-db"))
+		Expect(instance.ServiceID).To(Equal("postgres-id"))
+		Expect(instance.ServicePlanID).To(Equal("standard-id"))
+		Expect(instance.Parameters).To(MatchJSON(`{"size":3,"tier":"small","backup":true,"tags":["a","b"]}`))
+
+		Expect(buffer.String()).To(ContainSubstring("  1. redis     Redis cache\n  2. postgres  PostgreSQL database\n"))
+		Expect(buffer.String()).To(ContainSubstring("size (integer, required) - Number of nodes: "))
+		Expect(buffer.String()).To(ContainSubstring("size is required"))
+		Expect(buffer.String()).To(ContainSubstring("Invalid value for size: expected an integer"))
+		Expect(buffer.String()).To(ContainSubstring("tier (string, one of: small, large) [small]: "))
+		Expect(buffer.String()).To(ContainSubstring("Invalid value for tier: expected one of small, large"))
+		Expect(buffer.String()).To(ContainSubstring("Invalid value for backup: expected true or false"))
+		Expect(buffer.String()).To(ContainSubstring(`"service_plan_id": "standard-id"`))
+		Expect(buffer.String()).To(ContainSubstring("Parameters saved to params.json"))
+
+		saved, err := afero.ReadFile(fs, "params.json")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(saved).To(MatchJSON(instance.Parameters))
+	})
+
+	It("should prompt only for the missing arguments", func() {
+		err := executeWithInput("5\n\nfalse\n\n\ny\n", "my-db", "postgres", "standard")
+
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(buffer.String()).NotTo(ContainSubstring("Instance name:"))
+		Expect(buffer.String()).NotTo(ContainSubstring("Select a"))
+		Expect(provisionedInstance().Parameters).To(MatchJSON(`{"size":5,"tier":"small","backup":false}`))
+	})
+
+	It("should read the parameters as JSON for plans without schema", func() {
+		err := executeWithInput("[1]\n{\"a\":1}\n\ny\n", "my-db", "postgres", "free")
+
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(buffer.String()).To(ContainSubstring("parameters must be a valid JSON object: [1]"))
+		Expect(provisionedInstance().Parameters).To(MatchJSON(`{"a":1}`))
+	})
+
+	It("should select the broker when the offering name is ambiguous", func() {
+		marketplace.ServiceOfferings = append(marketplace.ServiceOfferings, types.ServiceOffering{
+			ID: "other-redis-id", Name: "redis", BrokerID: "other-broker-id", Plans: []types.ServicePlan{{ID: "other-plan-id", Name: "default"}},
+		})
+		client.ListBrokersReturns(&types.Brokers{Brokers: []types.Broker{{ID: "broker-id", Name: "broker"}, {ID: "other-broker-id", Name: "other-broker"}}}, nil)
+
+		err := executeWithInput("redis\nother-broker\n1\n\n\ny\n", "my-cache")
+
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(buffer.String()).To(ContainSubstring("More than one service offering with name redis found. Brokers:\n  1. broker\n  2. other-broker\n"))
+		Expect(provisionedInstance().ServicePlanID).To(Equal("other-plan-id"))
+	})
+
+	It("should not provision when declined", func() {
+		err := executeWithInput("\n\nn\n", "my-cache", "redis", "default")
+
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(buffer.String()).To(ContainSubstring("Provisioning declined"))
+		Expect(client.ProvisionCallCount()).To(Equal(0))
+	})
+
+	It("should fail when the parameters do not match the schema", func() {
+		err := executeWithInput("0\n\n\n\n", "my-db", "postgres", "standard")
+
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("$.size: "))
+		Expect(client.ProvisionCallCount()).To(Equal(0))
+	})
+
+	It("should fail when the input ends", func() {
+		err := executeWithInput("my-db\n")
+
+		Expect(err).To(MatchError("unexpected end of input"))
+		Expect(client.ProvisionCallCount()).To(Equal(0))
+	})
+
+	It("should fail for an unknown offering", func() {
+		err := executeWithInput("", "my-db", "mysql")
+
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("service offering with name mysql not found"))
+	})
+
+	It("should not accept parameters", func() {
+		err := executeWithInput("", "-c", `{"size":1}`)
+
+		Expect(err).To(MatchError(ContainSubstring("--parameters cannot be used with --interactive")))
+		Expect(client.MarketplaceCallCount()).To(Equal(0))
+	})
+})
@@ -1,21 +1,25 @@
 package instance
 
 import (
+	"context"
 	"encoding/json"
-	"github.com/Peripli/service-manager/pkg/util"
+	"github.com/Peripli/service-manager-cli/pkg/smclient"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"gopkg.in/yaml.v3"
 	"io/ioutil"
 	"net/http"
+	"os"
 
 	"bytes"
 	"errors"
 
 	"github.com/Peripli/service-manager-cli/internal/cmd"
+	"github.com/Peripli/service-manager-cli/pkg/query"
 	"github.com/Peripli/service-manager-cli/pkg/smclient/smclientfakes"
 	"github.com/Peripli/service-manager-cli/pkg/types"
 
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 )
 
@@ -26,6 +30,8 @@ var _ = Describe("Provision Command test", func() {
 	var client *smclientfakes.FakeClient
 	var command *ProvisionCmd
 	var buffer *bytes.Buffer
+	var input *bytes.Buffer
+	var fs afero.Fs
 
 	var offerings *types.ServiceOfferings
 	var plans *types.ServicePlans
@@ -35,7 +41,9 @@ var _ = Describe("Provision Command test", func() {
 		buffer = &bytes.Buffer{}
 		client = &smclientfakes.FakeClient{}
 		context := &cmd.Context{Output: buffer, Client: client}
-		command = NewProvisionCmd(context)
+		input = &bytes.Buffer{}
+		fs = afero.NewMemMapFs()
+		command = NewProvisionCmd(context, input, fs)
 	})
 
 	validAsyncProvisionExecution := func(location string, args ...string) *cobra.Command {
@@ -102,6 +110,79 @@ var _ = Describe("Provision Command test", func() {
 			})
 		})
 
+		Context("With wait flag", func() {
+			var resultInstance *types.ServiceInstance
+
+			BeforeEach(func() {
+				resultInstance = &types.ServiceInstance{ID: "instance-id", Name: "instance-name"}
+				client.GetInstanceByIDReturns(resultInstance, nil)
+			})
+
+			It("should poll the operation and print the provisioned instance", func() {
+				client.StatusReturnsOnCall(0, &types.Operation{State: "in progress"}, nil)
+				client.StatusReturnsOnCall(1, &types.Operation{ID: "op-id", Type: "create", State: "succeeded", ResourceID: "instance-id", ResourceType: "/v1/service_instances"}, nil)
+				client.ListOfferingsReturns(&types.ServiceOfferings{ServiceOfferings: []types.ServiceOffering{{ID: OfferingID}}}, nil)
+				client.ListPlansReturns(&types.ServicePlans{ServicePlans: []types.ServicePlan{{ID: PlanID}}}, nil)
+				client.ProvisionReturns(nil, "location", nil)
+
+				err := invalidProvisionCommandExecution("instance-name", "offering-name", "plan-name", "--wait")
+
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(client.StatusCallCount()).To(Equal(2))
+				_, location, _ := client.StatusArgsForCall(1)
+				Expect(location).To(Equal("location"))
+				_, id, _ := client.GetInstanceByIDArgsForCall(0)
+				Expect(id).To(Equal("instance-id"))
+				Expect(buffer.String()).To(ContainSubstring(resultInstance.TableData().String()))
+				Expect(buffer.String()).ToNot(ContainSubstring("smctl status"))
+			})
+
+			It("should print the operation errors and fail when the operation fails", func() {
+				client.StatusReturns(&types.Operation{ID: "op-id", Type: "create", State: "failed", Errors: json.RawMessage(`{"description":"broker error"}`)}, nil)
+				client.ListOfferingsReturns(&types.ServiceOfferings{ServiceOfferings: []types.ServiceOffering{{ID: OfferingID}}}, nil)
+				client.ListPlansReturns(&types.ServicePlans{ServicePlans: []types.ServicePlan{{ID: PlanID}}}, nil)
+				client.ProvisionReturns(nil, "location", nil)
+
+				err := invalidProvisionCommandExecution("instance-name", "offering-name", "plan-name", "--wait")
+
+				Expect(err).To(MatchError("operation op-id failed"))
+				Expect(cmd.KindOf(err)).To(Equal(cmd.ErrorKindOperationFailed))
+				Expect(buffer.String()).To(ContainSubstring("broker error"))
+				Expect(client.GetInstanceByIDCallCount()).To(Equal(0))
+			})
+
+			It("should fail when the timeout expires", func() {
+				client.StatusReturns(&types.Operation{State: "in progress"}, nil)
+				client.ListOfferingsReturns(&types.ServiceOfferings{ServiceOfferings: []types.ServiceOffering{{ID: OfferingID}}}, nil)
+				client.ListPlansReturns(&types.ServicePlans{ServicePlans: []types.ServicePlan{{ID: PlanID}}}, nil)
+				client.ProvisionReturns(nil, "location", nil)
+
+				err := invalidProvisionCommandExecution("instance-name", "offering-name", "plan-name", "--wait", "--timeout", "10ms")
+
+				Expect(err).Should(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("timed out after 10ms"))
+				Expect(cmd.KindOf(err)).To(Equal(cmd.ErrorKindTimeout))
+			})
+
+			It("should stop polling when the context is cancelled", func() {
+				ctx, cancel := context.WithCancel(context.Background())
+				command.Ctx = ctx
+				client.StatusStub = func(context.Context, string, *query.Parameters) (*types.Operation, error) {
+					cancel()
+					return &types.Operation{State: "in progress"}, nil
+				}
+				client.ListOfferingsReturns(&types.ServiceOfferings{ServiceOfferings: []types.ServiceOffering{{ID: OfferingID}}}, nil)
+				client.ListPlansReturns(&types.ServicePlans{ServicePlans: []types.ServicePlan{{ID: PlanID}}}, nil)
+				client.ProvisionReturns(nil, "location", nil)
+
+				err := invalidProvisionCommandExecution("instance-name", "offering-name", "plan-name", "--wait")
+
+				Expect(err).To(MatchError(context.Canceled))
+				Expect(cmd.KindOf(err)).To(Equal(cmd.ErrorKindInterrupted))
+				Expect(client.StatusCallCount()).To(Equal(1))
+			})
+		})
+
 		Context("With json output flag", func() {
 			It("should be printed in json output format", func() {
 				validSyncProvisionExecution("instance-name", "offering-name", "plan-name", "--output", "json")
@@ -128,7 +209,7 @@ var _ = Describe("Provision Command test", func() {
 			It("should pass it to SM", func() {
 				validSyncProvisionExecution("instance-name", "offering-name", "plan-name", "--param", "paramKey=paramValue")
 
-				_, args := client.ProvisionArgsForCall(0)
+				_, _, args := client.ProvisionArgsForCall(0)
 
 				Expect(args.GeneralParams).To(ConsistOf("paramKey=paramValue", "async=false"))
 				Expect(args.FieldQuery).To(BeEmpty())
@@ -136,11 +217,21 @@ var _ = Describe("Provision Command test", func() {
 			})
 		})
 
+		Context("With idempotency key flag", func() {
+			It("should pass it to SM", func() {
+				validSyncProvisionExecution("instance-name", "offering-name", "plan-name", "--idempotency-key", "key-1")
+
+				_, _, args := client.ProvisionArgsForCall(0)
+
+				Expect(args.IdempotencyKey).To(Equal("key-1"))
+			})
+		})
+
 		Context("With sync flag", func() {
 			It("should pass it to SM", func() {
 				validSyncProvisionExecution("instance-name", "offering-name", "plan-name")
 
-				_, args := client.ProvisionArgsForCall(0)
+				_, _, args := client.ProvisionArgsForCall(0)
 
 				Expect(args.GeneralParams).To(ConsistOf("async=false"))
 				Expect(args.FieldQuery).To(BeEmpty())
@@ -238,7 +329,7 @@ var _ = Describe("Provision Command test", func() {
 		Context("With http response error from http client", func() {
 			It("should return error's description", func() {
 				body := ioutil.NopCloser(bytes.NewReader([]byte("HTTP response error")))
-				expectedError := util.HandleResponseError(&http.Response{Body: body})
+				expectedError := smclient.NewAPIError(&http.Response{Body: body})
 				client.ProvisionReturns(nil, "", expectedError)
 
 				err := invalidProvisionCommandExecution("validName", "offering-name", "plan-name")
@@ -258,4 +349,235 @@ var _ = Describe("Provision Command test", func() {
 			})
 		})
 	})
+	Describe("Parameters validation", func() {
+		BeforeEach(func() {
+			client.ListOfferingsReturns(&types.ServiceOfferings{ServiceOfferings: []types.ServiceOffering{{ID: OfferingID}}}, nil)
+			client.ListPlansReturns(&types.ServicePlans{ServicePlans: []types.ServicePlan{{ID: PlanID, Name: "plan-name", Schemas: json.RawMessage(`{
+				"service_instance": {"create": {"parameters": {
+					"type": "object",
+					"properties": {
+						"size": {"type": "integer", "minimum": 1},
+						"servers": {"type": "array", "items": {"type": "object", "properties": {"name": {"type": "string"}}}}
+					},
+					"required": ["size"]
+				}}}}`)}}}, nil)
+			client.ProvisionReturns(&types.ServiceInstance{ID: "instance-id", Name: "instance-name"}, "", nil)
+		})
+
+		It("should provision with parameters matching the plan schema", func() {
+			err := invalidProvisionCommandExecution("instance-name", "offering-name", "plan-name", "-c", `{"size":2}`, "--mode", "sync")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			_, instanceArg, _ := client.ProvisionArgsForCall(0)
+			Expect(string(instanceArg.Parameters)).To(Equal(`{"size":2}`))
+		})
+
+		It("should report every violation with its path", func() {
+			err := invalidProvisionCommandExecution("instance-name", "offering-name", "plan-name", "-c", `{"size":0,"servers":[{"name":1}]}`)
+
+			Expect(err).Should(HaveOccurred())
+			Expect(cmd.KindOf(err)).To(Equal(cmd.ErrorKindValidation))
+			Expect(err.Error()).To(ContainSubstring("parameters do not match the service_instance.create schema of plan plan-name"))
+			Expect(err.Error()).To(ContainSubstring("$.servers[0].name: "))
+			Expect(err.Error()).To(ContainSubstring("$.size: "))
+			Expect(err.Error()).To(ContainSubstring("--skip-validation"))
+			Expect(client.ProvisionCallCount()).To(Equal(0))
+		})
+
+		It("should validate missing parameters against the required properties", func() {
+			err := invalidProvisionCommandExecution("instance-name", "offering-name", "plan-name")
+
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("$: size is required"))
+			Expect(client.ProvisionCallCount()).To(Equal(0))
+		})
+
+		It("should provision without validation with skip validation flag", func() {
+			err := invalidProvisionCommandExecution("instance-name", "offering-name", "plan-name", "-c", `{"size":0}`, "--skip-validation", "--mode", "sync")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(client.ProvisionCallCount()).To(Equal(1))
+		})
+
+		It("should suggest skipping the validation when the schemas of the plan cannot be read", func() {
+			client.ListPlansReturns(&types.ServicePlans{ServicePlans: []types.ServicePlan{{ID: PlanID, Name: "plan-name", Schemas: json.RawMessage(`{"service_instance": []}`)}}}, nil)
+			err := invalidProvisionCommandExecution("instance-name", "offering-name", "plan-name", "-c", `{"size":2}`)
+
+			Expect(err).To(MatchError(ContainSubstring("could not read the schemas of plan plan-name")))
+			Expect(err.Error()).To(ContainSubstring("Use --skip-validation to send the parameters anyway"))
+			Expect(client.ProvisionCallCount()).To(Equal(0))
+		})
+
+		It("should accept any parameters for plans without schemas", func() {
+			client.ListPlansReturns(&types.ServicePlans{ServicePlans: []types.ServicePlan{{ID: PlanID, Name: "plan-name"}}}, nil)
+			err := invalidProvisionCommandExecution("instance-name", "offering-name", "plan-name", "-c", `{"size":0}`, "--mode", "sync")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(client.ProvisionCallCount()).To(Equal(1))
+		})
+
+		It("should reject parameters which are not valid JSON", func() {
+			err := invalidProvisionCommandExecution("instance-name", "offering-name", "plan-name", "-c", `{"size":`)
+
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("parameters must be a JSON or YAML object"))
+			Expect(client.ListOfferingsCallCount()).To(Equal(0))
+		})
+	})
+	Describe("Parameters sources", func() {
+		BeforeEach(func() {
+			client.ListOfferingsReturns(&types.ServiceOfferings{ServiceOfferings: []types.ServiceOffering{{ID: OfferingID}}}, nil)
+			client.ListPlansReturns(&types.ServicePlans{ServicePlans: []types.ServicePlan{{ID: PlanID, Name: "plan-name"}}}, nil)
+			client.ProvisionReturns(&types.ServiceInstance{ID: "instance-id", Name: "instance-name"}, "", nil)
+		})
+
+		provisionedParameters := func() json.RawMessage {
+			Expect(client.ProvisionCallCount()).To(Equal(1))
+			_, instanceArg, _ := client.ProvisionArgsForCall(0)
+			return instanceArg.Parameters
+		}
+
+		It("should read the parameters from a JSON file", func() {
+			Expect(afero.WriteFile(fs, "params.json", []byte(`{"size": 2, "ratio": 0.10}`), 0644)).To(Succeed())
+
+			err := invalidProvisionCommandExecution("instance-name", "offering-name", "plan-name", "-c", "@params.json")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(provisionedParameters())).To(Equal(`{"ratio":0.10,"size":2}`))
+		})
+
+		It("should read the parameters from a YAML file", func() {
+			Expect(afero.WriteFile(fs, "params.yaml", []byte("size: 2\nnetwork:\n  cidr: 10.0.0.0/16\n"), 0644)).To(Succeed())
+
+			err := invalidProvisionCommandExecution("instance-name", "offering-name", "plan-name", "-c", "@params.yaml")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(provisionedParameters()).To(MatchJSON(`{"size":2,"network":{"cidr":"10.0.0.0/16"}}`))
+		})
+
+		It("should read the parameters from stdin", func() {
+			input.WriteString("size: 3\n")
+
+			err := invalidProvisionCommandExecution("instance-name", "offering-name", "plan-name", "-c", "@-")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(provisionedParameters()).To(MatchJSON(`{"size":3}`))
+		})
+
+		It("should deep merge the sources in order", func() {
+			Expect(afero.WriteFile(fs, "base.yaml", []byte("size: 1\nnetwork:\n  cidr: 10.0.0.0/16\n  public: false\ntags: [a, b]\n"), 0644)).To(Succeed())
+			input.WriteString(`{"network": {"public": true}, "tags": ["c"]}`)
+
+			err := invalidProvisionCommandExecution("instance-name", "offering-name", "plan-name",
+				"-c", "@base.yaml", "-c", "@-", "-c", `{"size": 5}`)
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(provisionedParameters()).To(MatchJSON(`{"size":5,"network":{"cidr":"10.0.0.0/16","public":true},"tags":["c"]}`))
+		})
+
+		It("should substitute variables from flags and environment", func() {
+			os.Setenv("SMCTL_TEST_REGION", "eu10")
+			defer os.Unsetenv("SMCTL_TEST_REGION")
+			Expect(afero.WriteFile(fs, "params.yaml", []byte("size: ${SIZE}\nregion: ${SMCTL_TEST_REGION}\nliteral: $${SIZE}\n"), 0644)).To(Succeed())
+
+			err := invalidProvisionCommandExecution("instance-name", "offering-name", "plan-name", "-c", "@params.yaml", "--var", "SIZE=4")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(provisionedParameters()).To(MatchJSON(`{"size":4,"region":"eu10","literal":"${SIZE}"}`))
+		})
+
+		It("should substitute variables only in string values", func() {
+			err := invalidProvisionCommandExecution("instance-name", "offering-name", "plan-name",
+				"-c", `{"password": "${PASSWORD}", "motd": "${GREETING} world", "${PASSWORD}": "key"}`,
+				"--var", `PASSWORD=p", "admin": true, "x": "`, "--var", "GREETING=hello\n\"quoted\"")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(provisionedParameters()).To(MatchJSON(`{"password":"p\", \"admin\": true, \"x\": \"","motd":"hello\n\"quoted\" world","${PASSWORD}":"key"}`))
+		})
+
+		It("should keep the type of values referencing a single variable", func() {
+			err := invalidProvisionCommandExecution("instance-name", "offering-name", "plan-name",
+				"-c", `{"size": "${SIZE}", "public": "${PUBLIC}", "name": "${NAME}", "label": "size-${SIZE}"}`,
+				"--var", "SIZE=4", "--var", "PUBLIC=true", "--var", `NAME={"injected": 1}`)
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(provisionedParameters()).To(MatchJSON(`{"size":4,"public":true,"name":"{\"injected\": 1}","label":"size-4"}`))
+		})
+
+		It("should fail for undefined variables", func() {
+			err := invalidProvisionCommandExecution("instance-name", "offering-name", "plan-name", "-c", `{"size": "${SMCTL_TEST_UNDEFINED}"}`)
+
+			Expect(err).Should(HaveOccurred())
+			Expect(cmd.KindOf(err)).To(Equal(cmd.ErrorKindValidation))
+			Expect(err.Error()).To(ContainSubstring("undefined variables in parameters: SMCTL_TEST_UNDEFINED"))
+			Expect(client.ProvisionCallCount()).To(Equal(0))
+		})
+
+		It("should fail for invalid variables", func() {
+			err := invalidProvisionCommandExecution("instance-name", "offering-name", "plan-name", "-c", `{}`, "--var", "SIZE")
+
+			Expect(err).To(MatchError(ContainSubstring("variable SIZE must be in the form name=value")))
+		})
+
+		It("should fail for missing files", func() {
+			err := invalidProvisionCommandExecution("instance-name", "offering-name", "plan-name", "-c", "@missing.json")
+
+			Expect(err).To(MatchError(ContainSubstring("could not read the parameters file")))
+		})
+
+		It("should fail for files which do not contain an object", func() {
+			Expect(afero.WriteFile(fs, "params.yaml", []byte("- size\n"), 0644)).To(Succeed())
+
+			err := invalidProvisionCommandExecution("instance-name", "offering-name", "plan-name", "-c", "@params.yaml")
+
+			Expect(err).To(MatchError(ContainSubstring("parameters file params.yaml must be a JSON or YAML object")))
+		})
+
+		It("should read stdin only once", func() {
+			err := invalidProvisionCommandExecution("instance-name", "offering-name", "plan-name", "-c", "@-", "-c", "@-")
+
+			Expect(err).To(MatchError(ContainSubstring("parameters can be read from stdin only once")))
+		})
+	})
+	Describe("Completion", func() {
+		BeforeEach(func() {
+			client.ListOfferingsReturns(&types.ServiceOfferings{ServiceOfferings: []types.ServiceOffering{{ID: OfferingID, Name: "offering-name"}}}, nil)
+			client.ListPlansReturns(&types.ServicePlans{ServicePlans: []types.ServicePlan{{ID: PlanID, Name: "plan-name"}, {ID: "other", Name: "plan-name"}}}, nil)
+		})
+
+		complete := func(args ...string) []string {
+			piCmd := command.Prepare(cmd.SmPrepare)
+			completions, directive := piCmd.ValidArgsFunction(piCmd, args[:len(args)-1], args[len(args)-1])
+			Expect(directive).To(Equal(cobra.ShellCompDirectiveNoFileComp))
+			return completions
+		}
+
+		It("should not complete the instance name", func() {
+			Expect(complete("")).To(BeEmpty())
+			Expect(client.ListOfferingsCallCount()).To(Equal(0))
+		})
+
+		It("should complete the offering names", func() {
+			Expect(complete("instance-name", "off")).To(Equal([]string{"offering-name"}))
+		})
+
+		It("should complete the plan names of the offering", func() {
+			Expect(complete("instance-name", "offering-name", "")).To(Equal([]string{"plan-name"}))
+
+			_, offeringsQuery := client.ListOfferingsArgsForCall(0)
+			Expect(offeringsQuery.FieldQuery).To(ConsistOf("name eq 'offering-name'"))
+			_, plansQuery := client.ListPlansArgsForCall(0)
+			Expect(plansQuery.FieldQuery).To(ConsistOf("service_offering_id eq '" + OfferingID + "'"))
+		})
+
+		It("should complete the broker names", func() {
+			client.ListBrokersReturns(&types.Brokers{Brokers: []types.Broker{{Name: "broker-name"}}}, nil)
+			piCmd := command.Prepare(cmd.SmPrepare)
+			completeBroker, ok := piCmd.GetFlagCompletionFunc("broker-name")
+			Expect(ok).To(BeTrue())
+
+			completions, _ := completeBroker(piCmd, []string{"instance-name", "offering-name"}, "")
+			Expect(completions).To(Equal([]string{"broker-name"}))
+		})
+	})
 })
@@ -66,6 +66,11 @@ func (trc *TransferCmd) Prepare(prepare cmd.PrepareFunc) *cobra.Command {
 	result.Flags().StringVarP(&trc.toPlatformID, "to", "", "", "ID of the platform to which you want to move the instance")
 	cmd.AddFormatFlag(result.Flags())
 	cmd.AddModeFlag(result.Flags(), "async")
+	cmd.AddWaitFlags(result.Flags(), &trc.Wait)
+	result.ValidArgsFunction = cmd.CompleteArgs(trc.Context, cmd.InstanceNames)
+	cmd.CompleteFlag(result, "id", trc.Context, cmd.InstanceIDs)
+	cmd.CompleteFlag(result, "from", trc.Context, cmd.PlatformIDs)
+	cmd.CompleteFlag(result, "to", trc.Context, cmd.PlatformIDs)
 
 	return result
 }
@@ -91,7 +96,7 @@ func (trc *TransferCmd) Validate(args []string) error {
 // Run runs the command's logic
 func (trc *TransferCmd) Run() error {
 	if trc.instanceID == "" {
-		instances, err := trc.Client.ListInstances(&query.Parameters{
+		instances, err := trc.Client.ListInstances(trc.Ctx, &query.Parameters{
 			FieldQuery: []string{
 				fmt.Sprintf("name eq '%s'", trc.instanceName),
 				fmt.Sprintf("platform_id eq '%s'", trc.fromPlatformID),
@@ -102,7 +107,7 @@ func (trc *TransferCmd) Run() error {
 			return err
 		}
 		if len(instances.ServiceInstances) == 0 {
-			return fmt.Errorf(cmd.NO_INSTANCES_FOUND, trc.instanceName)
+			return cmd.NewError(cmd.ErrorKindNotFound, cmd.NO_INSTANCES_FOUND, trc.instanceName)
 		}
 
 		if len(instances.ServiceInstances) > 1 {
@@ -112,7 +117,7 @@ func (trc *TransferCmd) Run() error {
 		trc.instanceID = instances.ServiceInstances[0].ID
 	}
 
-	resultInstance, location, err := trc.Client.UpdateInstance(trc.instanceID, &types.ServiceInstance{
+	resultInstance, location, err := trc.Client.UpdateInstance(trc.Ctx, trc.instanceID, &types.ServiceInstance{
 		PlatformID: trc.toPlatformID,
 	}, nil)
 	if err != nil {
@@ -121,10 +126,11 @@ func (trc *TransferCmd) Run() error {
 	}
 
 	if len(location) != 0 {
-		cmd.CommonHandleAsyncExecution(trc.Context, location, fmt.Sprintf("Service Instance %s successfully scheduled for transfer to platform with id %s. To see status of the operation use:\n", trc.instanceName, trc.toPlatformID))
-		return nil
+		return cmd.CommonHandleAsyncExecution(trc.Context, location, fmt.Sprintf("Service Instance %s successfully scheduled for transfer to platform with id %s. To see status of the operation use:\n", trc.instanceName, trc.toPlatformID), trc.outputFormat)
+	}
+	if err := output.PrintServiceManagerObject(trc.Output, trc.outputFormat, resultInstance); err != nil {
+		return err
 	}
-	output.PrintServiceManagerObject(trc.Output, trc.outputFormat, resultInstance)
 	output.Println(trc.Output)
 	return nil
 }
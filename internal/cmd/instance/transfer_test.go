@@ -143,6 +143,7 @@ var _ = Describe("Transfer Command test", func() {
 				err := invalidTransferCommandExecution("no-instance", "--from", "from_platform", "--to", "to_platform")
 				message := fmt.Sprintf(cmd.NO_INSTANCES_FOUND, "no-instance")
 				Expect(err.Error()).To(Equal(message))
+				Expect(cmd.KindOf(err)).To(Equal(cmd.ErrorKindNotFound))
 			})
 		})
 
@@ -221,4 +222,18 @@ var _ = Describe("Transfer Command test", func() {
 		})
 	})
 
+	Describe("Completion", func() {
+		It("should complete the platform IDs", func() {
+			client.ListPlatformsReturns(&types.Platforms{Platforms: []types.Platform{{ID: "cf-id", Name: "cf"}, {ID: "k8s-id", Name: "k8s"}}}, nil)
+			trCmd := command.Prepare(cmd.SmPrepare)
+
+			for _, flag := range []string{"from", "to"} {
+				completePlatform, ok := trCmd.GetFlagCompletionFunc(flag)
+				Expect(ok).To(BeTrue())
+				completions, _ := completePlatform(trCmd, []string{"instance-name"}, "k8s")
+				Expect(completions).To(Equal([]string{"k8s-id\tk8s"}))
+			}
+		})
+	})
+
 })
@@ -17,27 +17,32 @@
 package instance
 
 import (
-	"encoding/json"
 	"fmt"
+	"io"
+
 	"github.com/Peripli/service-manager-cli/internal/cmd"
 	"github.com/Peripli/service-manager-cli/internal/output"
 	"github.com/Peripli/service-manager-cli/pkg/query"
 	"github.com/Peripli/service-manager-cli/pkg/types"
 
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 )
 
 type UpdateCmd struct {
 	*cmd.Context
+	input          io.Reader
+	fs             afero.Fs
 	instance       types.ServiceInstance
 	instanceName   string
 	planName       string
-	parametersJSON string
+	parameters     cmd.Parameters
+	skipValidation bool
 	outputFormat   output.Format
 }
 
-func NewUpdateInstanceCmd(context *cmd.Context) *UpdateCmd {
-	return &UpdateCmd{Context: context, instance: types.ServiceInstance{}}
+func NewUpdateInstanceCmd(context *cmd.Context, input io.Reader, fs afero.Fs) *UpdateCmd {
+	return &UpdateCmd{Context: context, input: input, fs: fs, instance: types.ServiceInstance{}}
 }
 
 // Prepare returns cobra command
@@ -53,9 +58,14 @@ func (uc *UpdateCmd) Prepare(prepare cmd.PrepareFunc) *cobra.Command {
 	result.Flags().StringVarP(&uc.instance.ID, "id", "", "", "The id of the service instance to update")
 	result.Flags().StringVarP(&uc.instance.Name, "new-name", "", "", "The new name of the service instance")
 	result.Flags().StringVarP(&uc.planName, "plan", "", "", "The name of the new service plan to use for the instance")
-	result.Flags().StringVarP(&uc.parametersJSON, "instance-params", "c", "", "Valid JSON object containing instance configuration parameters")
+	cmd.AddParametersFlags(result.Flags(), "instance-params", "Instance configuration parameters", &uc.parameters)
+	cmd.AddSkipValidationFlag(result.Flags(), &uc.skipValidation)
 	cmd.AddFormatFlag(result.Flags())
 	cmd.AddModeFlag(result.Flags(), "async")
+	cmd.AddWaitFlags(result.Flags(), &uc.Wait)
+	result.ValidArgsFunction = cmd.CompleteArgs(uc.Context, cmd.InstanceNames)
+	cmd.CompleteFlag(result, "id", uc.Context, cmd.InstanceIDs)
+	cmd.CompleteFlag(result, "plan", uc.Context, cmd.PlanNames)
 	return result
 }
 
@@ -64,13 +74,19 @@ func (uc *UpdateCmd) Validate(args []string) error {
 		return fmt.Errorf("name is required")
 	}
 	uc.instanceName = args[0]
+
+	parameters, err := uc.parameters.Load(uc.fs, uc.input)
+	if err != nil {
+		return err
+	}
+	uc.instance.Parameters = parameters
 	return nil
 }
 
 func (uc *UpdateCmd) Run() error {
 	var instanceBeforeUpdate *types.ServiceInstance
 	if uc.instance.ID == "" {
-		instances, err := uc.Client.ListInstances(&query.Parameters{
+		instances, err := uc.Client.ListInstances(uc.Ctx, &query.Parameters{
 			FieldQuery: []string{
 				fmt.Sprintf("name eq '%s'", uc.instanceName),
 			},
@@ -80,7 +96,7 @@ func (uc *UpdateCmd) Run() error {
 			return err
 		}
 		if len(instances.ServiceInstances) == 0 {
-			return fmt.Errorf(cmd.NO_INSTANCES_FOUND, uc.instanceName)
+			return cmd.NewError(cmd.ErrorKindNotFound, cmd.NO_INSTANCES_FOUND, uc.instanceName)
 		}
 
 		if len(instances.ServiceInstances) > 1 {
@@ -90,7 +106,7 @@ func (uc *UpdateCmd) Run() error {
 		instanceBeforeUpdate = &instances.ServiceInstances[0]
 
 	} else {
-		instance, err := uc.Client.GetInstanceByID(uc.instance.ID, nil)
+		instance, err := uc.Client.GetInstanceByID(uc.Ctx, uc.instance.ID, nil)
 		if err != nil {
 			return err
 		}
@@ -98,16 +114,22 @@ func (uc *UpdateCmd) Run() error {
 
 	}
 
-	if uc.planName != "" {
-		currentPlan, err := uc.Client.GetPlanByID(instanceBeforeUpdate.ServicePlanID, &uc.Parameters)
+	// the current plan is needed to find the new plan and to validate the parameters
+	var plan *types.ServicePlan
+	validateParameters := len(uc.instance.Parameters) > 0 && !uc.skipValidation
+	if uc.planName != "" || validateParameters {
+		currentPlan, err := uc.Client.GetPlanByID(uc.Ctx, instanceBeforeUpdate.ServicePlanID, &uc.Parameters)
 		if err != nil {
 			return err
 		}
+		plan = currentPlan
+	}
 
-		plans, err := uc.Client.ListPlans(&query.Parameters{
+	if uc.planName != "" {
+		plans, err := uc.Client.ListPlans(uc.Ctx, &query.Parameters{
 			FieldQuery: []string{
 				fmt.Sprintf("catalog_name eq '%s'", uc.planName),
-				fmt.Sprintf("service_offering_id eq '%s'", currentPlan.ServiceOfferingID),
+				fmt.Sprintf("service_offering_id eq '%s'", plan.ServiceOfferingID),
 			},
 			GeneralParams: uc.Parameters.GeneralParams,
 		})
@@ -116,29 +138,33 @@ func (uc *UpdateCmd) Run() error {
 			return err
 		}
 		if len(plans.ServicePlans) == 0 {
-			return fmt.Errorf("service plan with name %s for offering with id %s not found", uc.planName, currentPlan.ServiceOfferingID)
+			return cmd.NewError(cmd.ErrorKindNotFound, "service plan with name %s for offering with id %s not found", uc.planName, plan.ServiceOfferingID)
 		}
 		if len(plans.ServicePlans) > 1 {
-			return fmt.Errorf("exactly one service plan with name %s for offering with id %s expected", uc.planName, currentPlan.ServiceOfferingID)
+			return cmd.NewError(cmd.ErrorKindNotFound, "exactly one service plan with name %s for offering with id %s expected", uc.planName, plan.ServiceOfferingID)
 		}
 
-		uc.instance.ServicePlanID = plans.ServicePlans[0].ID
+		plan = &plans.ServicePlans[0]
+		uc.instance.ServicePlanID = plan.ID
 	}
-	if len(uc.parametersJSON) > 0 {
-		uc.instance.Parameters = json.RawMessage(uc.parametersJSON)
+	if validateParameters {
+		if err := cmd.ValidateParameters(plan, cmd.InstanceUpdateSchema, uc.instance.Parameters); err != nil {
+			return err
+		}
 	}
 
-	resultInstance, location, err := uc.Client.UpdateInstance(instanceBeforeUpdate.ID, &uc.instance, &uc.Parameters)
+	resultInstance, location, err := uc.Client.UpdateInstance(uc.Ctx, instanceBeforeUpdate.ID, &uc.instance, &uc.Parameters)
 	if err != nil {
 		output.PrintMessage(uc.Output, "Could not update service instance. Reason: ")
 		return err
 	}
 
 	if len(location) != 0 {
-		cmd.CommonHandleAsyncExecution(uc.Context, location, fmt.Sprintf("Service Instance %s successfully scheduled for update. To see status of the operation use:\n", uc.instance.Name))
-		return nil
+		return cmd.CommonHandleAsyncExecution(uc.Context, location, fmt.Sprintf("Service Instance %s successfully scheduled for update. To see status of the operation use:\n", uc.instance.Name), uc.outputFormat)
+	}
+	if err := output.PrintServiceManagerObject(uc.Output, uc.outputFormat, resultInstance); err != nil {
+		return err
 	}
-	output.PrintServiceManagerObject(uc.Output, uc.outputFormat, resultInstance)
 	output.Println(uc.Output)
 	return nil
 }
@@ -10,8 +10,9 @@ import (
 	"fmt"
 	"errors"
 	"io/ioutil"
-	"github.com/Peripli/service-manager/pkg/util"
+	"github.com/Peripli/service-manager-cli/pkg/smclient"
 	"net/http"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 	"encoding/json"
 )
@@ -35,7 +36,7 @@ var _ = Describe("update instance command test", func() {
 		buffer = &bytes.Buffer{}
 		client = &smclientfakes.FakeClient{}
 		context := &cmd.Context{Output: buffer, Client: client}
-		command = NewUpdateInstanceCmd(context)
+		command = NewUpdateInstanceCmd(context, &bytes.Buffer{}, afero.NewMemMapFs())
 		errGetInstance = nil
 	})
 
@@ -172,6 +173,7 @@ var _ = Describe("update instance command test", func() {
 				It("should return an error", func() {
 					err := invalidUpdateInstanceCommandExecution("instance-name", "--new-name", "new name")
 					Expect(err.Error()).To(ContainSubstring(fmt.Sprintf(cmd.NO_INSTANCES_FOUND, "instance-name")))
+					Expect(cmd.KindOf(err)).To(Equal(cmd.ErrorKindNotFound))
 				})
 
 			})
@@ -248,6 +250,7 @@ var _ = Describe("update instance command test", func() {
 				It("should return an error", func() {
 					err := invalidUpdateInstanceCommandExecution("instance-name", "--new-name", "new name", "--plan", plan.CatalogName)
 					Expect(err.Error()).To(ContainSubstring(fmt.Sprintf("exactly one service plan with name %s for offering with id %s expected", plan.CatalogName, plan.ServiceOfferingID)))
+					Expect(cmd.KindOf(err)).To(Equal(cmd.ErrorKindNotFound))
 				})
 			})
 
@@ -264,7 +267,7 @@ var _ = Describe("update instance command test", func() {
 				Context("With http response error from http client", func() {
 					It("should return error's description", func() {
 						body := ioutil.NopCloser(bytes.NewReader([]byte("HTTP response error")))
-						expectedError := util.HandleResponseError(&http.Response{Body: body})
+						expectedError := smclient.NewAPIError(&http.Response{Body: body})
 						client.UpdateInstanceReturns(nil, "", expectedError)
 						err := invalidUpdateInstanceCommandExecution("instance-name", "plan", "small")
 						Expect(err).Should(HaveOccurred())
@@ -285,4 +288,54 @@ var _ = Describe("update instance command test", func() {
 		})
 
 	})
+	Describe("parameters validation", func() {
+		BeforeEach(func() {
+			client.ListInstancesReturns(&types.ServiceInstances{ServiceInstances: []types.ServiceInstance{{ID: instanceId, Name: "myinstancename", ServicePlanID: planId}}}, nil)
+			client.GetPlanByIDReturns(&types.ServicePlan{ID: planId, Name: "small", ServiceOfferingID: offeringId, Schemas: json.RawMessage(`{
+				"service_instance": {"update": {"parameters": {"type": "object", "properties": {"color": {"enum": ["red", "green"]}}}}}}`)}, nil)
+			client.ListPlansReturns(&types.ServicePlans{ServicePlans: []types.ServicePlan{{ID: newPlanId, Name: "large", ServiceOfferingID: offeringId, Schemas: json.RawMessage(`{
+				"service_instance": {"update": {"parameters": {"type": "object", "properties": {"color": {"enum": ["blue"]}}}}}}`)}}}, nil)
+			client.UpdateInstanceReturns(&types.ServiceInstance{ID: instanceId, Name: "myinstancename"}, "", nil)
+		})
+
+		It("should validate against the schema of the current plan", func() {
+			err := invalidUpdateInstanceCommandExecution("myinstancename", "--instance-params", `{"color":"blue"}`)
+
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("parameters do not match the service_instance.update schema of plan small"))
+			Expect(err.Error()).To(ContainSubstring("$.color: "))
+			Expect(client.UpdateInstanceCallCount()).To(Equal(0))
+		})
+
+		It("should validate against the schema of the new plan", func() {
+			err := invalidUpdateInstanceCommandExecution("myinstancename", "--plan", "large", "--instance-params", `{"color":"blue"}`, "--mode", "sync")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			_, _, instanceArg, _ := client.UpdateInstanceArgsForCall(0)
+			Expect(instanceArg.ServicePlanID).To(Equal(newPlanId))
+			Expect(string(instanceArg.Parameters)).To(Equal(`{"color":"blue"}`))
+		})
+
+		It("should not validate without parameters", func() {
+			err := invalidUpdateInstanceCommandExecution("myinstancename", "--new-name", "renamed", "--mode", "sync")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(client.GetPlanByIDCallCount()).To(Equal(0))
+		})
+
+		It("should update without validation with skip validation flag", func() {
+			err := invalidUpdateInstanceCommandExecution("myinstancename", "--instance-params", `{"color":"blue"}`, "--skip-validation", "--mode", "sync")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(client.GetPlanByIDCallCount()).To(Equal(0))
+			Expect(client.UpdateInstanceCallCount()).To(Equal(1))
+		})
+
+		It("should reject parameters which are not a JSON object", func() {
+			err := invalidUpdateInstanceCommandExecution("myinstancename", "--instance-params", `"blue"`)
+
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("parameters must be a JSON or YAML object"))
+		})
+	})
 })
@@ -62,6 +62,8 @@ Instance can be shared only if it was created with the plan that supports instan
 	}
 	result.Flags().StringVarP(&shc.instanceID, "id", "", "", cmd.INSTANCE_ID_DESCRIPTION)
 	cmd.AddFormatFlag(result.Flags())
+	result.ValidArgsFunction = cmd.CompleteArgs(shc.Context, cmd.InstanceNames)
+	cmd.CompleteFlag(result, "id", shc.Context, cmd.InstanceIDs)
 	return result
 }
 
@@ -77,7 +79,7 @@ func (shc *UpdateSharingCmd) Validate(args []string) error {
 // Run runs the command's logic
 func (shc *UpdateSharingCmd) Run() error {
 	if shc.instanceID == "" {
-		instances, err := shc.Client.ListInstances(&query.Parameters{
+		instances, err := shc.Client.ListInstances(shc.Ctx, &query.Parameters{
 			FieldQuery: []string{
 				fmt.Sprintf("name eq '%s'", shc.instanceName),
 			},
@@ -87,7 +89,7 @@ func (shc *UpdateSharingCmd) Run() error {
 			return err
 		}
 		if len(instances.ServiceInstances) == 0 {
-			return fmt.Errorf(cmd.NO_INSTANCES_FOUND, shc.instanceName)
+			return cmd.NewError(cmd.ErrorKindNotFound, cmd.NO_INSTANCES_FOUND, shc.instanceName)
 		}
 
 		if len(instances.ServiceInstances) > 1 {
@@ -99,7 +101,7 @@ func (shc *UpdateSharingCmd) Run() error {
 	shc.Parameters.GeneralParams = append(shc.Parameters.GeneralParams, fmt.Sprintf("%s=%s", web.QueryParamAsync, "false"))
 	shared:=new(bool)
 	*shared = shc.share
-	resultInstance, _, err := shc.Client.UpdateInstance(shc.instanceID, &types.ServiceInstance{
+	resultInstance, _, err := shc.Client.UpdateInstance(shc.Ctx, shc.instanceID, &types.ServiceInstance{
 		Shared: shared,
 	}, &query.Parameters{
 		GeneralParams: shc.Parameters.GeneralParams,
@@ -109,7 +111,9 @@ func (shc *UpdateSharingCmd) Run() error {
 		return err
 	}
 
-	output.PrintServiceManagerObject(shc.Output, shc.outputFormat, resultInstance)
+	if err := output.PrintServiceManagerObject(shc.Output, shc.outputFormat, resultInstance); err != nil {
+		return err
+	}
 	output.Println(shc.Output)
 	return nil
 }
@@ -21,9 +21,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/Peripli/service-manager-cli/internal/cmd"
+	"github.com/Peripli/service-manager-cli/pkg/smclient"
 	"github.com/Peripli/service-manager-cli/pkg/smclient/smclientfakes"
 	"github.com/Peripli/service-manager-cli/pkg/types"
-	"github.com/Peripli/service-manager/pkg/util"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/spf13/cobra"
@@ -147,6 +147,7 @@ var _ = Describe("Update sharing instance command test", func() {
 					It("should return an error", func() {
 						err := invalidUpdateSharingCommandExecution("instance-name")
 						Expect(err.Error()).To(ContainSubstring(fmt.Sprintf(cmd.NO_INSTANCES_FOUND, "instance-name")))
+						Expect(cmd.KindOf(err)).To(Equal(cmd.ErrorKindNotFound))
 					})
 
 				})
@@ -179,7 +180,7 @@ var _ = Describe("Update sharing instance command test", func() {
 							},
 						}, nil)
 						body := ioutil.NopCloser(bytes.NewReader([]byte("HTTP response error")))
-						expectedError := util.HandleResponseError(&http.Response{Body: body})
+						expectedError := smclient.NewAPIError(&http.Response{Body: body})
 						client.UpdateInstanceReturns(nil, "", expectedError)
 					})
 					It("should return error's description", func() {
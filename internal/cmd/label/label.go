@@ -39,10 +39,28 @@ func (c *Cmd) Prepare(prepare cmd.PrepareFunc) *cobra.Command {
 
 	result.Flags().StringArrayVar(&c.values, "val", []string{}, "Label value to be used")
 	cmd.AddCommonQueryFlag(result.Flags(), &c.Parameters)
+	result.ValidArgsFunction = cmd.CompleteArgs(c.Context,
+		cmd.StaticCompletion("platform", "broker", "service-instance"),
+		labelTargetIDs,
+		cmd.StaticCompletion("add", "remove", "add-values", "remove-values"))
 
 	return result
 }
 
+// labelTargetIDs lists the IDs of the resources of the type given as first argument
+func labelTargetIDs(ctx *cmd.Context, args []string) ([]string, error) {
+	switch args[0] {
+	case "platform":
+		return cmd.PlatformIDs(ctx, args)
+	case "broker":
+		return cmd.BrokerIDs(ctx, args)
+	case "service-instance":
+		return cmd.InstanceIDs(ctx, args)
+	default:
+		return nil, nil
+	}
+}
+
 // Validate validates command's arguments
 func (c *Cmd) Validate(args []string) error {
 
@@ -95,7 +113,7 @@ func (c *Cmd) Validate(args []string) error {
 
 // Run runs the command's logic
 func (c *Cmd) Run() error {
-	if err := c.Client.Label(c.resourcePath, c.id, &c.labelChanges, &c.Parameters); err != nil {
+	if err := c.Client.Label(c.Ctx, c.resourcePath, c.id, &c.labelChanges, &c.Parameters); err != nil {
 		return err
 	}
 
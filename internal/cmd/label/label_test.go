@@ -10,12 +10,13 @@ import (
 	"net/http"
 
 	"github.com/Peripli/service-manager-cli/internal/cmd"
+	"github.com/Peripli/service-manager-cli/pkg/smclient"
 	"github.com/Peripli/service-manager-cli/pkg/smclient/smclientfakes"
 	"github.com/Peripli/service-manager-cli/pkg/types"
-	"github.com/Peripli/service-manager/pkg/util"
 	"github.com/Peripli/service-manager/pkg/web"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
 )
 
 func TestLabelCmd(t *testing.T) {
@@ -65,7 +66,7 @@ var _ = Describe("Label Command test", func() {
 				err := validLabelExecution("platform", "id", "add-values", "key", "--val", "val1", "--val", "val2", "--val", "val3")
 
 				Expect(err).ShouldNot(HaveOccurred())
-				resourcePath, id, changes, _ := client.LabelArgsForCall(0)
+				_, resourcePath, id, changes, _ := client.LabelArgsForCall(0)
 				Expect(resourcePath).To(Equal(web.PlatformsURL))
 				Expect(id).To(Equal("id"))
 				Expect(changes).To(Equal(labelChanges))
@@ -147,7 +148,7 @@ var _ = Describe("Label Command test", func() {
 			It("should return error's description", func() {
 				description := "HTTP response error"
 				body := ioutil.NopCloser(bytes.NewReader([]byte("HTTP response error")))
-				expectedError := util.HandleResponseError(&http.Response{Body: body})
+				expectedError := smclient.NewAPIError(&http.Response{Body: body})
 				client.LabelReturns(expectedError)
 				err := invalidLabelExecution("platform", "id", "add", "key", "--val", "value")
 				Expect(err).Should(HaveOccurred())
@@ -158,4 +159,34 @@ var _ = Describe("Label Command test", func() {
 
 	})
 
+	Describe("Completion", func() {
+		complete := func(args ...string) []string {
+			lc := command.Prepare(cmd.SmPrepare)
+			completions, directive := lc.ValidArgsFunction(lc, args[:len(args)-1], args[len(args)-1])
+			Expect(directive).To(Equal(cobra.ShellCompDirectiveNoFileComp))
+			return completions
+		}
+
+		It("should complete the resource types", func() {
+			Expect(complete("")).To(Equal([]string{"platform", "broker", "service-instance"}))
+		})
+
+		It("should complete the IDs of the resources of the type", func() {
+			client.ListBrokersReturns(&types.Brokers{Brokers: []types.Broker{{ID: "broker-id", Name: "broker-name"}}}, nil)
+			client.ListPlatformsReturns(&types.Platforms{Platforms: []types.Platform{{ID: "platform-id", Name: "platform-name"}}}, nil)
+
+			Expect(complete("broker", "")).To(Equal([]string{"broker-id\tbroker-name"}))
+			Expect(complete("platform", "")).To(Equal([]string{"platform-id\tplatform-name"}))
+			Expect(client.ListInstancesCallCount()).To(Equal(0))
+		})
+
+		It("should complete nothing for unknown resource types", func() {
+			Expect(complete("visibility", "")).To(BeEmpty())
+		})
+
+		It("should complete the operations", func() {
+			Expect(complete("platform", "id", "add")).To(Equal([]string{"add", "add-values"}))
+		})
+	})
+
 })
@@ -57,6 +57,7 @@ type Cmd struct {
 	cert               string
 	key                string
 	authenticationFlow auth.Flow
+	noBrowser          bool
 
 	authBuilder authenticationBuilder
 }
@@ -88,7 +89,8 @@ func (lc *Cmd) Prepare(prepare cmd.PrepareFunc) *cobra.Command {
 	result.Flags().StringVarP(&lc.cert, "cert", "", "", "Path to the file which contains the certificate (public-key)")
 	result.Flags().StringVarP(&lc.key, "key", "", "", "Path to the file which contains the key (private-key)")
 	result.Flags().BoolVarP(&lc.sslDisabled, "skip-ssl-validation", "", false, "Skip verification of the OAuth endpoint. Not recommended!")
-	result.Flags().StringVarP((*string)(&lc.authenticationFlow), "auth-flow", "", string(auth.PasswordGrant), `Authentication flow (grant type): "client-credentials" or "password-grant"`)
+	result.Flags().StringVarP((*string)(&lc.authenticationFlow), "auth-flow", "", string(auth.PasswordGrant), `Authentication flow (grant type): "client-credentials", "password-grant", "authorization-code" or "device-code"`)
+	result.Flags().BoolVarP(&lc.noBrowser, "no-browser", "", false, "Do not open a browser in the authorization-code flow, only print the authorization URL")
 	cmd.AddCommonQueryFlag(result.Flags(), &lc.Parameters)
 
 	return result
@@ -124,14 +126,14 @@ func (lc *Cmd) Run() error {
 	}
 
 	if lc.Client == nil {
-		lc.Client = smclient.NewClient(lc.Ctx, httpClient, lc.serviceManagerURL)
+		lc.Client = smclient.NewClient(httpClient, lc.serviceManagerURL)
 	}
 
 	if lc.authenticationFlow == auth.ClientCredentials {
 		lc.Parameters.GeneralParams = append(lc.Parameters.GeneralParams, "grant_type=client_credentials")
 	}
 
-	info, err := lc.Client.GetInfo(&lc.Parameters)
+	info, err := lc.Client.GetInfo(lc.Ctx, &lc.Parameters)
 	if err != nil {
 		return cliErr.New("Could not get Service Manager info", err)
 	}
@@ -182,6 +184,11 @@ func (lc *Cmd) Run() error {
 	if err != nil {
 		return err
 	}
+	if lc.Target != "" {
+		if err := lc.Configuration.UseTarget(lc.Target); err != nil {
+			return err
+		}
+	}
 
 	output.PrintMessage(lc.Output, "Logged in successfully.\n")
 	return nil
@@ -193,11 +200,31 @@ func (lc *Cmd) getToken(authStrategy auth.Authenticator) (*auth.Token, error) {
 		return authStrategy.ClientCredentials()
 	case auth.PasswordGrant:
 		return authStrategy.PasswordCredentials(lc.user, lc.password)
+	case auth.AuthorizationCode:
+		return authStrategy.AuthorizationCode(lc.openAuthorizationURL)
+	case auth.DeviceCode:
+		return authStrategy.DeviceCode(lc.printDeviceCode)
 	default:
 		return nil, fmt.Errorf("authentication flow %s not recognized", lc.authenticationFlow)
 	}
 }
 
+func (lc *Cmd) openAuthorizationURL(authorizationURL string) error {
+	output.PrintMessage(lc.Output, "Open the following URL in your browser to log in:\n%s\n", authorizationURL)
+	if !lc.noBrowser {
+		if err := util.OpenBrowser(authorizationURL); err != nil {
+			output.PrintMessage(lc.Output, "Could not open browser: %s\n", err)
+		}
+	}
+	output.PrintMessage(lc.Output, "Waiting for authorization...\n")
+	return nil
+}
+
+func (lc *Cmd) printDeviceCode(userCode, verificationURI string) {
+	output.PrintMessage(lc.Output, "To log in, open %s on any device and enter the code %s\n", verificationURI, userCode)
+	output.PrintMessage(lc.Output, "Waiting for authorization...\n")
+}
+
 func (lc *Cmd) validateLoginFlow() error {
 	switch lc.authenticationFlow {
 	case auth.ClientCredentials:
@@ -208,6 +235,10 @@ func (lc *Cmd) validateLoginFlow() error {
 		}
 	case auth.PasswordGrant:
 		return lc.validatePasswordGrant()
+	case auth.AuthorizationCode, auth.DeviceCode:
+		if len(lc.clientID) == 0 {
+			lc.clientID = defaultClientID
+		}
 	default:
 		return fmt.Errorf("unknown authentication flow: %s", lc.authenticationFlow)
 	}
@@ -77,6 +77,20 @@ var _ = Describe("Login Command test", func() {
 			})
 		})
 
+		Context("With target selected", func() {
+			It("should save to the selected target and make it current", func() {
+				command.Target = "prod"
+				lc.SetArgs([]string{"--url=http://valid-url.com", "--user=user", "--password=password"})
+
+				err := lc.Execute()
+
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(config.SelectTargetArgsForCall(0)).To(Equal("prod"))
+				Expect(config.SaveCallCount()).To(Equal(1))
+				Expect(config.UseTargetArgsForCall(0)).To(Equal("prod"))
+			})
+		})
+
 		Context("With password and client id provided through flags", func() {
 			It("should save configuration successfully without client credentials", func() {
 				lc.SetArgs([]string{"--url=http://valid-url.com", "--password=password", "--client-id=smctl"})
@@ -141,7 +155,7 @@ var _ = Describe("Login Command test", func() {
 				err := lc.Execute()
 				Expect(err).ToNot(HaveOccurred())
 
-				args := client.GetInfoArgsForCall(0)
+				_, args := client.GetInfoArgsForCall(0)
 				Expect(args.GeneralParams).To(ConsistOf(param))
 			})
 			When("client id secret are provided through flag", func() {
@@ -170,6 +184,48 @@ var _ = Describe("Login Command test", func() {
 			})
 		})
 
+		Context("With authorization code flow", func() {
+			It("should print the authorization URL and save the default client id", func() {
+				authStrategy.AuthorizationCodeStub = func(openURL func(string) error) (*auth.Token, error) {
+					Expect(openURL("http://valid-uaa.com/oauth/authorize")).To(Succeed())
+					return &auth.Token{AccessToken: "access-token"}, nil
+				}
+				lc.SetArgs([]string{"--url=http://valid-url.com", "--auth-flow=authorization-code", "--no-browser"})
+
+				err := lc.Execute()
+
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(outputBuffer.String()).To(ContainSubstring("http://valid-uaa.com/oauth/authorize"))
+				Expect(outputBuffer.String()).To(ContainSubstring("Logged in successfully.\n"))
+				Expect(authOptions.ClientID).To(Equal("cf"))
+
+				savedConfig := config.SaveArgsForCall(0)
+				Expect(savedConfig.AuthFlow).To(Equal(auth.AuthorizationCode))
+				Expect(savedConfig.ClientID).To(Equal("cf"))
+			})
+		})
+
+		Context("With device code flow", func() {
+			It("should print the user code and verification URI", func() {
+				authStrategy.DeviceCodeStub = func(prompt func(string, string)) (*auth.Token, error) {
+					prompt("ABCD-EFGH", "https://uaa.com/activate")
+					return &auth.Token{AccessToken: "access-token"}, nil
+				}
+				lc.SetArgs([]string{"--url=http://valid-url.com", "--auth-flow=device-code"})
+
+				err := lc.Execute()
+
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(outputBuffer.String()).To(ContainSubstring("open https://uaa.com/activate on any device and enter the code ABCD-EFGH"))
+				Expect(outputBuffer.String()).To(ContainSubstring("Logged in successfully.\n"))
+
+				savedConfig := config.SaveArgsForCall(0)
+				Expect(savedConfig.AuthFlow).To(Equal(auth.DeviceCode))
+				Expect(savedConfig.ClientID).To(Equal("cf"))
+				Expect(savedConfig.AccessToken).To(Equal("access-token"))
+			})
+		})
+
 		Context("Use token_basic_auth returned by info endpoint", func() {
 			for _, tokenBasicAuth := range []bool{true, false} {
 				tokenBasicAuth := tokenBasicAuth
@@ -262,7 +318,7 @@ var _ = Describe("Login Command test", func() {
 					err := lc.Execute()
 
 					Expect(err).Should(HaveOccurred())
-					Expect(err).To(Equal(validationError))
+					Expect(err).To(MatchError(validationError))
 				})
 			})
 
@@ -273,7 +329,7 @@ var _ = Describe("Login Command test", func() {
 					err := lc.Execute()
 
 					Expect(err).Should(HaveOccurred())
-					Expect(err).To(Equal(validationError))
+					Expect(err).To(MatchError(validationError))
 				})
 			})
 
@@ -284,7 +340,7 @@ var _ = Describe("Login Command test", func() {
 					err := lc.Execute()
 
 					Expect(err).Should(HaveOccurred())
-					Expect(err).To(Equal(validationError))
+					Expect(err).To(MatchError(validationError))
 				})
 				It("fails due to missing cert", func() {
 					lc.SetArgs([]string{"--url=http://valid-url.com", "--auth-flow=client-credentials", "--client-id=id", "--key=key.pem"})
@@ -292,7 +348,7 @@ var _ = Describe("Login Command test", func() {
 					err := lc.Execute()
 
 					Expect(err).Should(HaveOccurred())
-					Expect(err).To(Equal(validationError))
+					Expect(err).To(MatchError(validationError))
 				})
 				It("fails due to missing key", func() {
 					lc.SetArgs([]string{"--url=http://valid-url.com", "--auth-flow=client-credentials", "--cert=cert.pem", "--key=key.pem"})
@@ -300,7 +356,7 @@ var _ = Describe("Login Command test", func() {
 					err := lc.Execute()
 
 					Expect(err).Should(HaveOccurred())
-					Expect(err).To(Equal(validationError))
+					Expect(err).To(MatchError(validationError))
 				})
 			})
 		})
@@ -0,0 +1,29 @@
+/*
+ * Copyright 2018 The Service Manager Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package cmd
+
+// Kinds of the resources of the manifests created by smctl export and applied by smctl apply
+const (
+	ManifestKindPlatform   = "platform"
+	ManifestKindBroker     = "broker"
+	ManifestKindVisibility = "visibility"
+	ManifestKindInstance   = "instance"
+	ManifestKindBinding    = "binding"
+)
+
+// ManifestKinds lists the kinds of manifest resources in the order of their dependencies, in which smctl apply creates them
+var ManifestKinds = []string{ManifestKindPlatform, ManifestKindBroker, ManifestKindVisibility, ManifestKindInstance, ManifestKindBinding}
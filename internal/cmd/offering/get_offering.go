@@ -0,0 +1,156 @@
+/*
+ * Copyright 2018 The Service Manager Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package offering
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Peripli/service-manager-cli/internal/cmd"
+	"github.com/Peripli/service-manager-cli/internal/output"
+	"github.com/Peripli/service-manager-cli/pkg/query"
+	"github.com/Peripli/service-manager-cli/pkg/smclient"
+	"github.com/Peripli/service-manager-cli/pkg/types"
+)
+
+// GetOfferingCmd wraps the smctl get-offering command
+type GetOfferingCmd struct {
+	*cmd.Context
+
+	nameOrID     string
+	brokerName   string
+	prepare      cmd.PrepareFunc
+	outputFormat output.Format
+}
+
+// NewGetOfferingCmd returns new get-offering command with context
+func NewGetOfferingCmd(context *cmd.Context) *GetOfferingCmd {
+	return &GetOfferingCmd{Context: context}
+}
+
+// Run runs the command's logic
+func (gof *GetOfferingCmd) Run() error {
+	id, err := gof.resolveID()
+	if err != nil {
+		return err
+	}
+
+	offering, err := gof.Client.GetOfferingByID(gof.Ctx, id, &gof.Parameters)
+	if err != nil {
+		if smclient.IsNotFound(err) {
+			return cmd.NewError(cmd.ErrorKindNotFound, "no service offering found with name or id: %s", gof.nameOrID)
+		}
+		return err
+	}
+	if err := output.PrintServiceManagerObject(gof.Output, gof.outputFormat, offering); err != nil {
+		return err
+	}
+	output.Println(gof.Output)
+
+	return nil
+}
+
+// resolveID returns the id of the offering with the provided name, or the argument itself if there is no such offering
+func (gof *GetOfferingCmd) resolveID() (string, error) {
+	offerings, err := gof.Client.ListOfferings(gof.Ctx, &query.Parameters{
+		FieldQuery: []string{
+			fmt.Sprintf("name eq '%s'", gof.nameOrID),
+		},
+		GeneralParams: gof.Parameters.GeneralParams,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if gof.brokerName != "" {
+		brokers, err := gof.Client.ListBrokers(gof.Ctx, &query.Parameters{
+			FieldQuery: []string{
+				fmt.Sprintf("name eq '%s'", gof.brokerName),
+			},
+			GeneralParams: gof.Parameters.GeneralParams,
+		})
+		if err != nil {
+			return "", err
+		}
+		if len(brokers.Brokers) != 1 {
+			return "", cmd.NewError(cmd.ErrorKindNotFound, "broker with name %s not found", gof.brokerName)
+		}
+		offerings = offeringsOfBroker(offerings, brokers.Brokers[0].ID)
+	}
+
+	switch len(offerings.ServiceOfferings) {
+	case 0:
+		return gof.nameOrID, nil
+	case 1:
+		return offerings.ServiceOfferings[0].ID, nil
+	default:
+		return "", fmt.Errorf("more than one service offering with name %s found. Use -b flag to specify broker name", gof.nameOrID)
+	}
+}
+
+func offeringsOfBroker(offerings *types.ServiceOfferings, brokerID string) *types.ServiceOfferings {
+	result := &types.ServiceOfferings{}
+	for _, offering := range offerings.ServiceOfferings {
+		if offering.BrokerID == brokerID {
+			result.ServiceOfferings = append(result.ServiceOfferings, offering)
+		}
+	}
+	return result
+}
+
+// Validate validates command's arguments
+func (gof *GetOfferingCmd) Validate(args []string) error {
+	if len(args) < 1 || len(args[0]) == 0 {
+		return fmt.Errorf("name or id is required")
+	}
+
+	gof.nameOrID = args[0]
+
+	return nil
+}
+
+// SetOutputFormat set output format
+func (gof *GetOfferingCmd) SetOutputFormat(format output.Format) {
+	gof.outputFormat = format
+}
+
+// HideUsage hide command's usage
+func (gof *GetOfferingCmd) HideUsage() bool {
+	return true
+}
+
+// Prepare returns cobra command
+func (gof *GetOfferingCmd) Prepare(prepare cmd.PrepareFunc) *cobra.Command {
+	gof.prepare = prepare
+	result := &cobra.Command{
+		Use:     "get-offering [name|id]",
+		Aliases: []string{"go"},
+		Short:   "Get single service offering",
+		Long:    `Get single service offering by its name or id`,
+		PreRunE: gof.prepare(gof, gof.Context),
+		RunE:    cmd.RunE(gof),
+	}
+
+	result.Flags().StringVarP(&gof.brokerName, "broker", "b", "", "Name of the broker which provides the service offering")
+	cmd.AddFormatFlag(result.Flags())
+	cmd.AddCommonQueryFlag(result.Flags(), &gof.Parameters)
+	result.ValidArgsFunction = cmd.CompleteArgs(gof.Context, cmd.OfferingNames)
+	cmd.CompleteFlag(result, "broker", gof.Context, cmd.BrokerNames)
+
+	return result
+}
@@ -0,0 +1,81 @@
+package offering
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/Peripli/service-manager-cli/internal/cmd"
+	"github.com/Peripli/service-manager-cli/pkg/smclient/smclientfakes"
+	"github.com/Peripli/service-manager-cli/pkg/types"
+)
+
+var _ = Describe("Get offering command test", func() {
+
+	var client *smclientfakes.FakeClient
+	var command *GetOfferingCmd
+	var buffer *bytes.Buffer
+	offering1 := types.ServiceOffering{ID: "offering-id-1", Name: "postgres", BrokerID: "broker-id-1"}
+	offering2 := types.ServiceOffering{ID: "offering-id-2", Name: "postgres", BrokerID: "broker-id-2"}
+
+	BeforeEach(func() {
+		buffer = &bytes.Buffer{}
+		client = &smclientfakes.FakeClient{}
+		client.GetOfferingByIDReturns(&offering1, nil)
+		context := &cmd.Context{Output: buffer, Client: client}
+		command = NewGetOfferingCmd(context)
+	})
+
+	executeWithArgs := func(args ...string) error {
+		commandToRun := command.Prepare(cmd.SmPrepare)
+		commandToRun.SetArgs(args)
+
+		return commandToRun.Execute()
+	}
+
+	Context("when offering with name is found", func() {
+		It("should get it by its id and print all its fields", func() {
+			client.ListOfferingsReturns(&types.ServiceOfferings{ServiceOfferings: []types.ServiceOffering{offering1}}, nil)
+			err := executeWithArgs("postgres")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			_, id, _ := client.GetOfferingByIDArgsForCall(0)
+			Expect(id).To(Equal("offering-id-1"))
+			Expect(buffer.String()).To(ContainSubstring(offering1.TableData().String()))
+		})
+	})
+
+	Context("when no offering has the name", func() {
+		It("should get the offering with this id", func() {
+			client.ListOfferingsReturns(&types.ServiceOfferings{}, nil)
+			err := executeWithArgs("offering-id-1")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			_, id, _ := client.GetOfferingByIDArgsForCall(0)
+			Expect(id).To(Equal("offering-id-1"))
+		})
+	})
+
+	Context("when more than one offering has the name", func() {
+		BeforeEach(func() {
+			client.ListOfferingsReturns(&types.ServiceOfferings{ServiceOfferings: []types.ServiceOffering{offering1, offering2}}, nil)
+		})
+
+		It("should require the broker name", func() {
+			err := executeWithArgs("postgres")
+
+			Expect(err).To(MatchError(ContainSubstring("more than one service offering with name postgres found")))
+			Expect(client.GetOfferingByIDCallCount()).To(Equal(0))
+		})
+
+		It("should get the offering of the broker", func() {
+			client.ListBrokersReturns(&types.Brokers{Brokers: []types.Broker{{ID: "broker-id-2", Name: "broker2"}}}, nil)
+			err := executeWithArgs("postgres", "-b", "broker2")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			_, id, _ := client.GetOfferingByIDArgsForCall(0)
+			Expect(id).To(Equal("offering-id-2"))
+		})
+	})
+})
@@ -19,7 +19,11 @@ package offering
 import (
 	"github.com/Peripli/service-manager-cli/internal/cmd"
 	"github.com/Peripli/service-manager-cli/internal/output"
+	"github.com/Peripli/service-manager-cli/pkg/smclient"
+	"github.com/Peripli/service-manager-cli/pkg/types"
 	"github.com/spf13/cobra"
+
+	"github.com/Peripli/service-manager/pkg/web"
 )
 
 // ListOfferingsCmd wraps the smctl list-offerings command
@@ -35,12 +39,21 @@ func NewListOfferingsCmd(context *cmd.Context) *ListOfferingsCmd {
 
 // Run runs the command's logic
 func (lo *ListOfferingsCmd) Run() error {
-	offerings, err := lo.Client.ListOfferings(&lo.Parameters)
+	if lo.Paging.IsSet() {
+		return cmd.PrintPages(lo.Context, smclient.NewPager(lo.Ctx, lo.Client, web.ServiceOfferingsURL, &lo.Parameters, &lo.Paging.PageOptions), lo.outputFormat, func() (types.ServiceManagerObject, interface{}) {
+			offerings := &types.ServiceOfferings{}
+			return offerings, &offerings.ServiceOfferings
+		}, nil)
+	}
+
+	offerings, err := lo.Client.ListOfferings(lo.Ctx, &lo.Parameters)
 	if err != nil {
 		return err
 	}
 
-	output.PrintServiceManagerObject(lo.Output, lo.outputFormat, offerings)
+	if err := output.PrintServiceManagerObject(lo.Output, lo.outputFormat, offerings); err != nil {
+		return err
+	}
 	output.Println(lo.Output)
 
 	return nil
@@ -70,6 +83,7 @@ func (lo *ListOfferingsCmd) Prepare(prepare cmd.PrepareFunc) *cobra.Command {
 	cmd.AddFormatFlag(result.Flags())
 	cmd.AddQueryingFlags(result.Flags(), &lo.Parameters)
 	cmd.AddCommonQueryFlag(result.Flags(), &lo.Parameters)
+	cmd.AddPagingFlags(result.Flags(), &lo.Paging)
 
 	return result
 }
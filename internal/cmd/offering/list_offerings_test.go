@@ -100,7 +100,7 @@ var _ = Describe("List offerings command test", func() {
 			err := executeWithArgs([]string{"--param", param})
 			Expect(err).ShouldNot(HaveOccurred())
 
-			args := client.ListOfferingsArgsForCall(0)
+			_, args := client.ListOfferingsArgsForCall(0)
 
 			Expect(args.GeneralParams).To(ConsistOf(param))
 			Expect(args.FieldQuery).To(BeEmpty())
@@ -114,7 +114,7 @@ var _ = Describe("List offerings command test", func() {
 			client.ListOfferingsReturns(result, nil)
 			err := executeWithArgs([]string{"--environment", "kubernetes"})
 			Expect(err).ShouldNot(HaveOccurred())
-			args := client.ListOfferingsArgsForCall(0)
+			_, args := client.ListOfferingsArgsForCall(0)
 			Expect(args.Environment).To(Equal("kubernetes"))
 
 		})
@@ -127,7 +127,7 @@ var _ = Describe("List offerings command test", func() {
 			param := "name eq 'offering1'"
 			err := executeWithArgs([]string{"--field-query", param})
 
-			args := client.ListOfferingsArgsForCall(0)
+			_, args := client.ListOfferingsArgsForCall(0)
 
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(args.FieldQuery).To(ConsistOf(param))
@@ -142,7 +142,7 @@ var _ = Describe("List offerings command test", func() {
 			param := "test eq false"
 			err := executeWithArgs([]string{"--label-query", param})
 
-			args := client.ListOfferingsArgsForCall(0)
+			_, args := client.ListOfferingsArgsForCall(0)
 
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(args.LabelQuery).To(ConsistOf(param))
@@ -40,12 +40,14 @@ func NewMarketplaceCmd(context *cmd.Context) *MarketplaceCmd {
 
 // Run runs the command's logic
 func (m *MarketplaceCmd) Run() error {
-	marketplace, err := m.Client.Marketplace(&m.Parameters)
+	marketplace, err := m.Client.Marketplace(m.Ctx, &m.Parameters)
 	if err != nil {
 		return err
 	}
 	if m.offering == "" {
-		output.PrintServiceManagerObject(m.Output, m.outputFormat, marketplace)
+		if err := output.PrintServiceManagerObject(m.Output, m.outputFormat, marketplace); err != nil {
+			return err
+		}
 	} else {
 		plans := &types.ServicePlansForOffering{}
 		for _, v := range marketplace.ServiceOfferings {
@@ -53,7 +55,9 @@ func (m *MarketplaceCmd) Run() error {
 				plans.ServicePlans = append(plans.ServicePlans, v.Plans...)
 			}
 		}
-		output.PrintServiceManagerObject(m.Output, m.outputFormat, plans)
+		if err := output.PrintServiceManagerObject(m.Output, m.outputFormat, plans); err != nil {
+			return err
+		}
 	}
 	output.Println(m.Output)
 	return nil
@@ -85,6 +89,7 @@ func (m *MarketplaceCmd) Prepare(prepare cmd.PrepareFunc) *cobra.Command {
 	cmd.AddSupportedEnvironmentFlag(result.Flags(), &m.Parameters, "Filters service offerings by supported environments")
 	result.Flags().StringVarP(&m.offering, "service", "s", "", "Plan details for a single service offering")
 	cmd.AddCommonQueryFlag(result.Flags(), &m.Parameters)
+	cmd.CompleteFlag(result, "service", m.Context, cmd.OfferingNames)
 
 	return result
 }
@@ -99,7 +99,7 @@ var _ = Describe("Marketplace command test", func() {
 			client.MarketplaceReturns(result, nil)
 			err := executeWithArgs([]string{"--environment", "kubernetes"})
 			Expect(err).ShouldNot(HaveOccurred())
-			args := client.MarketplaceArgsForCall(0)
+			_, args := client.MarketplaceArgsForCall(0)
 			Expect(args.Environment).To(Equal("kubernetes"))
 
 		})
@@ -113,7 +113,7 @@ var _ = Describe("Marketplace command test", func() {
 			err := executeWithArgs([]string{"--param", param})
 			Expect(err).ShouldNot(HaveOccurred())
 
-			args := client.MarketplaceArgsForCall(0)
+			_, args := client.MarketplaceArgsForCall(0)
 
 			Expect(args.GeneralParams).To(ConsistOf(param))
 			Expect(args.FieldQuery).To(BeEmpty())
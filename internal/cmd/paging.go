@@ -0,0 +1,107 @@
+/*
+ * Copyright 2018 The Service Manager Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/spf13/pflag"
+
+	"github.com/Peripli/service-manager-cli/internal/output"
+	"github.com/Peripli/service-manager-cli/pkg/smclient"
+	"github.com/Peripli/service-manager-cli/pkg/types"
+)
+
+// PagingOptions holds the values of the paging flags of list commands
+type PagingOptions struct {
+	smclient.PageOptions
+	Stream bool
+}
+
+// IsSet returns whether any of the paging flags was provided
+func (o *PagingOptions) IsSet() bool {
+	return o.Stream || o.MaxItems != 0 || o.PageSize != 0 || o.PageToken != ""
+}
+
+func (o *PagingOptions) validate() error {
+	if o.MaxItems < 0 {
+		return fmt.Errorf("--max-items must not be negative")
+	}
+	if o.PageSize < 0 {
+		return fmt.Errorf("--page-size must not be negative")
+	}
+	return nil
+}
+
+// AddPagingFlags adds the --max-items, --page-size, --page-token and --stream flags
+func AddPagingFlags(flags *pflag.FlagSet, options *PagingOptions) {
+	flags.IntVarP(&options.MaxItems, "max-items", "", 0, "Maximum number of items to list. 0 means no limit")
+	flags.IntVarP(&options.PageSize, "page-size", "", 0, "Number of items to request per page. 0 means the server default")
+	flags.StringVarP(&options.PageToken, "page-token", "", "", "Token of the page to start listing from, as printed by a previous listing")
+	flags.BoolVarP(&options.Stream, "stream", "", false, "Print items as each page arrives instead of after all pages are loaded")
+}
+
+// NewListFunc returns an empty list object and a pointer to its items slice
+type NewListFunc func() (types.ServiceManagerObject, interface{})
+
+// PrintPages loads the pages of pager and prints them according to the paging options of the context.
+// Credentials are masked unless --show-credentials is used.
+// If process is not nil, it is called with every page before the page is printed.
+func PrintPages(ctx *Context, pager *smclient.Pager, outputFormat output.Format, newList NewListFunc, process func(types.ServiceManagerObject) error) error {
+	if err := ctx.Paging.validate(); err != nil {
+		return newValidationError(err)
+	}
+
+	list, items := newList()
+	all := reflect.ValueOf(items).Elem()
+	printed := 0
+	printer := &output.ListPagePrinter{Format: outputFormat}
+	for pager.HasNext() {
+		page, pageItems := newList()
+		if err := pager.Next(pageItems); err != nil {
+			return err
+		}
+		if process != nil {
+			if err := process(page); err != nil {
+				return err
+			}
+		}
+
+		pageSlice := reflect.ValueOf(pageItems).Elem()
+		if !ctx.Paging.Stream {
+			all.Set(reflect.AppendSlice(all, pageSlice))
+			continue
+		}
+		if err := printer.Print(ctx.Output, Printable(ctx, page)); err != nil {
+			return err
+		}
+		printed += pageSlice.Len()
+	}
+
+	if !ctx.Paging.Stream || (printed == 0 && outputFormat == output.FormatText) {
+		if err := output.PrintServiceManagerObject(ctx.Output, outputFormat, Printable(ctx, list)); err != nil {
+			return err
+		}
+		output.Println(ctx.Output)
+	}
+	if pager.Token() != "" && outputFormat == output.FormatText {
+		output.PrintMessage(ctx.Output, "More items are available. To list them, use --page-token %s\n", pager.Token())
+	}
+
+	return nil
+}
@@ -0,0 +1,351 @@
+/*
+ * Copyright 2018 The Service Manager Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/spf13/afero"
+	"github.com/spf13/pflag"
+	"github.com/xeipuuv/gojsonschema"
+	"gopkg.in/yaml.v3"
+
+	"github.com/Peripli/service-manager-cli/pkg/types"
+)
+
+// ParametersSchema is the location of a parameters schema in the schemas of a service plan
+type ParametersSchema struct {
+	Resource string
+	Action   string
+}
+
+var (
+	// InstanceCreateSchema validates the parameters of provision
+	InstanceCreateSchema = ParametersSchema{Resource: "service_instance", Action: "create"}
+	// InstanceUpdateSchema validates the parameters of update-instance
+	InstanceUpdateSchema = ParametersSchema{Resource: "service_instance", Action: "update"}
+	// BindingCreateSchema validates the parameters of bind
+	BindingCreateSchema = ParametersSchema{Resource: "service_binding", Action: "create"}
+)
+
+var (
+	identifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	// variableReference matches ${NAME} and the escaped form $${NAME}
+	variableReference = regexp.MustCompile(`\$?\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+)
+
+// Parameters are the sources of the parameters of a request and the variables substituted in them
+type Parameters struct {
+	Sources   []string
+	Variables []string
+}
+
+// AddParametersFlags adds the -c flag with the given name for the parameters sources and the --var flag
+func AddParametersFlags(flags *pflag.FlagSet, name, description string, parameters *Parameters) {
+	flags.StringArrayVarP(&parameters.Sources, name, "c", nil, description+
+		" as JSON or YAML object, @file to read them from a file or @- to read them from stdin. Repeat to merge several sources")
+	flags.StringArrayVarP(&parameters.Variables, "var", "", nil, "Value of a variable referenced as ${name} in the parameters in the form name=value. Environment variables are used for undefined variables")
+}
+
+// Load reads, substitutes the variables in and deep-merges the parameters sources in order. No sources are returned as nil.
+func (p *Parameters) Load(fs afero.Fs, stdin io.Reader) (json.RawMessage, error) {
+	if len(p.Sources) == 0 {
+		return nil, nil
+	}
+	variables, err := p.variables()
+	if err != nil {
+		return nil, err
+	}
+
+	stdinSources := 0
+	for _, source := range p.Sources {
+		if source == "@-" {
+			stdinSources++
+		}
+	}
+	if stdinSources > 1 {
+		return nil, fmt.Errorf("parameters can be read from stdin only once")
+	}
+
+	merged := map[string]interface{}{}
+	for _, source := range p.Sources {
+		var content []byte
+		name := "parameters"
+		switch {
+		case source == "@-":
+			name = "parameters from stdin"
+			if content, err = ioutil.ReadAll(stdin); err != nil {
+				return nil, fmt.Errorf("could not read the parameters from stdin: %s", err)
+			}
+		case strings.HasPrefix(source, "@"):
+			name = "parameters file " + source[1:]
+			if content, err = afero.ReadFile(fs, source[1:]); err != nil {
+				return nil, fmt.Errorf("could not read the parameters file: %s", err)
+			}
+		default:
+			content = []byte(source)
+		}
+
+		parameters, err := decodeParameters(content, name, filepath.Ext(source))
+		if err != nil {
+			return nil, err
+		}
+		substitution := &variableSubstitution{variables: variables}
+		substitution.substitute(parameters)
+		if err := substitution.err(name); err != nil {
+			return nil, err
+		}
+		deepMerge(merged, parameters)
+	}
+
+	result, err := json.Marshal(merged)
+	if err != nil {
+		return nil, fmt.Errorf("parameters cannot be converted to JSON: %s", err)
+	}
+	return result, nil
+}
+
+func (p *Parameters) variables() (map[string]string, error) {
+	variables := make(map[string]string)
+	for _, variable := range p.Variables {
+		parts := strings.SplitN(variable, "=", 2)
+		if len(parts) != 2 || !identifier.MatchString(parts[0]) {
+			return nil, fmt.Errorf("variable %s must be in the form name=value", variable)
+		}
+		variables[parts[0]] = parts[1]
+	}
+	return variables, nil
+}
+
+// variableSubstitution replaces ${name} in the string values of decoded parameters by the value of the variable
+// or of the environment variable. $${name} is kept as ${name}. Keys are not substituted.
+type variableSubstitution struct {
+	variables map[string]string
+	undefined []string
+}
+
+// substitute replaces the variable references in the strings of the value and returns the result.
+// A string which is a single reference is replaced by the typed value of the variable if it is a JSON number, boolean or null.
+func (vs *variableSubstitution) substitute(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, item := range value {
+			value[key] = vs.substitute(item)
+		}
+	case []interface{}:
+		for i, item := range value {
+			value[i] = vs.substitute(item)
+		}
+	case string:
+		if match := variableReference.FindStringSubmatch(value); match != nil && match[0] == value && !strings.HasPrefix(value, "$$") {
+			if variable, found := vs.lookup(match[1]); found {
+				return typedValue(variable)
+			}
+			return value
+		}
+		return variableReference.ReplaceAllStringFunc(value, func(reference string) string {
+			if strings.HasPrefix(reference, "$$") {
+				return reference[1:]
+			}
+			if variable, found := vs.lookup(reference[2 : len(reference)-1]); found {
+				return variable
+			}
+			return reference
+		})
+	}
+	return value
+}
+
+func (vs *variableSubstitution) lookup(name string) (string, bool) {
+	if value, found := vs.variables[name]; found {
+		return value, true
+	}
+	if value, found := os.LookupEnv(name); found {
+		return value, true
+	}
+	if !contains(vs.undefined, name) {
+		vs.undefined = append(vs.undefined, name)
+	}
+	return "", false
+}
+
+func (vs *variableSubstitution) err(name string) error {
+	if len(vs.undefined) == 0 {
+		return nil
+	}
+	sort.Strings(vs.undefined)
+	return fmt.Errorf("undefined variables in %s: %s. Use --var name=value or environment variables", name, strings.Join(vs.undefined, ", "))
+}
+
+// typedValue returns the value as JSON number, boolean or null if it is one, otherwise as string
+func typedValue(value string) interface{} {
+	if !json.Valid([]byte(value)) {
+		return value
+	}
+	decoder := json.NewDecoder(strings.NewReader(value))
+	decoder.UseNumber()
+	var typed interface{}
+	if err := decoder.Decode(&typed); err != nil {
+		return value
+	}
+	switch typed.(type) {
+	case json.Number, bool, nil:
+		return typed
+	}
+	return value
+}
+
+// decodeParameters decodes a JSON object, or a YAML object if the content is not JSON or the file has a YAML extension
+func decodeParameters(content []byte, name, extension string) (map[string]interface{}, error) {
+	var parameters map[string]interface{}
+	if extension != ".yaml" && extension != ".yml" && json.Valid(content) {
+		decoder := json.NewDecoder(bytes.NewReader(content))
+		decoder.UseNumber()
+		if err := decoder.Decode(&parameters); err != nil || parameters == nil {
+			return nil, fmt.Errorf("%s must be a JSON or YAML object", name)
+		}
+		return parameters, nil
+	}
+	if err := yaml.Unmarshal(content, &parameters); err != nil || parameters == nil {
+		return nil, fmt.Errorf("%s must be a JSON or YAML object", name)
+	}
+	return parameters, nil
+}
+
+// deepMerge merges the source into the target. Objects are merged recursively, other values are replaced.
+func deepMerge(target, source map[string]interface{}) {
+	for key, value := range source {
+		sourceObject, sourceIsObject := value.(map[string]interface{})
+		targetObject, targetIsObject := target[key].(map[string]interface{})
+		if sourceIsObject && targetIsObject {
+			deepMerge(targetObject, sourceObject)
+			continue
+		}
+		target[key] = value
+	}
+}
+
+// AddSkipValidationFlag adds the --skip-validation flag for commands validating parameters against the plan schemas
+func AddSkipValidationFlag(flags *pflag.FlagSet, skipValidation *bool) {
+	flags.BoolVarP(skipValidation, "skip-validation", "", false, "Do not validate the parameters against the JSON schema of the service plan")
+}
+
+// ParseParameters checks that parametersJSON is a JSON object. Empty parameters are returned as nil.
+func ParseParameters(parametersJSON string) (json.RawMessage, error) {
+	if len(strings.TrimSpace(parametersJSON)) == 0 {
+		return nil, nil
+	}
+	var parameters map[string]interface{}
+	if err := json.Unmarshal([]byte(parametersJSON), &parameters); err != nil || parameters == nil {
+		return nil, fmt.Errorf("parameters must be a valid JSON object: %s", parametersJSON)
+	}
+	return json.RawMessage(parametersJSON), nil
+}
+
+// ValidateParameters validates the parameters against the schema of the plan.
+// Missing parameters are validated as an empty object. Plans without such a schema accept any parameters.
+func ValidateParameters(plan *types.ServicePlan, schema ParametersSchema, parameters json.RawMessage) error {
+	planSchema, err := schema.Of(plan)
+	if err != nil {
+		return fmt.Errorf("%s. Use --skip-validation to send the parameters anyway", err)
+	}
+	if planSchema == nil {
+		return nil
+	}
+	if len(parameters) == 0 {
+		parameters = json.RawMessage("{}")
+	}
+
+	result, err := gojsonschema.Validate(gojsonschema.NewBytesLoader(planSchema), gojsonschema.NewBytesLoader(parameters))
+	if err != nil {
+		return fmt.Errorf("could not validate the parameters against the %s schema of plan %s: %s. Use --skip-validation to send them anyway", schema, plan.Name, err)
+	}
+	if result.Valid() {
+		return nil
+	}
+
+	violations := make([]string, 0, len(result.Errors()))
+	for _, violation := range result.Errors() {
+		violations = append(violations, fmt.Sprintf("  %s: %s", jsonPath(violation.Context()), violation.Description()))
+	}
+	sort.Strings(violations)
+	return NewError(ErrorKindValidation, "parameters do not match the %s schema of plan %s:\n%s\nUse --skip-validation to send them anyway",
+		schema, plan.Name, strings.Join(violations, "\n"))
+}
+
+func (s ParametersSchema) String() string {
+	return s.Resource + "." + s.Action
+}
+
+// Of returns the parameters schema in the schemas of the plan, or nil if the plan has no such schema
+func (s ParametersSchema) Of(plan *types.ServicePlan) (json.RawMessage, error) {
+	if plan == nil || len(plan.Schemas) == 0 {
+		return nil, nil
+	}
+	var schemas map[string]map[string]struct {
+		Parameters json.RawMessage `json:"parameters"`
+	}
+	if err := json.Unmarshal(plan.Schemas, &schemas); err != nil {
+		return nil, fmt.Errorf("could not read the schemas of plan %s: %s", plan.Name, err)
+	}
+	parameters := schemas[s.Resource][s.Action].Parameters
+	if len(parameters) == 0 || string(parameters) == "null" {
+		return nil, nil
+	}
+	return parameters, nil
+}
+
+// jsonPath returns the JSONPath of the validated value, e.g. $.servers[0].name
+func jsonPath(context *gojsonschema.JsonContext) string {
+	const delimiter = "\x00"
+	segments := strings.Split(context.String(delimiter), delimiter)
+
+	path := "$"
+	// the first segment is the root
+	for _, segment := range segments[1:] {
+		switch {
+		case isIndex(segment):
+			path += "[" + segment + "]"
+		case identifier.MatchString(segment):
+			path += "." + segment
+		default:
+			path += "['" + strings.ReplaceAll(segment, "'", `\'`) + "']"
+		}
+	}
+	return path
+}
+
+func isIndex(segment string) bool {
+	if segment == "" {
+		return false
+	}
+	for _, r := range segment {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,186 @@
+/*
+ * Copyright 2018 The Service Manager Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Peripli/service-manager-cli/internal/cmd"
+	"github.com/Peripli/service-manager-cli/internal/output"
+	"github.com/Peripli/service-manager-cli/pkg/query"
+	"github.com/Peripli/service-manager-cli/pkg/types"
+)
+
+// DescribePlanCmd wraps the smctl describe-plan command
+type DescribePlanCmd struct {
+	*cmd.Context
+
+	offeringName string
+	planName     string
+	template     bool
+	operation    string
+	prepare      cmd.PrepareFunc
+	outputFormat output.Format
+}
+
+// NewDescribePlanCmd returns new describe-plan command with context
+func NewDescribePlanCmd(context *cmd.Context) *DescribePlanCmd {
+	return &DescribePlanCmd{Context: context}
+}
+
+// Run runs the command's logic
+func (dp *DescribePlanCmd) Run() error {
+	if dp.template && dp.outputFormat != output.FormatText && dp.outputFormat != output.FormatYAML && dp.outputFormat != output.FormatJSON {
+		return cmd.NewError(cmd.ErrorKindValidation, "--template supports only the yaml and json output formats")
+	}
+
+	plan, err := dp.findPlan()
+	if err != nil {
+		return err
+	}
+
+	if !dp.template && dp.outputFormat != output.FormatText {
+		if err := output.PrintServiceManagerObject(dp.Output, dp.outputFormat, plan); err != nil {
+			return err
+		}
+		output.Println(dp.Output)
+		return nil
+	}
+
+	schemas, err := parseOperationSchemas(plan)
+	if err != nil {
+		return err
+	}
+	if !dp.template {
+		output.PrintMessage(dp.Output, "%s", planReference(dp.offeringName, plan, schemas, dp.operation))
+		return nil
+	}
+
+	updatable := schemas.updatable()
+	if dp.operation != "" {
+		schemas = schemas.only(dp.operation)
+	}
+	if len(schemas) == 0 {
+		return cmd.NewError(cmd.ErrorKindNotFound, "service plan %s of offering %s does not define %s", plan.Name, dp.offeringName, describeMissingSchema(dp.operation))
+	}
+	template, err := parametersTemplate(plan, schemas, updatable, dp.outputFormat == output.FormatJSON, dp.operation != "")
+	if err != nil {
+		return err
+	}
+	output.PrintMessage(dp.Output, "%s", template)
+	return nil
+}
+
+func (dp *DescribePlanCmd) findPlan() (*types.ServicePlan, error) {
+	offerings, err := dp.Client.ListOfferings(dp.Ctx, &query.Parameters{
+		FieldQuery: []string{
+			fmt.Sprintf("name eq '%s'", dp.offeringName),
+		},
+		GeneralParams: dp.Parameters.GeneralParams,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(offerings.ServiceOfferings) == 0 {
+		return nil, cmd.NewError(cmd.ErrorKindNotFound, "service offering with name %s not found", dp.offeringName)
+	}
+	if len(offerings.ServiceOfferings) > 1 {
+		return nil, fmt.Errorf("more than one service offering with name %s found", dp.offeringName)
+	}
+
+	plans, err := dp.Client.ListPlans(dp.Ctx, &query.Parameters{
+		FieldQuery: []string{
+			fmt.Sprintf("name eq '%s'", dp.planName),
+			fmt.Sprintf("service_offering_id eq '%s'", offerings.ServiceOfferings[0].ID),
+		},
+		GeneralParams: dp.Parameters.GeneralParams,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(plans.ServicePlans) == 0 {
+		return nil, cmd.NewError(cmd.ErrorKindNotFound, "service plan with name %s for offering %s not found", dp.planName, dp.offeringName)
+	}
+	if len(plans.ServicePlans) > 1 {
+		return nil, cmd.NewError(cmd.ErrorKindNotFound, "exactly one service plan with name %s for offering %s expected", dp.planName, dp.offeringName)
+	}
+	return &plans.ServicePlans[0], nil
+}
+
+func describeMissingSchema(operation string) string {
+	for _, o := range planOperations {
+		if o.name == operation {
+			return fmt.Sprintf("a %s parameters schema", o.schema)
+		}
+	}
+	return "parameters schemas"
+}
+
+// Validate validates command's arguments
+func (dp *DescribePlanCmd) Validate(args []string) error {
+	if len(args) < 2 {
+		return fmt.Errorf("offering and plan names are required")
+	}
+	dp.offeringName = args[0]
+	dp.planName = args[1]
+
+	if dp.operation != "" {
+		valid := false
+		for _, o := range planOperations {
+			valid = valid || o.name == dp.operation
+		}
+		if !valid {
+			return fmt.Errorf("unknown operation %s. Use create, update or bind", dp.operation)
+		}
+	}
+	return nil
+}
+
+// SetOutputFormat set output format
+func (dp *DescribePlanCmd) SetOutputFormat(format output.Format) {
+	dp.outputFormat = format
+}
+
+// HideUsage hide command's usage
+func (dp *DescribePlanCmd) HideUsage() bool {
+	return true
+}
+
+// Prepare returns cobra command
+func (dp *DescribePlanCmd) Prepare(prepare cmd.PrepareFunc) *cobra.Command {
+	dp.prepare = prepare
+	result := &cobra.Command{
+		Use:   "describe-plan [offering] [plan]",
+		Short: "Describes the parameters of a service plan",
+		Long: `Describes the parameters of a service plan based on its JSON schemas.
+Prints a markdown reference of the parameters for provision, update-instance and bind, including which parameters are updatable.
+With --template prints a commented YAML skeleton of the parameters, or a JSON skeleton with --output json.`,
+		PreRunE: dp.prepare(dp, dp.Context),
+		RunE:    cmd.RunE(dp),
+	}
+
+	result.Flags().BoolVarP(&dp.template, "template", "", false, "Print a skeleton of the parameters instead of the reference")
+	result.Flags().StringVarP(&dp.operation, "operation", "", "", "Describe only the parameters of one operation: create, update or bind")
+	cmd.AddFormatFlag(result.Flags())
+	cmd.AddCommonQueryFlag(result.Flags(), &dp.Parameters)
+	result.ValidArgsFunction = cmd.CompleteArgs(dp.Context, cmd.OfferingNames, cmd.PlanNamesOfOffering(0))
+	cmd.CompleteFlag(result, "operation", dp.Context, cmd.StaticCompletion("create", "update", "bind"))
+
+	return result
+}
@@ -0,0 +1,244 @@
+package plan
+
+import (
+	"bytes"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/Peripli/service-manager-cli/internal/cmd"
+	"github.com/Peripli/service-manager-cli/pkg/smclient/smclientfakes"
+	"github.com/Peripli/service-manager-cli/pkg/types"
+)
+
+var _ = Describe("Describe plan command test", func() {
+
+	var client *smclientfakes.FakeClient
+	var command *DescribePlanCmd
+	var buffer *bytes.Buffer
+	var plan types.ServicePlan
+
+	BeforeEach(func() {
+		buffer = &bytes.Buffer{}
+		client = &smclientfakes.FakeClient{}
+		plan = types.ServicePlan{ID: "plan-id", Name: "standard", Description: "Configurable database", ServiceOfferingID: "offering-id", Schemas: json.RawMessage(`{
+			"service_instance": {
+				"create": {"parameters": {
+					"type": "object",
+					"properties": {
+						"size": {"type": "integer", "description": "Number of nodes"},
+						"tier": {"type": "string", "enum": ["small", "large"], "default": "small"},
+						"network": {"type": "object", "properties": {
+							"cidr": {"type": "string", "description": "Allowed range | CIDR"}
+						}},
+						"tags": {"type": "array", "items": {"type": "string"}}
+					},
+					"required": ["size"]
+				}},
+				"update": {"parameters": {
+					"type": "object",
+					"properties": {"size": {"type": "integer", "description": "Number of nodes"}}
+				}}
+			},
+			"service_binding": {
+				"create": {"parameters": {"type": "object", "properties": {"read_only": {"type": "boolean"}}}}
+			}
+		}`)}
+		client.ListOfferingsReturns(&types.ServiceOfferings{ServiceOfferings: []types.ServiceOffering{{ID: "offering-id", Name: "postgres"}}}, nil)
+		client.ListPlansReturns(&types.ServicePlans{ServicePlans: []types.ServicePlan{plan}}, nil)
+		context := &cmd.Context{Output: buffer, Client: client}
+		command = NewDescribePlanCmd(context)
+	})
+
+	executeWithArgs := func(args ...string) error {
+		commandToRun := command.Prepare(cmd.SmPrepare)
+		commandToRun.SetArgs(args)
+
+		return commandToRun.Execute()
+	}
+
+	Context("without template flag", func() {
+		It("should print the markdown reference of the parameters", func() {
+			err := executeWithArgs("postgres", "standard")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			_, args := client.ListPlansArgsForCall(0)
+			Expect(args.FieldQuery).To(ConsistOf("name eq 'standard'", "service_offering_id eq 'offering-id'"))
+			Expect(buffer.String()).To(Equal("# Plan standard of service offering postgres\n" +
+				"\n" +
+				"Configurable database\n" +
+				"\n" +
+				"## Provision parameters\n" +
+				"\n" +
+				"Schema `service_instance.create`\n" +
+				"\n" +
+				"| Parameter | Type | Required | Default | Description |\n" +
+				"|-----------|------|----------|---------|-------------|\n" +
+				"| `size` | integer | yes |  | Number of nodes |\n" +
+				"| `tier` | string |  | `small` | Allowed values: `small`, `large` |\n" +
+				"| `network` | object |  |  |  |\n" +
+				"| `network.cidr` | string |  |  | Allowed range \\| CIDR |\n" +
+				"| `tags` | array of string |  |  |  |\n" +
+				"\n" +
+				"## Update instance parameters\n" +
+				"\n" +
+				"Schema `service_instance.update`\n" +
+				"\n" +
+				"| Parameter | Type | Required | Default | Description |\n" +
+				"|-----------|------|----------|---------|-------------|\n" +
+				"| `size` | integer |  |  | Number of nodes |\n" +
+				"\n" +
+				"## Bind parameters\n" +
+				"\n" +
+				"Schema `service_binding.create`\n" +
+				"\n" +
+				"| Parameter | Type | Required | Default | Description |\n" +
+				"|-----------|------|----------|---------|-------------|\n" +
+				"| `read_only` | boolean |  |  |  |\n" +
+				"\n" +
+				"## Updatable parameters\n" +
+				"\n" +
+				"- `size`\n"))
+		})
+
+		It("should describe only the selected operation", func() {
+			err := executeWithArgs("postgres", "standard", "--operation", "bind")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buffer.String()).To(ContainSubstring("## Bind parameters"))
+			Expect(buffer.String()).NotTo(ContainSubstring("## Provision parameters"))
+			Expect(buffer.String()).NotTo(ContainSubstring("## Updatable parameters"))
+		})
+
+		It("should tell when the plan has no update schema", func() {
+			plan.Schemas = json.RawMessage(`{"service_instance": {"create": {"parameters": {"type": "object"}}}}`)
+			client.ListPlansReturns(&types.ServicePlans{ServicePlans: []types.ServicePlan{plan}}, nil)
+
+			err := executeWithArgs("postgres", "standard")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buffer.String()).To(ContainSubstring("## Provision parameters\n\nSchema `service_instance.create`\n\nNo parameters.\n"))
+			Expect(buffer.String()).To(ContainSubstring("## Updatable parameters\n\nThe plan does not define an update schema.\n"))
+		})
+
+		It("should tell when the plan has no schemas", func() {
+			plan.Schemas = nil
+			client.ListPlansReturns(&types.ServicePlans{ServicePlans: []types.ServicePlan{plan}}, nil)
+
+			err := executeWithArgs("postgres", "standard")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buffer.String()).To(HaveSuffix("The plan does not define parameters schemas.\n"))
+		})
+
+		It("should fail when the schemas of the plan cannot be read", func() {
+			plan.Schemas = json.RawMessage(`{"service_instance": []}`)
+			client.ListPlansReturns(&types.ServicePlans{ServicePlans: []types.ServicePlan{plan}}, nil)
+
+			err := executeWithArgs("postgres", "standard")
+
+			Expect(err).To(MatchError(ContainSubstring("could not read the schemas of plan standard")))
+			Expect(err.Error()).NotTo(ContainSubstring("--skip-validation"))
+		})
+
+		It("should print the plan in json output format", func() {
+			err := executeWithArgs("postgres", "standard", "-o", "json")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buffer.String()).To(ContainSubstring(`"schemas": {`))
+		})
+	})
+
+	Context("with template flag", func() {
+		It("should print commented yaml skeletons", func() {
+			err := executeWithArgs("postgres", "standard", "--template")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buffer.String()).To(Equal("# Provision parameters of plan standard (service_instance.create)\n" +
+				"\n" +
+				"# Number of nodes\n" +
+				"# integer, required, updatable\n" +
+				"size: 0\n" +
+				"# string, one of: small, large, not updatable\n" +
+				"tier: small\n" +
+				"# object, not updatable\n" +
+				"network:\n" +
+				"  # Allowed range | CIDR\n" +
+				"  # string, not updatable\n" +
+				"  cidr: \"\"\n" +
+				"# array of string, not updatable\n" +
+				"tags: []\n" +
+				"---\n" +
+				"# Update instance parameters of plan standard (service_instance.update)\n" +
+				"\n" +
+				"# Number of nodes\n" +
+				"# integer\n" +
+				"size: 0\n" +
+				"---\n" +
+				"# Bind parameters of plan standard (service_binding.create)\n" +
+				"\n" +
+				"# boolean\n" +
+				"read_only: false\n"))
+		})
+
+		It("should print the json skeletons by operation", func() {
+			err := executeWithArgs("postgres", "standard", "--template", "-o", "json")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buffer.String()).To(MatchJSON(`{
+				"create": {"size": 0, "tier": "small", "network": {"cidr": ""}, "tags": []},
+				"update": {"size": 0},
+				"bind": {"read_only": false}
+			}`))
+		})
+
+		It("should print the json skeleton of the selected operation", func() {
+			err := executeWithArgs("postgres", "standard", "--template", "-o", "json", "--operation", "update")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buffer.String()).To(MatchJSON(`{"size": 0}`))
+		})
+
+		It("should fail when the plan does not define the schema of the operation", func() {
+			plan.Schemas = json.RawMessage(`{"service_instance": {"create": {"parameters": {"type": "object"}}}}`)
+			client.ListPlansReturns(&types.ServicePlans{ServicePlans: []types.ServicePlan{plan}}, nil)
+
+			err := executeWithArgs("postgres", "standard", "--template", "--operation", "bind")
+
+			Expect(err).Should(HaveOccurred())
+			Expect(cmd.KindOf(err)).To(Equal(cmd.ErrorKindNotFound))
+			Expect(err.Error()).To(ContainSubstring("does not define a service_binding.create parameters schema"))
+		})
+
+		It("should fail for unsupported output formats", func() {
+			err := executeWithArgs("postgres", "standard", "--template", "-o", "csv")
+
+			Expect(err).To(MatchError(ContainSubstring("--template supports only the yaml and json output formats")))
+			Expect(client.ListOfferingsCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("with invalid arguments", func() {
+		It("should require offering and plan", func() {
+			err := executeWithArgs("postgres")
+
+			Expect(err).To(MatchError(ContainSubstring("offering and plan names are required")))
+		})
+
+		It("should reject unknown operations", func() {
+			err := executeWithArgs("postgres", "standard", "--operation", "delete")
+
+			Expect(err).To(MatchError(ContainSubstring("unknown operation delete")))
+		})
+
+		It("should fail when the plan is not found", func() {
+			client.ListPlansReturns(&types.ServicePlans{}, nil)
+
+			err := executeWithArgs("postgres", "free")
+
+			Expect(err).Should(HaveOccurred())
+			Expect(cmd.KindOf(err)).To(Equal(cmd.ErrorKindNotFound))
+		})
+	})
+})
@@ -0,0 +1,148 @@
+/*
+ * Copyright 2018 The Service Manager Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Peripli/service-manager-cli/internal/cmd"
+	"github.com/Peripli/service-manager-cli/internal/output"
+	"github.com/Peripli/service-manager-cli/pkg/query"
+	"github.com/Peripli/service-manager-cli/pkg/smclient"
+)
+
+// GetPlanCmd wraps the smctl get-plan command
+type GetPlanCmd struct {
+	*cmd.Context
+
+	nameOrID     string
+	offeringName string
+	prepare      cmd.PrepareFunc
+	outputFormat output.Format
+}
+
+// NewGetPlanCmd returns new get-plan command with context
+func NewGetPlanCmd(context *cmd.Context) *GetPlanCmd {
+	return &GetPlanCmd{Context: context}
+}
+
+// Run runs the command's logic
+func (gp *GetPlanCmd) Run() error {
+	id, err := gp.resolveID()
+	if err != nil {
+		return err
+	}
+
+	plan, err := gp.Client.GetPlanByID(gp.Ctx, id, &gp.Parameters)
+	if err != nil {
+		if smclient.IsNotFound(err) {
+			return cmd.NewError(cmd.ErrorKindNotFound, "no service plan found with name or id: %s", gp.nameOrID)
+		}
+		return err
+	}
+	if err := output.PrintServiceManagerObject(gp.Output, gp.outputFormat, plan); err != nil {
+		return err
+	}
+	output.Println(gp.Output)
+
+	return nil
+}
+
+// resolveID returns the id of the plan with the provided name, or the argument itself if there is no such plan
+func (gp *GetPlanCmd) resolveID() (string, error) {
+	fieldQuery := []string{
+		fmt.Sprintf("name eq '%s'", gp.nameOrID),
+	}
+	if gp.offeringName != "" {
+		offerings, err := gp.Client.ListOfferings(gp.Ctx, &query.Parameters{
+			FieldQuery: []string{
+				fmt.Sprintf("name eq '%s'", gp.offeringName),
+			},
+			GeneralParams: gp.Parameters.GeneralParams,
+		})
+		if err != nil {
+			return "", err
+		}
+		if len(offerings.ServiceOfferings) == 0 {
+			return "", cmd.NewError(cmd.ErrorKindNotFound, "service offering with name %s not found", gp.offeringName)
+		}
+		if len(offerings.ServiceOfferings) > 1 {
+			return "", fmt.Errorf("more than one service offering with name %s found. Use the id of the plan instead", gp.offeringName)
+		}
+		fieldQuery = append(fieldQuery, fmt.Sprintf("service_offering_id eq '%s'", offerings.ServiceOfferings[0].ID))
+	}
+
+	plans, err := gp.Client.ListPlans(gp.Ctx, &query.Parameters{
+		FieldQuery:    fieldQuery,
+		GeneralParams: gp.Parameters.GeneralParams,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	switch len(plans.ServicePlans) {
+	case 0:
+		return gp.nameOrID, nil
+	case 1:
+		return plans.ServicePlans[0].ID, nil
+	default:
+		return "", fmt.Errorf("more than one service plan with name %s found. Use --offering flag to specify service offering name", gp.nameOrID)
+	}
+}
+
+// Validate validates command's arguments
+func (gp *GetPlanCmd) Validate(args []string) error {
+	if len(args) < 1 || len(args[0]) == 0 {
+		return fmt.Errorf("name or id is required")
+	}
+
+	gp.nameOrID = args[0]
+
+	return nil
+}
+
+// SetOutputFormat set output format
+func (gp *GetPlanCmd) SetOutputFormat(format output.Format) {
+	gp.outputFormat = format
+}
+
+// HideUsage hide command's usage
+func (gp *GetPlanCmd) HideUsage() bool {
+	return true
+}
+
+// Prepare returns cobra command
+func (gp *GetPlanCmd) Prepare(prepare cmd.PrepareFunc) *cobra.Command {
+	gp.prepare = prepare
+	result := &cobra.Command{
+		Use:     "get-plan [name|id]",
+		Short:   "Get single service plan",
+		Long:    `Get single service plan by its name or id`,
+		PreRunE: gp.prepare(gp, gp.Context),
+		RunE:    cmd.RunE(gp),
+	}
+
+	result.Flags().StringVarP(&gp.offeringName, "offering", "", "", "Name of the service offering of the plan")
+	cmd.AddFormatFlag(result.Flags())
+	cmd.AddCommonQueryFlag(result.Flags(), &gp.Parameters)
+	result.ValidArgsFunction = cmd.CompleteArgs(gp.Context, cmd.PlanNames)
+	cmd.CompleteFlag(result, "offering", gp.Context, cmd.OfferingNames)
+
+	return result
+}
@@ -0,0 +1,81 @@
+package plan
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/Peripli/service-manager-cli/internal/cmd"
+	"github.com/Peripli/service-manager-cli/pkg/smclient/smclientfakes"
+	"github.com/Peripli/service-manager-cli/pkg/types"
+)
+
+var _ = Describe("Get plan command test", func() {
+
+	var client *smclientfakes.FakeClient
+	var command *GetPlanCmd
+	var buffer *bytes.Buffer
+	plan1 := types.ServicePlan{ID: "plan-id-1", Name: "standard", ServiceOfferingID: "offering-id-1"}
+	plan2 := types.ServicePlan{ID: "plan-id-2", Name: "standard", ServiceOfferingID: "offering-id-2"}
+
+	BeforeEach(func() {
+		buffer = &bytes.Buffer{}
+		client = &smclientfakes.FakeClient{}
+		client.GetPlanByIDReturns(&plan1, nil)
+		context := &cmd.Context{Output: buffer, Client: client}
+		command = NewGetPlanCmd(context)
+	})
+
+	executeWithArgs := func(args ...string) error {
+		commandToRun := command.Prepare(cmd.SmPrepare)
+		commandToRun.SetArgs(args)
+
+		return commandToRun.Execute()
+	}
+
+	Context("when plan with name is found", func() {
+		It("should get it by its id and print all its fields", func() {
+			client.ListPlansReturns(&types.ServicePlans{ServicePlans: []types.ServicePlan{plan1}}, nil)
+			err := executeWithArgs("standard")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			_, id, _ := client.GetPlanByIDArgsForCall(0)
+			Expect(id).To(Equal("plan-id-1"))
+			Expect(buffer.String()).To(ContainSubstring(plan1.TableData().String()))
+		})
+	})
+
+	Context("when no plan has the name", func() {
+		It("should get the plan with this id", func() {
+			client.ListPlansReturns(&types.ServicePlans{}, nil)
+			err := executeWithArgs("plan-id-1")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			_, id, _ := client.GetPlanByIDArgsForCall(0)
+			Expect(id).To(Equal("plan-id-1"))
+		})
+	})
+
+	Context("when more than one plan has the name", func() {
+		It("should require the offering name", func() {
+			client.ListPlansReturns(&types.ServicePlans{ServicePlans: []types.ServicePlan{plan1, plan2}}, nil)
+			err := executeWithArgs("standard")
+
+			Expect(err).To(MatchError(ContainSubstring("more than one service plan with name standard found")))
+			Expect(client.GetPlanByIDCallCount()).To(Equal(0))
+		})
+
+		It("should filter the plans by offering", func() {
+			client.ListOfferingsReturns(&types.ServiceOfferings{ServiceOfferings: []types.ServiceOffering{{ID: "offering-id-2", Name: "postgres"}}}, nil)
+			client.ListPlansReturns(&types.ServicePlans{ServicePlans: []types.ServicePlan{plan2}}, nil)
+			err := executeWithArgs("standard", "--offering", "postgres")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			_, q := client.ListPlansArgsForCall(0)
+			Expect(q.FieldQuery).To(ConsistOf("name eq 'standard'", "service_offering_id eq 'offering-id-2'"))
+			_, id, _ := client.GetPlanByIDArgsForCall(0)
+			Expect(id).To(Equal("plan-id-2"))
+		})
+	})
+})
@@ -19,7 +19,11 @@ package plan
 import (
 	"github.com/Peripli/service-manager-cli/internal/cmd"
 	"github.com/Peripli/service-manager-cli/internal/output"
+	"github.com/Peripli/service-manager-cli/pkg/smclient"
+	"github.com/Peripli/service-manager-cli/pkg/types"
 	"github.com/spf13/cobra"
+
+	"github.com/Peripli/service-manager/pkg/web"
 )
 
 // ListPlansCmd wraps the smctl list-plans command
@@ -36,12 +40,21 @@ func NewListPlansCmd(context *cmd.Context) *ListPlansCmd {
 
 // Run runs the command's logic
 func (lp *ListPlansCmd) Run() error {
-	plans, err := lp.Client.ListPlans(&lp.Parameters)
+	if lp.Paging.IsSet() {
+		return cmd.PrintPages(lp.Context, smclient.NewPager(lp.Ctx, lp.Client, web.ServicePlansURL, &lp.Parameters, &lp.Paging.PageOptions), lp.outputFormat, func() (types.ServiceManagerObject, interface{}) {
+			plans := &types.ServicePlans{}
+			return plans, &plans.ServicePlans
+		}, nil)
+	}
+
+	plans, err := lp.Client.ListPlans(lp.Ctx, &lp.Parameters)
 	if err != nil {
 		return err
 	}
 
-	output.PrintServiceManagerObject(lp.Output, lp.outputFormat, plans)
+	if err := output.PrintServiceManagerObject(lp.Output, lp.outputFormat, plans); err != nil {
+		return err
+	}
 	output.Println(lp.Output)
 
 	return nil
@@ -70,6 +83,7 @@ func (lp *ListPlansCmd) Prepare(prepare cmd.PrepareFunc) *cobra.Command {
 	cmd.AddFormatFlag(result.Flags())
 	cmd.AddQueryingFlags(result.Flags(), &lp.Parameters)
 	cmd.AddCommonQueryFlag(result.Flags(), &lp.Parameters)
+	cmd.AddPagingFlags(result.Flags(), &lp.Paging)
 
 	return result
 }
@@ -109,7 +109,7 @@ var _ = Describe("List plans command test", func() {
 			err := executeWithArgs([]string{"--param", param})
 			Expect(err).ShouldNot(HaveOccurred())
 
-			args := client.ListPlansArgsForCall(0)
+			_, args := client.ListPlansArgsForCall(0)
 
 			Expect(args.GeneralParams).To(ConsistOf(param))
 			Expect(args.FieldQuery).To(BeEmpty())
@@ -123,7 +123,7 @@ var _ = Describe("List plans command test", func() {
 			client.ListPlansReturns(result, nil)
 			err := executeWithArgs([]string{"--environment", "kubernetes"})
 			Expect(err).ShouldNot(HaveOccurred())
-			args := client.ListPlansArgsForCall(0)
+			_, args := client.ListPlansArgsForCall(0)
 			Expect(args.Environment).To(Equal("kubernetes"))
 
 		})
@@ -135,7 +135,7 @@ var _ = Describe("List plans command test", func() {
 			param := "name eq 'plan1'"
 			err := executeWithArgs([]string{"--field-query", param})
 
-			args := client.ListPlansArgsForCall(0)
+			_, args := client.ListPlansArgsForCall(0)
 
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(args.FieldQuery).To(ConsistOf(param))
@@ -150,7 +150,7 @@ var _ = Describe("List plans command test", func() {
 			param := "test eq false"
 			err := executeWithArgs([]string{"--label-query", param})
 
-			args := client.ListPlansArgsForCall(0)
+			_, args := client.ListPlansArgsForCall(0)
 
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(args.LabelQuery).To(ConsistOf(param))
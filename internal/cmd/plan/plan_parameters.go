@@ -0,0 +1,337 @@
+/*
+ * Copyright 2018 The Service Manager Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package plan
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/Peripli/service-manager-cli/internal/cmd"
+	"github.com/Peripli/service-manager-cli/pkg/types"
+)
+
+// planOperation is an operation of a plan which accepts parameters
+type planOperation struct {
+	name   string
+	title  string
+	schema cmd.ParametersSchema
+}
+
+var planOperations = []planOperation{
+	{name: "create", title: "Provision", schema: cmd.InstanceCreateSchema},
+	{name: "update", title: "Update instance", schema: cmd.InstanceUpdateSchema},
+	{name: "bind", title: "Bind", schema: cmd.BindingCreateSchema},
+}
+
+// operationSchema is the parameters schema of a plan operation
+type operationSchema struct {
+	planOperation
+	parameters *cmd.Schema
+}
+
+type operationSchemas []operationSchema
+
+// parseOperationSchemas returns the schemas the plan defines, in the order of planOperations
+func parseOperationSchemas(plan *types.ServicePlan) (operationSchemas, error) {
+	var schemas operationSchemas
+	for _, operation := range planOperations {
+		schema, err := operation.schema.Of(plan)
+		if err != nil {
+			return nil, err
+		}
+		if schema == nil {
+			continue
+		}
+		parameters, err := cmd.ParseSchema(schema)
+		if err != nil {
+			return nil, fmt.Errorf("could not read the %s schema of plan %s: %s", operation.schema, plan.Name, err)
+		}
+		schemas = append(schemas, operationSchema{planOperation: operation, parameters: parameters})
+	}
+	return schemas, nil
+}
+
+func (schemas operationSchemas) only(operation string) operationSchemas {
+	var result operationSchemas
+	for _, schema := range schemas {
+		if schema.name == operation {
+			result = append(result, schema)
+		}
+	}
+	return result
+}
+
+// updatable returns the paths of the parameters in the update schema, or nil if the plan has no update schema
+func (schemas operationSchemas) updatable() []string {
+	update := schemas.only("update")
+	if len(update) == 0 {
+		return nil
+	}
+	paths := []string{}
+	visitProperties("", update[0].parameters, func(path string, _ cmd.SchemaProperty) {
+		paths = append(paths, path)
+	})
+	return paths
+}
+
+// visitProperties visits the properties of the schema and of its nested objects. Array items are visited with a [] suffix.
+func visitProperties(path string, schema *cmd.Schema, visit func(path string, property cmd.SchemaProperty)) {
+	for _, property := range schema.Properties {
+		propertyPath := joinPath(path, property.Name)
+		visit(propertyPath, property)
+		visitProperties(propertyPath, &property.Schema, visit)
+		if property.Items != nil {
+			visitProperties(propertyPath+"[]", property.Items, visit)
+		}
+	}
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// planReference returns the markdown reference of the parameters. A non-empty operation limits it to that operation.
+func planReference(offeringName string, plan *types.ServicePlan, schemas operationSchemas, operation string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Plan %s of service offering %s\n", plan.Name, offeringName)
+	if plan.Description != "" {
+		fmt.Fprintf(&b, "\n%s\n", plan.Description)
+	}
+	if len(schemas) == 0 {
+		b.WriteString("\nThe plan does not define parameters schemas.\n")
+		return b.String()
+	}
+
+	for _, schema := range schemas {
+		if operation != "" && schema.name != operation {
+			continue
+		}
+		fmt.Fprintf(&b, "\n## %s parameters\n\nSchema `%s`\n\n", schema.title, schema.schema)
+		if len(schema.parameters.Properties) == 0 {
+			b.WriteString("No parameters.\n")
+			continue
+		}
+		b.WriteString("| Parameter | Type | Required | Default | Description |\n")
+		b.WriteString("|-----------|------|----------|---------|-------------|\n")
+		visitProperties("", schema.parameters, func(path string, property cmd.SchemaProperty) {
+			required, defaultValue := "", ""
+			if property.Required {
+				required = "yes"
+			}
+			if property.Default != nil {
+				defaultValue = "`" + markdownCell(cmd.FormatSchemaValue(property.Default)) + "`"
+			}
+			fmt.Fprintf(&b, "| `%s` | %s | %s | %s | %s |\n", path, typeName(&property.Schema), required, defaultValue, markdownCell(propertyDescription(property)))
+		})
+	}
+
+	if operation == "" || operation == "update" {
+		b.WriteString("\n## Updatable parameters\n\n")
+		updatable := schemas.updatable()
+		switch {
+		case updatable == nil:
+			b.WriteString("The plan does not define an update schema.\n")
+		case len(updatable) == 0:
+			b.WriteString("None.\n")
+		default:
+			for _, path := range updatable {
+				fmt.Fprintf(&b, "- `%s`\n", path)
+			}
+		}
+	}
+	return b.String()
+}
+
+func typeName(schema *cmd.Schema) string {
+	if schema.Type == "array" && schema.Items != nil && schema.Items.Type != "" {
+		return "array of " + schema.Items.Type
+	}
+	return schema.Type
+}
+
+func propertyDescription(property cmd.SchemaProperty) string {
+	description := property.Description
+	if len(property.Enum) > 0 {
+		allowed := make([]string, len(property.Enum))
+		for i, value := range property.Enum {
+			allowed[i] = "`" + cmd.FormatSchemaValue(value) + "`"
+		}
+		description = strings.TrimSpace(description + " Allowed values: " + strings.Join(allowed, ", "))
+	}
+	return description
+}
+
+func markdownCell(text string) string {
+	text = strings.ReplaceAll(text, "|", `\|`)
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// parametersTemplate returns a skeleton of the parameters as commented YAML documents or as JSON.
+// The JSON skeletons are an object by operation name, unless a single operation is requested.
+func parametersTemplate(plan *types.ServicePlan, schemas operationSchemas, updatable []string, asJSON, single bool) (string, error) {
+	updatablePaths := make(map[string]bool)
+	for _, path := range updatable {
+		updatablePaths[path] = true
+	}
+
+	skeletons := make([]*yaml.Node, len(schemas))
+	for i, schema := range schemas {
+		// only the provision parameters can be updatable or not
+		var marked map[string]bool
+		if schema.name == "create" && updatable != nil {
+			marked = updatablePaths
+		}
+		skeleton, err := skeletonNode("", schema.parameters, marked)
+		if err != nil {
+			return "", err
+		}
+		skeletons[i] = skeleton
+	}
+
+	if asJSON {
+		var value interface{}
+		if single {
+			if err := skeletons[0].Decode(&value); err != nil {
+				return "", err
+			}
+		} else {
+			byOperation := make(map[string]interface{})
+			for i, schema := range schemas {
+				var parameters interface{}
+				if err := skeletons[i].Decode(&parameters); err != nil {
+					return "", err
+				}
+				byOperation[schema.name] = parameters
+			}
+			value = byOperation
+		}
+		b, err := json.MarshalIndent(value, "", "  ")
+		if err != nil {
+			return "", err
+		}
+		return string(b) + "\n", nil
+	}
+
+	var b bytes.Buffer
+	encoder := yaml.NewEncoder(&b)
+	encoder.SetIndent(2)
+	for i, schema := range schemas {
+		document := &yaml.Node{
+			Kind:        yaml.DocumentNode,
+			HeadComment: fmt.Sprintf("%s parameters of plan %s (%s)", schema.title, plan.Name, schema.schema),
+			Content:     []*yaml.Node{skeletons[i]},
+		}
+		if err := encoder.Encode(document); err != nil {
+			return "", err
+		}
+	}
+	if err := encoder.Close(); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// skeletonNode returns the default, the first allowed value or an empty value of the schema type.
+// Objects are filled with their properties, which are commented with their description, type and constraints.
+func skeletonNode(path string, schema *cmd.Schema, updatable map[string]bool) (*yaml.Node, error) {
+	if schema.Default != nil {
+		return encodeNode(schema.Default)
+	}
+	if len(schema.Enum) > 0 {
+		return encodeNode(schema.Enum[0])
+	}
+
+	switch {
+	case len(schema.Properties) > 0:
+		node := &yaml.Node{Kind: yaml.MappingNode}
+		for _, property := range schema.Properties {
+			propertyPath := joinPath(path, property.Name)
+			value, err := skeletonNode(propertyPath, &property.Schema, updatable)
+			if err != nil {
+				return nil, err
+			}
+			key := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: property.Name, HeadComment: propertyComment(propertyPath, property, updatable)}
+			node.Content = append(node.Content, key, value)
+		}
+		return node, nil
+	case schema.Type == "object":
+		return &yaml.Node{Kind: yaml.MappingNode, Style: yaml.FlowStyle}, nil
+	case schema.Type == "array":
+		node := &yaml.Node{Kind: yaml.SequenceNode, Style: yaml.FlowStyle}
+		if schema.Items != nil && len(schema.Items.Properties) > 0 {
+			item, err := skeletonNode(path+"[]", schema.Items, updatable)
+			if err != nil {
+				return nil, err
+			}
+			node.Style = 0
+			node.Content = []*yaml.Node{item}
+		}
+		return node, nil
+	case schema.Type == "string":
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: ""}, nil
+	case schema.Type == "integer" || schema.Type == "number":
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: "0"}, nil
+	case schema.Type == "boolean":
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: "false"}, nil
+	default:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"}, nil
+	}
+}
+
+func encodeNode(value interface{}) (*yaml.Node, error) {
+	node := &yaml.Node{}
+	if err := node.Encode(value); err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+func propertyComment(path string, property cmd.SchemaProperty, updatable map[string]bool) string {
+	var details []string
+	if name := typeName(&property.Schema); name != "" {
+		details = append(details, name)
+	}
+	if property.Required {
+		details = append(details, "required")
+	}
+	if len(property.Enum) > 0 {
+		details = append(details, "one of: "+cmd.FormatSchemaValues(property.Enum))
+	}
+	if updatable != nil {
+		if updatable[path] {
+			details = append(details, "updatable")
+		} else {
+			details = append(details, "not updatable")
+		}
+	}
+
+	var lines []string
+	if property.Description != "" {
+		lines = append(lines, strings.Split(property.Description, "\n")...)
+	}
+	if len(details) > 0 {
+		lines = append(lines, strings.Join(details, ", "))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -19,6 +19,7 @@ package platform
 import (
 	"fmt"
 	"github.com/Peripli/service-manager-cli/pkg/query"
+	"github.com/Peripli/service-manager-cli/pkg/smclient"
 	"github.com/Peripli/service-manager/pkg/web"
 	"io"
 	"strings"
@@ -66,10 +67,9 @@ func (dpc *DeletePlatformCmd) Run() error {
 
 	dpc.Parameters.FieldQuery = append(dpc.Parameters.FieldQuery, fmt.Sprintf("name eq '%s'", dpc.name))
 
-	if err := dpc.Client.DeletePlatforms(&dpc.Parameters); err != nil {
-		if strings.Contains(err.Error(), "StatusCode: 404") {
-			output.PrintMessage(dpc.Output, "Platform(s) not found.\n")
-			return nil
+	if err := dpc.Client.DeletePlatforms(dpc.Ctx, &dpc.Parameters); err != nil {
+		if smclient.IsNotFound(err) {
+			return cmd.NewError(cmd.ErrorKindNotFound, "platform with name %s not found", dpc.name)
 		}
 		output.PrintMessage(dpc.Output, "Could not delete platform(s). Reason: ")
 		return err
@@ -80,7 +80,7 @@ func (dpc *DeletePlatformCmd) Run() error {
 
 func (dpc *DeletePlatformCmd) cascadeDelete() error {
 
-	platforms, err := dpc.Client.ListPlatforms(&query.Parameters{
+	platforms, err := dpc.Client.ListPlatforms(dpc.Ctx, &query.Parameters{
 		FieldQuery: []string{
 			fmt.Sprintf("name eq '%s'", dpc.name),
 		},
@@ -89,26 +89,30 @@ func (dpc *DeletePlatformCmd) cascadeDelete() error {
 		return err
 	}
 	if len(platforms.Platforms) < 1 {
-		output.PrintMessage(dpc.Output, "Platform(s) not found.")
-		return nil
+		return cmd.NewError(cmd.ErrorKindNotFound, "platform with name %s not found", dpc.name)
 	}
 
 	dpc.Parameters.GeneralParams = append(dpc.Parameters.GeneralParams, fmt.Sprintf("%s=%s", web.QueryParamCascade, "true"))
 	dpc.Parameters.GeneralParams = append(dpc.Parameters.GeneralParams, fmt.Sprintf("%s=%s", web.QueryParamAsync, "true"))
 
+	var failedPlatformIDs []string
 	for _, platform := range platforms.Platforms {
-		location, err := dpc.Client.DeletePlatform(platform.ID, &dpc.Parameters)
+		location, err := dpc.Client.DeletePlatform(dpc.Ctx, platform.ID, &dpc.Parameters)
 		if err != nil {
 			// The platform could be deleted after List and before Delete
-			if strings.Contains(err.Error(), "StatusCode: 404") {
+			if smclient.IsNotFound(err) {
 				continue
 			}
 			output.PrintMessage(dpc.Output, "Could not cascade-delete platform %s. Reason: %s\n", platform.ID, err)
 			continue
 		}
 		if len(location) != 0 {
-			cmd.CommonHandleAsyncExecution(dpc.Context, location, fmt.Sprintf("Cascade delete successfully scheduled for platform id: %s . "+
-				"To see status of the operation use:\n", platform.ID))
+			err := cmd.CommonHandleAsyncExecution(dpc.Context, location, fmt.Sprintf("Cascade delete successfully scheduled for platform id: %s . "+
+				"To see status of the operation use:\n", platform.ID), output.FormatText)
+			if err != nil {
+				output.PrintMessage(dpc.Output, "Cascade delete of platform %s did not succeed. Reason: %s\n", platform.ID, err)
+				failedPlatformIDs = append(failedPlatformIDs, platform.ID)
+			}
 			continue
 		}
 
@@ -116,6 +120,9 @@ func (dpc *DeletePlatformCmd) cascadeDelete() error {
 		// SM must return location>0, because it's async flow
 		output.PrintMessage(dpc.Output, "Error: Unable to get operation ID for platform %s.\n", platform.ID)
 	}
+	if len(failedPlatformIDs) > 0 {
+		return fmt.Errorf("cascade delete failed for platform(s): %s", strings.Join(failedPlatformIDs, ", "))
+	}
 	return nil
 }
 
@@ -151,6 +158,8 @@ func (dpc *DeletePlatformCmd) Prepare(prepare cmd.PrepareFunc) *cobra.Command {
 	dpc.cascadeFlag = result.PersistentFlags().Bool("cascade", false, "Cascade delete platform with all the associated resources")
 	result.Flags().BoolVarP(&dpc.force, "force", "f", false, "Force delete without confirmation")
 	cmd.AddCommonQueryFlag(result.Flags(), &dpc.Parameters)
+	cmd.AddWaitFlags(result.Flags(), &dpc.Wait)
+	result.ValidArgsFunction = cmd.CompleteArgs(dpc.Context, cmd.PlatformNames)
 
 	return result
 }
@@ -1,7 +1,7 @@
 package platform
 
 import (
-	"github.com/Peripli/service-manager/pkg/util"
+	"github.com/Peripli/service-manager-cli/pkg/smclient"
 	"io/ioutil"
 	"net/http"
 
@@ -80,7 +80,7 @@ var _ = Describe("Delete platforms command test", func() {
 				err := executeWithArgs([]string{"platform-name", "--param", param})
 				Expect(err).ShouldNot(HaveOccurred())
 
-				args := client.DeletePlatformsArgsForCall(0)
+				_, args := client.DeletePlatformsArgsForCall(0)
 
 				Expect(args.GeneralParams).To(ConsistOf(param))
 				Expect(args.FieldQuery).To(ConsistOf("name eq 'platform-name'"))
@@ -91,19 +91,19 @@ var _ = Describe("Delete platforms command test", func() {
 		When("non-existing platform is being deleted", func() {
 			It("should return message", func() {
 				body := ioutil.NopCloser(bytes.NewReader([]byte("")))
-				expectedError := util.HandleResponseError(&http.Response{Body: body, StatusCode: http.StatusNotFound})
+				expectedError := smclient.NewAPIError(&http.Response{Body: body, StatusCode: http.StatusNotFound})
 				client.DeletePlatformsReturns(expectedError)
 				err := executeWithArgs([]string{"non-existing-name", "-f"})
 
-				Expect(err).ShouldNot(HaveOccurred())
-				Expect(buffer.String()).To(ContainSubstring("Platform(s) not found."))
+				Expect(err).To(MatchError("platform with name non-existing-name not found"))
+				Expect(cmd.KindOf(err)).To(Equal(cmd.ErrorKindNotFound))
 			})
 		})
 
 		When("SM returns error", func() {
 			It("should return error message", func() {
 				body := ioutil.NopCloser(bytes.NewReader([]byte("")))
-				expectedError := util.HandleResponseError(&http.Response{Body: body, StatusCode: http.StatusInternalServerError})
+				expectedError := smclient.NewAPIError(&http.Response{Body: body, StatusCode: http.StatusInternalServerError})
 				client.DeletePlatformsReturns(expectedError)
 				err := executeWithArgs([]string{"name", "-f"})
 
@@ -143,11 +143,22 @@ var _ = Describe("Delete platforms command test", func() {
 				Expect(buffer.String()).To(ContainSubstring("Cascade delete successfully scheduled"))
 			})
 		})
+		When("wait flag is used", func() {
+			It("should return error when cascade delete operation fails", func() {
+				client.ListPlatformsReturns(&types.Platforms{Platforms: []types.Platform{platform1}}, nil)
+				client.DeletePlatformReturns("/v1/platforms/id1/operations/1a3e795d-819c-4661-89b5-344adb2ec26a", nil)
+				client.StatusReturns(&types.Operation{ID: "op-id", Type: "delete", State: "failed"}, nil)
+				err := executeWithArgs([]string{platform1.Name, "--cascade", "-f", "--wait"})
+
+				Expect(err).To(MatchError("cascade delete failed for platform(s): id1"))
+				Expect(buffer.String()).To(ContainSubstring("Cascade delete of platform id1 did not succeed"))
+			})
+		})
 		When("platform is active", func() {
 			It("should return error message", func() {
 				client.ListPlatformsReturns(&types.Platforms{Platforms: []types.Platform{platform1}}, nil)
 				body := ioutil.NopCloser(bytes.NewReader([]byte("Active platform cannot be deleted")))
-				expectedError := util.HandleResponseError(&http.Response{Body: body, StatusCode: http.StatusUnprocessableEntity})
+				expectedError := smclient.NewAPIError(&http.Response{Body: body, StatusCode: http.StatusUnprocessableEntity})
 				client.DeletePlatformReturns("", expectedError)
 				err := executeWithArgs([]string{platform1.Name, "--cascade", "-f"})
 
@@ -162,8 +173,8 @@ var _ = Describe("Delete platforms command test", func() {
 				client.ListPlatformsReturns(&types.Platforms{}, nil)
 				err := executeWithArgs([]string{"non-existing-name", "--cascade", "-f"})
 
-				Expect(err).ShouldNot(HaveOccurred())
-				Expect(buffer.String()).To(ContainSubstring("Platform(s) not found."))
+				Expect(err).To(MatchError("platform with name non-existing-name not found"))
+				Expect(cmd.KindOf(err)).To(Equal(cmd.ErrorKindNotFound))
 			})
 		})
 
@@ -171,7 +182,7 @@ var _ = Describe("Delete platforms command test", func() {
 			It("should print could not cascade delete platform", func() {
 				client.ListPlatformsReturns(&types.Platforms{Platforms: []types.Platform{platform1}}, nil)
 				body := ioutil.NopCloser(bytes.NewReader([]byte("")))
-				expectedError := util.HandleResponseError(&http.Response{Body: body, StatusCode: http.StatusInternalServerError})
+				expectedError := smclient.NewAPIError(&http.Response{Body: body, StatusCode: http.StatusInternalServerError})
 				client.DeletePlatformReturns("", expectedError)
 				promptBuffer.WriteString("y")
 				_ = executeWithArgs([]string{platform1.Name, "--cascade"})
@@ -0,0 +1,129 @@
+/*
+ * Copyright 2018 The Service Manager Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package platform
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Peripli/service-manager-cli/internal/cmd"
+	"github.com/Peripli/service-manager-cli/internal/output"
+	"github.com/Peripli/service-manager-cli/pkg/query"
+	"github.com/Peripli/service-manager-cli/pkg/smclient"
+	"github.com/Peripli/service-manager-cli/pkg/types"
+)
+
+// GetPlatformCmd wraps the smctl get-platform command
+type GetPlatformCmd struct {
+	*cmd.Context
+
+	nameOrID     string
+	prepare      cmd.PrepareFunc
+	outputFormat output.Format
+}
+
+// NewGetPlatformCmd returns new get-platform command with context
+func NewGetPlatformCmd(context *cmd.Context) *GetPlatformCmd {
+	return &GetPlatformCmd{Context: context}
+}
+
+// Run runs the command's logic
+func (gp *GetPlatformCmd) Run() error {
+	if gp.Watch.IsSet() {
+		return cmd.WatchObject(gp.Context, gp.outputFormat, gp.getPlatform)
+	}
+
+	platform, err := gp.getPlatform()
+	if err != nil {
+		return err
+	}
+	if err := output.PrintServiceManagerObject(gp.Output, gp.outputFormat, platform); err != nil {
+		return err
+	}
+	output.Println(gp.Output)
+
+	return nil
+}
+
+func (gp *GetPlatformCmd) getPlatform() (types.ServiceManagerObject, error) {
+	platforms, err := gp.Client.ListPlatforms(gp.Ctx, &query.Parameters{
+		FieldQuery: []string{
+			fmt.Sprintf("name eq '%s'", gp.nameOrID),
+		},
+		GeneralParams: gp.Parameters.GeneralParams,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// platform names are unique, if there is no platform with this name the argument is treated as id
+	id := gp.nameOrID
+	if len(platforms.Platforms) > 0 {
+		id = platforms.Platforms[0].ID
+	}
+	platform, err := gp.Client.GetPlatformByID(gp.Ctx, id, &gp.Parameters)
+	if err != nil {
+		if smclient.IsNotFound(err) {
+			return nil, cmd.NewError(cmd.ErrorKindNotFound, "no platform found with name or id: %s", gp.nameOrID)
+		}
+		return nil, err
+	}
+	platform.Vertical = true
+	return platform, nil
+}
+
+// Validate validates command's arguments
+func (gp *GetPlatformCmd) Validate(args []string) error {
+	if len(args) < 1 || len(args[0]) == 0 {
+		return fmt.Errorf("name or id is required")
+	}
+
+	gp.nameOrID = args[0]
+
+	return nil
+}
+
+// SetOutputFormat set output format
+func (gp *GetPlatformCmd) SetOutputFormat(format output.Format) {
+	gp.outputFormat = format
+}
+
+// HideUsage hide command's usage
+func (gp *GetPlatformCmd) HideUsage() bool {
+	return true
+}
+
+// Prepare returns cobra command
+func (gp *GetPlatformCmd) Prepare(prepare cmd.PrepareFunc) *cobra.Command {
+	gp.prepare = prepare
+	result := &cobra.Command{
+		Use:     "get-platform [name|id]",
+		Aliases: []string{"gp"},
+		Short:   "Get single platform",
+		Long:    `Get single platform by its name or id`,
+		PreRunE: gp.prepare(gp, gp.Context),
+		RunE:    cmd.RunE(gp),
+	}
+
+	cmd.AddFormatFlag(result.Flags())
+	cmd.AddCommonQueryFlag(result.Flags(), &gp.Parameters)
+	cmd.AddWatchFlag(result.Flags(), &gp.Watch)
+	result.ValidArgsFunction = cmd.CompleteArgs(gp.Context, cmd.PlatformNames)
+
+	return result
+}
@@ -0,0 +1,121 @@
+package platform
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/Peripli/service-manager-cli/internal/cmd"
+	"github.com/Peripli/service-manager-cli/pkg/smclient"
+	"github.com/Peripli/service-manager-cli/pkg/smclient/smclientfakes"
+	"github.com/Peripli/service-manager-cli/pkg/types"
+	smtypes "github.com/Peripli/service-manager/pkg/types"
+)
+
+var _ = Describe("Get platform command test", func() {
+
+	var client *smclientfakes.FakeClient
+	var command *GetPlatformCmd
+	var buffer *bytes.Buffer
+	platform := types.Platform{
+		ID:    "id1",
+		Name:  "platform1",
+		Type:  "cloudfoundry",
+		Ready: true,
+	}
+
+	BeforeEach(func() {
+		buffer = &bytes.Buffer{}
+		client = &smclientfakes.FakeClient{}
+		result := platform
+		client.GetPlatformByIDReturns(&result, nil)
+		context := &cmd.Context{Output: buffer, Client: client}
+		command = NewGetPlatformCmd(context)
+	})
+
+	executeWithArgs := func(args ...string) error {
+		commandToRun := command.Prepare(cmd.SmPrepare)
+		commandToRun.SetArgs(args)
+
+		return commandToRun.Execute()
+	}
+
+	Context("when no name or id is provided", func() {
+		It("should return error", func() {
+			err := executeWithArgs("")
+
+			Expect(err).To(MatchError("name or id is required"))
+		})
+	})
+
+	Context("when platform with name is found", func() {
+		It("should get it by its id and print all its fields", func() {
+			client.ListPlatformsReturns(&types.Platforms{Platforms: []types.Platform{platform}}, nil)
+			err := executeWithArgs("platform1")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			_, id, _ := client.GetPlatformByIDArgsForCall(0)
+			Expect(id).To(Equal("id1"))
+			vertical := platform
+			vertical.Vertical = true
+			Expect(buffer.String()).To(ContainSubstring(vertical.TableData().String()))
+			Expect(buffer.String()).ToNot(ContainSubstring(platform.TableData().String()))
+		})
+	})
+
+	Context("when no platform has the name", func() {
+		It("should get the platform with this id", func() {
+			client.ListPlatformsReturns(&types.Platforms{}, nil)
+			err := executeWithArgs("id1")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			_, id, _ := client.GetPlatformByIDArgsForCall(0)
+			Expect(id).To(Equal("id1"))
+			Expect(buffer.String()).To(ContainSubstring("platform1"))
+		})
+
+		It("should fail when there is no platform with this id", func() {
+			client.ListPlatformsReturns(&types.Platforms{}, nil)
+			body := ioutil.NopCloser(bytes.NewReader([]byte("")))
+			client.GetPlatformByIDReturns(nil, smclient.NewAPIError(&http.Response{Body: body, StatusCode: http.StatusNotFound}))
+			err := executeWithArgs("unknown")
+
+			Expect(err).To(MatchError("no platform found with name or id: unknown"))
+			Expect(cmd.KindOf(err)).To(Equal(cmd.ErrorKindNotFound))
+		})
+	})
+
+	Context("when --watch is used", func() {
+		It("should print events until the operation of the platform is done", func() {
+			deleting := platform
+			deleting.LastOperation = &smtypes.Operation{Type: smtypes.DELETE, State: smtypes.IN_PROGRESS}
+			failed := platform
+			failed.LastOperation = &smtypes.Operation{Type: smtypes.DELETE, State: smtypes.FAILED}
+			client.ListPlatformsReturns(&types.Platforms{Platforms: []types.Platform{platform}}, nil)
+			client.GetPlatformByIDReturnsOnCall(0, &deleting, nil)
+			client.GetPlatformByIDReturnsOnCall(1, &failed, nil)
+
+			Expect(executeWithArgs("platform1", "--watch=1ms")).To(Succeed())
+
+			Expect(client.GetPlatformByIDCallCount()).To(Equal(2))
+			lines := strings.Split(strings.TrimSpace(buffer.String()), "\n")
+			Expect(lines).To(HaveLen(2))
+			Expect(lines[0]).To(HavePrefix(`{"type":"ADDED","object":{"id":"id1"`))
+			Expect(lines[1]).To(HavePrefix(`{"type":"MODIFIED","object":{"id":"id1"`))
+		})
+
+		It("should fail if the platform does not exist", func() {
+			client.ListPlatformsReturns(&types.Platforms{}, nil)
+			body := ioutil.NopCloser(bytes.NewReader([]byte("")))
+			client.GetPlatformByIDReturns(nil, smclient.NewAPIError(&http.Response{Body: body, StatusCode: http.StatusNotFound}))
+
+			err := executeWithArgs("platform1", "--watch")
+
+			Expect(err).To(MatchError("no platform found with name or id: platform1"))
+		})
+	})
+})
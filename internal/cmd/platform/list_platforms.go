@@ -18,8 +18,12 @@ package platform
 
 import (
 	"github.com/Peripli/service-manager-cli/internal/output"
+	"github.com/Peripli/service-manager-cli/pkg/smclient"
+	"github.com/Peripli/service-manager-cli/pkg/types"
 	"github.com/spf13/cobra"
 
+	"github.com/Peripli/service-manager/pkg/web"
+
 	"github.com/Peripli/service-manager-cli/internal/cmd"
 )
 
@@ -37,12 +41,26 @@ func NewListPlatformsCmd(context *cmd.Context) *ListPlatformsCmd {
 
 // Run runs the command's logic
 func (lp *ListPlatformsCmd) Run() error {
-	platforms, err := lp.Client.ListPlatforms(&lp.Parameters)
+	if lp.Watch.IsSet() {
+		return cmd.WatchList(lp.Context, lp.outputFormat, func() (types.ServiceManagerObject, error) {
+			return lp.Client.ListPlatforms(lp.Ctx, &lp.Parameters)
+		})
+	}
+	if lp.Paging.IsSet() {
+		return cmd.PrintPages(lp.Context, smclient.NewPager(lp.Ctx, lp.Client, web.PlatformsURL, &lp.Parameters, &lp.Paging.PageOptions), lp.outputFormat, func() (types.ServiceManagerObject, interface{}) {
+			platforms := &types.Platforms{}
+			return platforms, &platforms.Platforms
+		}, nil)
+	}
+
+	platforms, err := lp.Client.ListPlatforms(lp.Ctx, &lp.Parameters)
 	if err != nil {
 		return err
 	}
 
-	output.PrintServiceManagerObject(lp.Output, lp.outputFormat, platforms)
+	if err := output.PrintServiceManagerObject(lp.Output, lp.outputFormat, platforms); err != nil {
+		return err
+	}
 	output.Println(lp.Output)
 
 	return nil
@@ -72,6 +90,8 @@ func (lp *ListPlatformsCmd) Prepare(prepare cmd.PrepareFunc) *cobra.Command {
 	cmd.AddFormatFlag(result.Flags())
 	cmd.AddQueryingFlags(result.Flags(), &lp.Parameters)
 	cmd.AddCommonQueryFlag(result.Flags(), &lp.Parameters)
+	cmd.AddPagingFlags(result.Flags(), &lp.Paging)
+	cmd.AddListWatchFlag(result.Flags(), &lp.Watch)
 
 	return result
 }
@@ -65,6 +65,7 @@ func (rpc *RegisterPlatformCmd) Prepare(prepare cmd.PrepareFunc) *cobra.Command
 	result.Flags().StringVarP(&rpc.platform.ID, "id", "i", "", "external platform ID")
 	cmd.AddFormatFlag(result.Flags())
 	cmd.AddCommonQueryFlag(result.Flags(), &rpc.Parameters)
+	cmd.AddShowCredentialsFlag(result.Flags(), &rpc.ShowCredentials)
 
 	return result
 }
@@ -87,11 +88,13 @@ func (rpc *RegisterPlatformCmd) Validate(args []string) error {
 
 // Run runs command's logic
 func (rpc *RegisterPlatformCmd) Run() error {
-	resultPlatform, err := rpc.Client.RegisterPlatform(&rpc.platform, &rpc.Parameters)
+	resultPlatform, err := rpc.Client.RegisterPlatform(rpc.Ctx, &rpc.platform, &rpc.Parameters)
 	if err != nil {
 		return err
 	}
-	output.PrintServiceManagerObject(rpc.Output, rpc.outputFormat, resultPlatform)
+	if err := output.PrintServiceManagerObject(rpc.Output, rpc.outputFormat, cmd.Printable(rpc.Context, resultPlatform)); err != nil {
+		return err
+	}
 	output.Println(rpc.Output)
 	return nil
 }
@@ -58,16 +58,17 @@ var _ = Describe("Register Platform Command test", func() {
 		Context("With necessary arguments provided", func() {
 			It("Platform should be registered", func() {
 				err := validRegisterPlatformExecution("platform", "cf")
-				tableOutputExpected := platform.TableData().String()
+				tableOutputExpected := platform.WithRedactedCredentials().(*types.Platform).TableData().String()
 
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(buffer.String()).To(ContainSubstring(tableOutputExpected))
+				Expect(buffer.String()).To(ContainSubstring(types.RedactedValue))
 			})
 
 			It("Argument values should be as expected", func() {
 				err := validRegisterPlatformExecution("validName", "validType")
 
-				p, _ := client.RegisterPlatformArgsForCall(0)
+				_, p, _ := client.RegisterPlatformArgsForCall(0)
 
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(p.Name).To(Equal("validName"))
@@ -80,7 +81,7 @@ var _ = Describe("Register Platform Command test", func() {
 				args := []string{"validName", "validType", "--id", "1234"}
 
 				err := validRegisterPlatformExecution(args...)
-				platform, _ := client.RegisterPlatformArgsForCall(0)
+				_, platform, _ := client.RegisterPlatformArgsForCall(0)
 
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(platform.ID).To(Equal("1234"))
@@ -100,7 +101,7 @@ var _ = Describe("Register Platform Command test", func() {
 			It("should be printed in json format", func() {
 				err := validRegisterPlatformExecution("platform", "cf", "--output", "json")
 
-				jsonByte, _ := json.MarshalIndent(platform, "", "  ")
+				jsonByte, _ := json.MarshalIndent(platform.WithRedactedCredentials(), "", "  ")
 				jsonOutputExpected := string(jsonByte) + "\n"
 
 				Expect(err).ShouldNot(HaveOccurred())
@@ -112,7 +113,7 @@ var _ = Describe("Register Platform Command test", func() {
 			It("should be printed in yaml format", func() {
 				err := validRegisterPlatformExecution("platform", "cf", "--output", "yaml")
 
-				yamlByte, _ := yaml.Marshal(platform)
+				yamlByte, _ := yaml.Marshal(platform.WithRedactedCredentials())
 				yamlOutputExpected := string(yamlByte) + "\n"
 
 				Expect(err).ShouldNot(HaveOccurred())
@@ -120,12 +121,25 @@ var _ = Describe("Register Platform Command test", func() {
 			})
 		})
 
+		Context("With show credentials flag", func() {
+			It("should print the credentials", func() {
+				err := validRegisterPlatformExecution("platform", "cf", "--show-credentials", "--output", "json")
+
+				jsonByte, _ := json.MarshalIndent(platform, "", "  ")
+				jsonOutputExpected := string(jsonByte) + "\n"
+
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(buffer.String()).To(Equal(jsonOutputExpected))
+				Expect(buffer.String()).NotTo(ContainSubstring(types.RedactedValue))
+			})
+		})
+
 		Context("With generic param flag", func() {
 			It("should pass it to SM", func() {
 				err := validRegisterPlatformExecution("platform", "cf", "--param", "paramKey=paramValue")
 				Expect(err).ShouldNot(HaveOccurred())
 
-				_, args := client.RegisterPlatformArgsForCall(0)
+				_, _, args := client.RegisterPlatformArgsForCall(0)
 
 				Expect(args.GeneralParams).To(ConsistOf("paramKey=paramValue"))
 				Expect(args.FieldQuery).To(BeEmpty())
@@ -66,23 +66,25 @@ func (upc *UpdatePlatformCmd) Validate(args []string) error {
 // Run runs the command's logic
 func (upc *UpdatePlatformCmd) Run() error {
 	upc.Parameters.FieldQuery = append(upc.Parameters.FieldQuery, fmt.Sprintf("name eq '%s'", upc.name))
-	toUpdatePlatforms, err := upc.Client.ListPlatforms(&upc.Parameters)
+	toUpdatePlatforms, err := upc.Client.ListPlatforms(upc.Ctx, &upc.Parameters)
 	if err != nil {
 		return err
 	}
 	if len(toUpdatePlatforms.Platforms) < 1 {
-		return fmt.Errorf("platform with name %s not found", upc.name)
+		return cmd.NewError(cmd.ErrorKindNotFound, "platform with name %s not found", upc.name)
 	}
 	toUpdatePlatform := toUpdatePlatforms.Platforms[0]
 	if upc.regenerateCredentials {
 		upc.Parameters.GeneralParams = append(upc.Parameters.GeneralParams, "regenerateCredentials=true")
 	}
-	result, err := upc.Client.UpdatePlatform(toUpdatePlatform.ID, upc.updatedPlatform, &upc.Parameters)
+	result, err := upc.Client.UpdatePlatform(upc.Ctx, toUpdatePlatform.ID, upc.updatedPlatform, &upc.Parameters)
 	if err != nil {
 		return err
 	}
 
-	output.PrintServiceManagerObject(upc.Output, upc.outputFormat, result)
+	if err := output.PrintServiceManagerObject(upc.Output, upc.outputFormat, cmd.Printable(upc.Context, result)); err != nil {
+		return err
+	}
 	output.Println(upc.Output)
 
 	return nil
@@ -110,6 +112,8 @@ smctl update-platform platform '{"name": "new-name", "description": "new-descrip
 
 	cmd.AddFormatFlag(result.Flags())
 	cmd.AddCommonQueryFlag(result.Flags(), &upc.Parameters)
+	cmd.AddShowCredentialsFlag(result.Flags(), &upc.ShowCredentials)
+	result.ValidArgsFunction = cmd.CompleteArgs(upc.Context, cmd.PlatformNames)
 
 	return result
 }
@@ -62,7 +62,7 @@ var _ = Describe("Update platform command test", func() {
 			It("argument values should be as expected", func() {
 				validUpdatePlatformExecution("platform", `{"type":"newType"}`)
 
-				id, platform, _ := client.UpdatePlatformArgsForCall(0)
+				_, id, platform, _ := client.UpdatePlatformArgsForCall(0)
 
 				Expect(id).To(Equal("id"))
 				Expect(platform).To(Equal(&types.Platform{Type: "newType"}))
@@ -95,7 +95,7 @@ var _ = Describe("Update platform command test", func() {
 			It("should pass it to SM", func() {
 				validUpdatePlatformExecution("platform", `{"type":"newType"}`, "--param", "paramKey=paramValue")
 
-				_, _, args := client.UpdatePlatformArgsForCall(0)
+				_, _, _, args := client.UpdatePlatformArgsForCall(0)
 
 				Expect(args.GeneralParams).To(ConsistOf("paramKey=paramValue"))
 				Expect(args.FieldQuery).To(ConsistOf("name eq 'platform'"))
@@ -107,9 +107,38 @@ var _ = Describe("Update platform command test", func() {
 			It("platform should pass it to SM", func() {
 				validUpdatePlatformExecution("platform", "--regenerate-credentials")
 
-				_, _, args := client.UpdatePlatformArgsForCall(0)
+				_, _, _, args := client.UpdatePlatformArgsForCall(0)
 				Expect(args.GeneralParams).To(ConsistOf("regenerateCredentials=true"))
 			})
+
+			Context("when the new credentials are returned", func() {
+				BeforeEach(func() {
+					platform = types.Platform{
+						Name:        "platform",
+						ID:          "id",
+						Credentials: &types.Credentials{Basic: types.Basic{User: "new-user", Password: "new-password"}},
+					}
+					client.ListPlatformsReturns(&types.Platforms{Platforms: []types.Platform{platform}}, nil)
+					client.UpdatePlatformReturns(&platform, nil)
+				})
+
+				It("should mask them", func() {
+					err := invalidUpdatePlatformExecution("platform", "--regenerate-credentials", "--output", "json")
+
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(buffer.String()).To(ContainSubstring(`"username": "[REDACTED]"`))
+					Expect(buffer.String()).To(ContainSubstring(`"password": "[REDACTED]"`))
+					Expect(buffer.String()).NotTo(ContainSubstring("new-password"))
+				})
+
+				It("should print them with show credentials flag", func() {
+					err := invalidUpdatePlatformExecution("platform", "--regenerate-credentials", "--show-credentials")
+
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(buffer.String()).To(ContainSubstring(platform.TableData().String()))
+					Expect(buffer.String()).To(ContainSubstring("new-password"))
+				})
+			})
 		})
 	})
 
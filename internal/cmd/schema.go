@@ -0,0 +1,141 @@
+/*
+ * Copyright 2018 The Service Manager Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Schema is the part of a JSON schema of parameters used to prompt for, template and document them
+type Schema struct {
+	Type        string
+	Description string
+	Default     interface{}
+	Enum        []interface{}
+	// Properties are in the order of the schema
+	Properties []SchemaProperty
+	Items      *Schema
+}
+
+// SchemaProperty is a property of an object schema
+type SchemaProperty struct {
+	Schema
+	Name     string
+	Required bool
+}
+
+// ParseSchema parses a JSON schema of parameters
+func ParseSchema(schema json.RawMessage) (*Schema, error) {
+	result := &Schema{}
+	if err := json.Unmarshal(schema, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// UnmarshalJSON decodes the schema keeping the order of its properties
+func (s *Schema) UnmarshalJSON(data []byte) error {
+	var definition struct {
+		Type        json.RawMessage `json:"type"`
+		Description string          `json:"description"`
+		Default     interface{}     `json:"default"`
+		Enum        []interface{}   `json:"enum"`
+		Properties  json.RawMessage `json:"properties"`
+		Required    []string        `json:"required"`
+		Items       json.RawMessage `json:"items"`
+	}
+	if err := json.Unmarshal(data, &definition); err != nil {
+		return err
+	}
+
+	s.Type = schemaType(definition.Type)
+	s.Description = definition.Description
+	s.Default = definition.Default
+	s.Enum = definition.Enum
+
+	// tuple validation with an array of items schemas is not described
+	if bytes.HasPrefix(bytes.TrimSpace(definition.Items), []byte("{")) {
+		s.Items = &Schema{}
+		if err := json.Unmarshal(definition.Items, s.Items); err != nil {
+			return err
+		}
+	}
+
+	properties := bytes.TrimSpace(definition.Properties)
+	if len(properties) == 0 || string(properties) == "null" {
+		return nil
+	}
+	required := make(map[string]bool)
+	for _, name := range definition.Required {
+		required[name] = true
+	}
+	// decoding token by token keeps the order of the properties
+	decoder := json.NewDecoder(bytes.NewReader(properties))
+	if token, err := decoder.Token(); err != nil || token != json.Delim('{') {
+		return fmt.Errorf("properties must be an object")
+	}
+	for decoder.More() {
+		name, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+		property := SchemaProperty{Name: name.(string), Required: required[name.(string)]}
+		if err := decoder.Decode(&property.Schema); err != nil {
+			return err
+		}
+		s.Properties = append(s.Properties, property)
+	}
+	return nil
+}
+
+// schemaType returns the type of a schema, or the first non-null type of a schema with several types
+func schemaType(definition json.RawMessage) string {
+	var single string
+	if err := json.Unmarshal(definition, &single); err == nil {
+		return single
+	}
+	var several []string
+	if err := json.Unmarshal(definition, &several); err == nil {
+		for _, t := range several {
+			if t != "null" {
+				return t
+			}
+		}
+	}
+	return ""
+}
+
+// FormatSchemaValue formats a default or allowed value of a schema. Strings are returned as is, other values as JSON.
+func FormatSchemaValue(value interface{}) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+	b, _ := json.Marshal(value)
+	return string(b)
+}
+
+// FormatSchemaValues formats the allowed values of a schema as comma separated list
+func FormatSchemaValues(values []interface{}) string {
+	formatted := make([]string, len(values))
+	for i, value := range values {
+		formatted[i] = FormatSchemaValue(value)
+	}
+	return strings.Join(formatted, ", ")
+}
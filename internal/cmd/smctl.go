@@ -17,20 +17,54 @@
 package cmd
 
 import (
+	"context"
+	"errors"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
 	"github.com/Peripli/service-manager-cli/internal/configuration"
+	"github.com/Peripli/service-manager-cli/internal/output"
+	"github.com/Peripli/service-manager-cli/pkg/smclient"
 	"github.com/Peripli/service-manager/pkg/log"
 )
 
-// Execute executes the root command
+// NewInterruptibleContext returns a context which is cancelled on the first SIGINT or SIGTERM.
+// A second signal terminates the process immediately.
+func NewInterruptibleContext() context.Context {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-ctx.Done()
+		stop()
+	}()
+	return ctx
+}
+
+// Execute executes the root command.
+// Errors are printed to stderr and smctl exits with the exit code of their kind.
 func Execute(cmd *cobra.Command) {
-	if err := cmd.Execute(); err != nil {
-		os.Exit(1)
+	executed, err := cmd.ExecuteC()
+	if err == nil {
+		return
+	}
+	var exitStatus *ExitStatusError
+	if errors.As(err, &exitStatus) {
+		os.Exit(exitStatus.Code)
+	}
+
+	// flags are parsed only after the command line is resolved to a command
+	unresolved := !executed.Flags().Parsed()
+	if unresolved {
+		err = newValidationError(err)
 	}
+	exitCode := PrintCommandError(executed.ErrOrStderr(), executed, err)
+	if unresolved {
+		output.PrintMessage(executed.ErrOrStderr(), "Run '%v --help' for usage.\n", executed.CommandPath())
+	}
+	os.Exit(exitCode)
 }
 
 // BuildRootCommand builds a new SM root command with context
@@ -53,12 +87,12 @@ func BuildRootCommand(ctx *Context) *cobra.Command {
 			if ctx.Output == nil {
 				ctx.Output = cmd.OutOrStdout()
 			}
-			if ctx.Configuration == nil {
-				configuration, err := configuration.NewSMConfiguration(viperEnv, cfgFile)
-				if err != nil {
-					return err
-				}
-				ctx.Configuration = configuration
+			// completion requests parse the flags of the completed command only later, the configuration is loaded on demand then
+			if cmd.Name() == cobra.ShellCompRequestCmd || cmd.Name() == cobra.ShellCompNoDescRequestCmd {
+				return nil
+			}
+			if err := ctx.ensureConfiguration(); err != nil {
+				return err
 			}
 
 			cmd.SilenceUsage = false
@@ -66,8 +100,21 @@ func BuildRootCommand(ctx *Context) *cobra.Command {
 		},
 	}
 
+	ctx.newConfiguration = func() (configuration.Configuration, error) {
+		return configuration.NewSMConfiguration(viperEnv, cfgFile, nil)
+	}
+	rootCmd.SetContext(ctx.Ctx)
+	rootCmd.CompletionOptions.DisableDefaultCmd = true
+	// errors are printed by Execute according to the output format of the failed command
+	rootCmd.SilenceErrors = true
+	rootCmd.SetFlagErrorFunc(func(_ *cobra.Command, err error) error {
+		return newValidationError(err)
+	})
+
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.sm/config.json)")
 	rootCmd.PersistentFlags().BoolVarP(&ctx.Verbose, "verbose", "v", false, "verbose")
+	rootCmd.PersistentFlags().StringVar(&ctx.Target, "target", "", "login target to use instead of the current one")
+	rootCmd.PersistentFlags().IntVar(&ctx.Retries, "retries", smclient.DefaultMaxRetries, "maximum number of retries of idempotent requests failing with transient errors")
 
 	return rootCmd
 }
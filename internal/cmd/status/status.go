@@ -20,8 +20,9 @@ import (
 	"fmt"
 	"github.com/Peripli/service-manager-cli/internal/cmd"
 	"github.com/Peripli/service-manager-cli/internal/output"
+	"github.com/Peripli/service-manager-cli/pkg/smclient"
+	"github.com/Peripli/service-manager-cli/pkg/types"
 	"github.com/spf13/cobra"
-	"strings"
 )
 
 // Cmd wraps smctl status command
@@ -50,6 +51,7 @@ func (c *Cmd) Prepare(prepare cmd.PrepareFunc) *cobra.Command {
 
 	cmd.AddFormatFlag(result.Flags())
 	cmd.AddCommonQueryFlag(result.Flags(), &c.Parameters)
+	cmd.AddWatchFlag(result.Flags(), &c.Watch)
 
 	return result
 }
@@ -65,20 +67,33 @@ func (c *Cmd) Validate(args []string) error {
 
 // Run runs the command's logic
 func (c *Cmd) Run() error {
-	operation, err := c.Client.Status(c.operationURL, &c.Parameters)
+	if c.Watch.IsSet() {
+		return cmd.WatchObject(c.Context, c.outputFormat, c.status)
+	}
+
+	operation, err := c.status()
 	if err != nil {
-		if strings.Contains(err.Error(), "StatusCode: 404") {
-			output.PrintMessage(c.Output, "Operation not found.\n")
-			return nil
-		}
 		return err
 	}
-	output.PrintServiceManagerObject(c.Output, c.outputFormat, operation)
+	if err := output.PrintServiceManagerObject(c.Output, c.outputFormat, operation); err != nil {
+		return err
+	}
 	output.Println(c.Output)
 
 	return nil
 }
 
+func (c *Cmd) status() (types.ServiceManagerObject, error) {
+	operation, err := c.Client.Status(c.Ctx, c.operationURL, &c.Parameters)
+	if err != nil {
+		if smclient.IsNotFound(err) {
+			return nil, cmd.NewError(cmd.ErrorKindNotFound, "operation %s not found", c.operationURL)
+		}
+		return nil, err
+	}
+	return operation, nil
+}
+
 // HideUsage hide command's usage
 func (c *Cmd) HideUsage() bool {
 	return true
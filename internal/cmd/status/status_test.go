@@ -1,9 +1,13 @@
 package status
 
 import (
-	"github.com/Peripli/service-manager/pkg/util"
+	"encoding/json"
+	"github.com/Peripli/service-manager-cli/pkg/smclient"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"testing"
 
 	. "github.com/onsi/ginkgo"
@@ -59,12 +63,12 @@ var _ = Describe("Status command test", func() {
 	Context("when operation is not found", func() {
 		It("should return message", func() {
 			body := ioutil.NopCloser(bytes.NewReader([]byte("")))
-			expectedError := util.HandleResponseError(&http.Response{Body: body, StatusCode: http.StatusNotFound})
+			expectedError := smclient.NewAPIError(&http.Response{Body: body, StatusCode: http.StatusNotFound})
 			client.StatusReturns(nil, expectedError)
 			err := executeWithArgs("non-existing-path")
 
-			Expect(err).ShouldNot(HaveOccurred())
-			Expect(buffer.String()).To(ContainSubstring("Operation not found"))
+			Expect(err).To(MatchError("operation non-existing-path not found"))
+			Expect(cmd.KindOf(err)).To(Equal(cmd.ErrorKindNotFound))
 		})
 	})
 
@@ -77,4 +81,102 @@ var _ = Describe("Status command test", func() {
 			Expect(buffer.String()).To(ContainSubstring(operation.TableData().String()))
 		})
 	})
+
+	Context("when the request fails", func() {
+		var statusCmd *cobra.Command
+		var stderr *bytes.Buffer
+
+		BeforeEach(func() {
+			body := ioutil.NopCloser(bytes.NewReader([]byte(`{"error":"Conflict","description":"operation is locked"}`)))
+			header := http.Header{"X-Correlation-Id": []string{"correlation-id"}}
+			client.StatusReturns(nil, smclient.NewAPIError(&http.Response{Body: body, StatusCode: http.StatusConflict, Header: header}))
+			stderr = &bytes.Buffer{}
+			statusCmd = command.Prepare(cmd.SmPrepare)
+			statusCmd.SilenceErrors = true
+		})
+
+		printError := func(args ...string) int {
+			statusCmd.SetArgs(args)
+			err := statusCmd.Execute()
+			Expect(err).Should(HaveOccurred())
+			return cmd.PrintCommandError(stderr, statusCmd, err)
+		}
+
+		expectErrorObject := func(errObject map[string]interface{}) {
+			Expect(errObject).To(HaveKeyWithValue("error", "Conflict"))
+			Expect(errObject).To(HaveKeyWithValue("description", ContainSubstring("StatusCode: 409")))
+			Expect(errObject).To(HaveKeyWithValue("exit_code", BeNumerically("==", cmd.ExitCodeConflict)))
+			Expect(errObject).To(HaveKeyWithValue("status_code", BeNumerically("==", http.StatusConflict)))
+			Expect(errObject).To(HaveKeyWithValue("error_code", "Conflict"))
+			Expect(errObject).To(HaveKeyWithValue("correlation_id", "correlation-id"))
+		}
+
+		It("should print it as text by default", func() {
+			exitCode := printError("path")
+
+			Expect(exitCode).To(Equal(cmd.ExitCodeConflict))
+			Expect(stderr.String()).To(HavePrefix("Error: request failed: StatusCode: 409"))
+		})
+
+		It("should print it as json object with json output", func() {
+			exitCode := printError("path", "-o", "json")
+
+			Expect(exitCode).To(Equal(cmd.ExitCodeConflict))
+			errObject := map[string]interface{}{}
+			Expect(json.Unmarshal(stderr.Bytes(), &errObject)).To(Succeed())
+			expectErrorObject(errObject)
+		})
+
+		It("should print it as yaml object with yaml output", func() {
+			exitCode := printError("path", "-o", "yaml")
+
+			Expect(exitCode).To(Equal(cmd.ExitCodeConflict))
+			errObject := map[string]interface{}{}
+			Expect(yaml.Unmarshal(stderr.Bytes(), &errObject)).To(Succeed())
+			expectErrorObject(errObject)
+		})
+	})
+
+	Context("when --watch is used", func() {
+		inProgress := &types.Operation{ID: "operation-id", Type: "create", State: "in progress"}
+		succeeded := &types.Operation{ID: "operation-id", Type: "create", State: "succeeded"}
+
+		It("should refresh until the operation reaches a terminal state", func() {
+			client.StatusReturnsOnCall(0, inProgress, nil)
+			client.StatusReturnsOnCall(1, inProgress, nil)
+			client.StatusReturnsOnCall(2, succeeded, nil)
+
+			Expect(executeWithArgs("path", "--watch=1ms")).To(Succeed())
+
+			Expect(client.StatusCallCount()).To(Equal(3))
+			lines := strings.Split(strings.TrimSpace(buffer.String()), "\n")
+			Expect(lines).To(HaveLen(2))
+			Expect(lines[0]).To(Equal(`{"type":"ADDED","object":{"id":"operation-id","type":"create","state":"in progress"}}`))
+			Expect(lines[1]).To(Equal(`{"type":"MODIFIED","object":{"id":"operation-id","type":"create","state":"succeeded"}}`))
+		})
+
+		It("should stop after the first refresh if the operation is completed", func() {
+			client.StatusReturns(succeeded, nil)
+
+			Expect(executeWithArgs("path", "--watch")).To(Succeed())
+
+			Expect(client.StatusCallCount()).To(Equal(1))
+		})
+
+		It("should fail if the operation is not found", func() {
+			body := ioutil.NopCloser(bytes.NewReader([]byte("")))
+			client.StatusReturns(nil, smclient.NewAPIError(&http.Response{Body: body, StatusCode: http.StatusNotFound}))
+
+			err := executeWithArgs("path", "--watch")
+
+			Expect(err).To(MatchError("operation path not found"))
+		})
+
+		It("should fail for a negative interval", func() {
+			err := executeWithArgs("path", "--watch=-1s")
+
+			Expect(err).To(MatchError("--watch interval must be positive"))
+			Expect(cmd.KindOf(err)).To(Equal(cmd.ErrorKindValidation))
+		})
+	})
 })
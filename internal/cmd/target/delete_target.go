@@ -0,0 +1,95 @@
+/*
+ * Copyright 2018 The Service Manager Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package target
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Peripli/service-manager-cli/internal/cmd"
+	"github.com/Peripli/service-manager-cli/internal/output"
+)
+
+// DeleteTargetCmd wraps the smctl target delete command
+type DeleteTargetCmd struct {
+	*cmd.Context
+
+	input io.Reader
+	force bool
+
+	name string
+}
+
+// NewDeleteTargetCmd returns new target delete command with context
+func NewDeleteTargetCmd(context *cmd.Context, input io.Reader) *DeleteTargetCmd {
+	return &DeleteTargetCmd{Context: context, input: input}
+}
+
+// Prepare returns cobra command
+func (dtc *DeleteTargetCmd) Prepare(prepare cmd.PrepareFunc) *cobra.Command {
+	result := &cobra.Command{
+		Use:   "delete [name]",
+		Short: "Delete a login target",
+		Long:  `Delete a login target along with its stored token.`,
+
+		PreRunE: prepare(dtc, dtc.Context),
+		RunE:    cmd.RunE(dtc),
+	}
+
+	result.Flags().BoolVarP(&dtc.force, "force", "f", false, "Force delete without confirmation")
+
+	return result
+}
+
+// Validate validates command's arguments
+func (dtc *DeleteTargetCmd) Validate(args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("single [name] is required")
+	}
+	dtc.name = args[0]
+	return nil
+}
+
+// Run runs the command's logic
+func (dtc *DeleteTargetCmd) Run() error {
+	if err := dtc.Configuration.DeleteTarget(dtc.name); err != nil {
+		return err
+	}
+	output.PrintMessage(dtc.Output, "Target %s successfully deleted.\n", dtc.name)
+	return nil
+}
+
+// AskForConfirmation asks the user to confirm deletion
+func (dtc *DeleteTargetCmd) AskForConfirmation() (bool, error) {
+	if !dtc.force {
+		message := fmt.Sprintf("Do you really want to delete target with name [%s] (Y/n): ", dtc.name)
+		return cmd.CommonConfirmationPrompt(message, dtc.Context, dtc.input)
+	}
+	return true, nil
+}
+
+// PrintDeclineMessage prints confirmation decline message to the user
+func (dtc *DeleteTargetCmd) PrintDeclineMessage() {
+	cmd.CommonPrintDeclineMessage(dtc.Output)
+}
+
+// HideUsage hide command's usage
+func (dtc *DeleteTargetCmd) HideUsage() bool {
+	return true
+}
@@ -0,0 +1,57 @@
+package target
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/Peripli/service-manager-cli/internal/cmd"
+	"github.com/Peripli/service-manager-cli/internal/configuration/configurationfakes"
+)
+
+var _ = Describe("Delete target command test", func() {
+	var config *configurationfakes.FakeConfiguration
+	var command *DeleteTargetCmd
+	var buffer *bytes.Buffer
+	var promptBuffer *bytes.Buffer
+
+	BeforeEach(func() {
+		buffer = &bytes.Buffer{}
+		promptBuffer = &bytes.Buffer{}
+		config = &configurationfakes.FakeConfiguration{}
+		context := &cmd.Context{Output: buffer, Configuration: config}
+		command = NewDeleteTargetCmd(context, promptBuffer)
+	})
+
+	executeWithArgs := func(args ...string) error {
+		dtCmd := command.Prepare(cmd.CommonPrepare)
+		dtCmd.SetArgs(args)
+		return dtCmd.Execute()
+	}
+
+	It("should delete the target when forced", func() {
+		err := executeWithArgs("dev", "-f")
+
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(config.DeleteTargetArgsForCall(0)).To(Equal("dev"))
+		Expect(buffer.String()).To(ContainSubstring("Target dev successfully deleted."))
+	})
+
+	It("should delete the target when confirmed", func() {
+		promptBuffer.WriteString("y")
+		err := executeWithArgs("dev")
+
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(config.DeleteTargetCallCount()).To(Equal(1))
+	})
+
+	It("should not delete the target when declined", func() {
+		promptBuffer.WriteString("n")
+		err := executeWithArgs("dev")
+
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(config.DeleteTargetCallCount()).To(Equal(0))
+		Expect(buffer.String()).To(ContainSubstring("Delete declined"))
+	})
+})
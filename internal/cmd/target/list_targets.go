@@ -0,0 +1,87 @@
+/*
+ * Copyright 2018 The Service Manager Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package target
+
+import (
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Peripli/service-manager-cli/internal/cmd"
+	"github.com/Peripli/service-manager-cli/internal/output"
+	"github.com/Peripli/service-manager-cli/pkg/types"
+)
+
+// ListTargetsCmd wraps the smctl target list command
+type ListTargetsCmd struct {
+	*cmd.Context
+}
+
+// NewListTargetsCmd returns new target list command with context
+func NewListTargetsCmd(context *cmd.Context) *ListTargetsCmd {
+	return &ListTargetsCmd{Context: context}
+}
+
+// Prepare returns cobra command
+func (ltc *ListTargetsCmd) Prepare(prepare cmd.PrepareFunc) *cobra.Command {
+	result := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List login targets",
+		Long:    `List all login targets. The current target is marked with "*".`,
+
+		PreRunE: prepare(ltc, ltc.Context),
+		RunE:    cmd.RunE(ltc),
+	}
+
+	return result
+}
+
+// Run runs the command's logic
+func (ltc *ListTargetsCmd) Run() error {
+	targets, current, err := ltc.Configuration.ListTargets()
+	if err != nil {
+		return err
+	}
+	if len(targets) == 0 {
+		output.PrintMessage(ltc.Output, "There are no targets. Use \"smctl login\" to log in.\n")
+		return nil
+	}
+
+	names := make([]string, 0, len(targets))
+	for name := range targets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	table := &types.TableData{Headers: []string{"Current", "Name", "URL", "User"}}
+	for _, name := range names {
+		marker := ""
+		if name == current {
+			marker = "*"
+		}
+		table.Data = append(table.Data, []string{marker, name, targets[name].URL, targets[name].User})
+	}
+	output.PrintTable(ltc.Output, table)
+
+	return nil
+}
+
+// HideUsage hide command's usage
+func (ltc *ListTargetsCmd) HideUsage() bool {
+	return true
+}
@@ -0,0 +1,67 @@
+package target
+
+import (
+	"bytes"
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/Peripli/service-manager-cli/internal/cmd"
+	"github.com/Peripli/service-manager-cli/internal/configuration"
+	"github.com/Peripli/service-manager-cli/internal/configuration/configurationfakes"
+)
+
+var _ = Describe("List targets command test", func() {
+	var config *configurationfakes.FakeConfiguration
+	var command *ListTargetsCmd
+	var buffer *bytes.Buffer
+
+	BeforeEach(func() {
+		buffer = &bytes.Buffer{}
+		config = &configurationfakes.FakeConfiguration{}
+		context := &cmd.Context{Output: buffer, Configuration: config}
+		command = NewListTargetsCmd(context)
+	})
+
+	executeWithArgs := func(args ...string) error {
+		ltCmd := command.Prepare(cmd.CommonPrepare)
+		ltCmd.SetArgs(args)
+		return ltCmd.Execute()
+	}
+
+	Context("when there are targets", func() {
+		It("should list them sorted by name and mark the current one", func() {
+			config.ListTargetsReturns(map[string]*configuration.Settings{
+				"prod": {URL: "http://prod-sm.com", User: "prod-user"},
+				"dev":  {URL: "http://dev-sm.com", User: "dev-user"},
+			}, "prod", nil)
+
+			err := executeWithArgs()
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buffer.String()).To(MatchRegexp(`\s+dev\s+http://dev-sm.com\s+dev-user\s+\n\*\s+prod\s+http://prod-sm.com\s+prod-user`))
+		})
+	})
+
+	Context("when there are no targets", func() {
+		It("should print a login hint", func() {
+			config.ListTargetsReturns(map[string]*configuration.Settings{}, "", nil)
+
+			err := executeWithArgs()
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buffer.String()).To(ContainSubstring(`Use "smctl login" to log in.`))
+		})
+	})
+
+	Context("when the configuration cannot be read", func() {
+		It("should return error", func() {
+			config.ListTargetsReturns(nil, "", errors.New("read error"))
+
+			err := executeWithArgs()
+
+			Expect(err).To(MatchError("read error"))
+		})
+	})
+})
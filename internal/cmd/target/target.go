@@ -0,0 +1,55 @@
+/*
+ * Copyright 2018 The Service Manager Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package target
+
+import (
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Peripli/service-manager-cli/internal/cmd"
+)
+
+// Cmd wraps the smctl target command
+type Cmd struct {
+	*cmd.Context
+
+	input io.Reader
+}
+
+// NewTargetCmd returns new target command with context
+func NewTargetCmd(context *cmd.Context, input io.Reader) *Cmd {
+	return &Cmd{Context: context, input: input}
+}
+
+// Prepare returns cobra command with the target subcommands
+func (tc *Cmd) Prepare(prepare cmd.PrepareFunc) *cobra.Command {
+	result := &cobra.Command{
+		Use:   "target",
+		Short: "Manage login targets",
+		Long: `Manage the named Service Manager login targets stored in the config file.
+Use "smctl login --target [name]" to add a target and "--target [name]" with any command to use a target other than the current one.`,
+	}
+
+	result.AddCommand(
+		NewListTargetsCmd(tc.Context).Prepare(prepare),
+		NewUseTargetCmd(tc.Context).Prepare(prepare),
+		NewDeleteTargetCmd(tc.Context, tc.input).Prepare(prepare),
+	)
+
+	return result
+}
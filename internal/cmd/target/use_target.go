@@ -0,0 +1,75 @@
+/*
+ * Copyright 2018 The Service Manager Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package target
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Peripli/service-manager-cli/internal/cmd"
+	"github.com/Peripli/service-manager-cli/internal/output"
+)
+
+// UseTargetCmd wraps the smctl target use command
+type UseTargetCmd struct {
+	*cmd.Context
+
+	name string
+}
+
+// NewUseTargetCmd returns new target use command with context
+func NewUseTargetCmd(context *cmd.Context) *UseTargetCmd {
+	return &UseTargetCmd{Context: context}
+}
+
+// Prepare returns cobra command
+func (utc *UseTargetCmd) Prepare(prepare cmd.PrepareFunc) *cobra.Command {
+	result := &cobra.Command{
+		Use:   "use [name]",
+		Short: "Switch the current login target",
+		Long:  `Switch the login target used by subsequent commands.`,
+
+		PreRunE: prepare(utc, utc.Context),
+		RunE:    cmd.RunE(utc),
+	}
+
+	return result
+}
+
+// Validate validates command's arguments
+func (utc *UseTargetCmd) Validate(args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("single [name] is required")
+	}
+	utc.name = args[0]
+	return nil
+}
+
+// Run runs the command's logic
+func (utc *UseTargetCmd) Run() error {
+	if err := utc.Configuration.UseTarget(utc.name); err != nil {
+		return err
+	}
+	output.PrintMessage(utc.Output, "Switched to target %s.\n", utc.name)
+	return nil
+}
+
+// HideUsage hide command's usage
+func (utc *UseTargetCmd) HideUsage() bool {
+	return true
+}
@@ -0,0 +1,54 @@
+package target
+
+import (
+	"bytes"
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/Peripli/service-manager-cli/internal/cmd"
+	"github.com/Peripli/service-manager-cli/internal/configuration/configurationfakes"
+)
+
+var _ = Describe("Use target command test", func() {
+	var config *configurationfakes.FakeConfiguration
+	var command *UseTargetCmd
+	var buffer *bytes.Buffer
+
+	BeforeEach(func() {
+		buffer = &bytes.Buffer{}
+		config = &configurationfakes.FakeConfiguration{}
+		context := &cmd.Context{Output: buffer, Configuration: config}
+		command = NewUseTargetCmd(context)
+	})
+
+	executeWithArgs := func(args ...string) error {
+		utCmd := command.Prepare(cmd.CommonPrepare)
+		utCmd.SetArgs(args)
+		return utCmd.Execute()
+	}
+
+	It("should switch the current target", func() {
+		err := executeWithArgs("prod")
+
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(config.UseTargetArgsForCall(0)).To(Equal("prod"))
+		Expect(buffer.String()).To(ContainSubstring("Switched to target prod."))
+	})
+
+	It("should return error when the target does not exist", func() {
+		config.UseTargetReturns(errors.New("target prod not found"))
+
+		err := executeWithArgs("prod")
+
+		Expect(err).To(MatchError("target prod not found"))
+	})
+
+	It("should require a name", func() {
+		err := executeWithArgs()
+
+		Expect(err).To(MatchError("single [name] is required"))
+		Expect(config.UseTargetCallCount()).To(Equal(0))
+	})
+})
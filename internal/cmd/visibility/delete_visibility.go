@@ -19,10 +19,10 @@ package visibility
 import (
 	"fmt"
 	"io"
-	"strings"
 
 	"github.com/Peripli/service-manager-cli/internal/cmd"
 	"github.com/Peripli/service-manager-cli/internal/output"
+	"github.com/Peripli/service-manager-cli/pkg/smclient"
 	"github.com/spf13/cobra"
 )
 
@@ -56,10 +56,9 @@ func (dv *DeleteVisibilityCmd) Validate(args []string) error {
 func (dv *DeleteVisibilityCmd) Run() error {
 	dv.Parameters.FieldQuery = append(dv.Parameters.FieldQuery, fmt.Sprintf("id eq '%s'", dv.id))
 
-	if err := dv.Client.DeleteVisibilities(&dv.Parameters); err != nil {
-		if strings.Contains(err.Error(), "StatusCode: 404") {
-			output.PrintMessage(dv.Output, "Visibility not found.\n")
-			return nil
+	if err := dv.Client.DeleteVisibilities(dv.Ctx, &dv.Parameters); err != nil {
+		if smclient.IsNotFound(err) {
+			return cmd.NewError(cmd.ErrorKindNotFound, "visibility with id %s not found", dv.id)
 		}
 		output.PrintMessage(dv.Output, "Could not delete visibility(s). Reason: ")
 		return err
@@ -4,8 +4,8 @@ import (
 	"bytes"
 
 	"github.com/Peripli/service-manager-cli/internal/cmd"
+	"github.com/Peripli/service-manager-cli/pkg/smclient"
 	"github.com/Peripli/service-manager-cli/pkg/smclient/smclientfakes"
-	"github.com/Peripli/service-manager/pkg/util"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"io/ioutil"
@@ -72,7 +72,7 @@ var _ = Describe("Delete visibility command test", func() {
 			err := executeWithArgs([]string{"id", "-f", "--param", param}...)
 			Expect(err).ShouldNot(HaveOccurred())
 
-			args := client.DeleteVisibilitiesArgsForCall(0)
+			_, args := client.DeleteVisibilitiesArgsForCall(0)
 
 			Expect(args.GeneralParams).To(ConsistOf(param))
 			Expect(args.FieldQuery).To(ConsistOf("id eq 'id'"))
@@ -83,12 +83,12 @@ var _ = Describe("Delete visibility command test", func() {
 	Context("when non-existing visibility is being deleted", func() {
 		It("should return error message", func() {
 			body := ioutil.NopCloser(bytes.NewReader([]byte("")))
-			expectedError := util.HandleResponseError(&http.Response{Body: body, StatusCode: http.StatusNotFound})
+			expectedError := smclient.NewAPIError(&http.Response{Body: body, StatusCode: http.StatusNotFound})
 			client.DeleteVisibilitiesReturns(expectedError)
 			err := executeWithArgs("id", "-f")
 
-			Expect(err).ShouldNot(HaveOccurred())
-			Expect(buffer.String()).To(ContainSubstring("Visibility not found."))
+			Expect(err).To(MatchError("visibility with id id not found"))
+			Expect(cmd.KindOf(err)).To(Equal(cmd.ErrorKindNotFound))
 		})
 	})
 
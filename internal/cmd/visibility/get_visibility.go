@@ -0,0 +1,97 @@
+/*
+ * Copyright 2018 The Service Manager Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package visibility
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Peripli/service-manager-cli/internal/cmd"
+	"github.com/Peripli/service-manager-cli/internal/output"
+	"github.com/Peripli/service-manager-cli/pkg/smclient"
+)
+
+// GetVisibilityCmd wraps the smctl get-visibility command
+type GetVisibilityCmd struct {
+	*cmd.Context
+
+	id           string
+	prepare      cmd.PrepareFunc
+	outputFormat output.Format
+}
+
+// NewGetVisibilityCmd returns new get-visibility command with context
+func NewGetVisibilityCmd(context *cmd.Context) *GetVisibilityCmd {
+	return &GetVisibilityCmd{Context: context}
+}
+
+// Run runs the command's logic
+func (gv *GetVisibilityCmd) Run() error {
+	visibility, err := gv.Client.GetVisibilityByID(gv.Ctx, gv.id, &gv.Parameters)
+	if err != nil {
+		if smclient.IsNotFound(err) {
+			return cmd.NewError(cmd.ErrorKindNotFound, "visibility with id %s not found", gv.id)
+		}
+		return err
+	}
+	if err := output.PrintServiceManagerObject(gv.Output, gv.outputFormat, visibility); err != nil {
+		return err
+	}
+	output.Println(gv.Output)
+
+	return nil
+}
+
+// Validate validates command's arguments
+func (gv *GetVisibilityCmd) Validate(args []string) error {
+	if len(args) < 1 || len(args[0]) == 0 {
+		return fmt.Errorf("id is required")
+	}
+
+	gv.id = args[0]
+
+	return nil
+}
+
+// SetOutputFormat set output format
+func (gv *GetVisibilityCmd) SetOutputFormat(format output.Format) {
+	gv.outputFormat = format
+}
+
+// HideUsage hide command's usage
+func (gv *GetVisibilityCmd) HideUsage() bool {
+	return true
+}
+
+// Prepare returns cobra command
+func (gv *GetVisibilityCmd) Prepare(prepare cmd.PrepareFunc) *cobra.Command {
+	gv.prepare = prepare
+	result := &cobra.Command{
+		Use:     "get-visibility [id]",
+		Aliases: []string{"gv"},
+		Short:   "Get single visibility",
+		Long:    `Get single visibility by its id`,
+		PreRunE: gv.prepare(gv, gv.Context),
+		RunE:    cmd.RunE(gv),
+	}
+
+	cmd.AddFormatFlag(result.Flags())
+	cmd.AddCommonQueryFlag(result.Flags(), &gv.Parameters)
+
+	return result
+}
@@ -0,0 +1,83 @@
+package visibility
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/Peripli/service-manager-cli/internal/cmd"
+	"github.com/Peripli/service-manager-cli/pkg/smclient"
+	"github.com/Peripli/service-manager-cli/pkg/smclient/smclientfakes"
+	"github.com/Peripli/service-manager-cli/pkg/types"
+)
+
+var _ = Describe("Get visibility command test", func() {
+
+	var client *smclientfakes.FakeClient
+	var command *GetVisibilityCmd
+	var buffer *bytes.Buffer
+	visibility := types.Visibility{
+		ID:            "visibilityID",
+		PlatformID:    "platformID",
+		ServicePlanID: "planID",
+	}
+
+	BeforeEach(func() {
+		buffer = &bytes.Buffer{}
+		client = &smclientfakes.FakeClient{}
+		context := &cmd.Context{Output: buffer, Client: client}
+		command = NewGetVisibilityCmd(context)
+	})
+
+	executeWithArgs := func(args ...string) error {
+		commandToRun := command.Prepare(cmd.SmPrepare)
+		commandToRun.SetArgs(args)
+
+		return commandToRun.Execute()
+	}
+
+	Context("when no id is provided", func() {
+		It("should return error", func() {
+			err := executeWithArgs()
+
+			Expect(err).To(MatchError("id is required"))
+		})
+	})
+
+	Context("when visibility is found", func() {
+		It("should print all its fields", func() {
+			client.GetVisibilityByIDReturns(&visibility, nil)
+			err := executeWithArgs("visibilityID", "--param", "key=value")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			_, id, q := client.GetVisibilityByIDArgsForCall(0)
+			Expect(id).To(Equal("visibilityID"))
+			Expect(q.GeneralParams).To(ConsistOf("key=value"))
+			Expect(buffer.String()).To(ContainSubstring(visibility.TableData().String()))
+		})
+	})
+
+	Context("when visibility is not found", func() {
+		It("should return not found error", func() {
+			body := ioutil.NopCloser(bytes.NewReader([]byte("")))
+			client.GetVisibilityByIDReturns(nil, smclient.NewAPIError(&http.Response{Body: body, StatusCode: http.StatusNotFound}))
+			err := executeWithArgs("unknown")
+
+			Expect(err).To(MatchError("visibility with id unknown not found"))
+			Expect(cmd.KindOf(err)).To(Equal(cmd.ErrorKindNotFound))
+		})
+	})
+
+	Context("when getting the visibility fails", func() {
+		It("should return the error", func() {
+			client.GetVisibilityByIDReturns(nil, errors.New("request failed"))
+			err := executeWithArgs("visibilityID")
+
+			Expect(err).To(MatchError("request failed"))
+		})
+	})
+})
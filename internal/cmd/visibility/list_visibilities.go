@@ -19,7 +19,11 @@ package visibility
 import (
 	"github.com/Peripli/service-manager-cli/internal/cmd"
 	"github.com/Peripli/service-manager-cli/internal/output"
+	"github.com/Peripli/service-manager-cli/pkg/smclient"
+	"github.com/Peripli/service-manager-cli/pkg/types"
 	"github.com/spf13/cobra"
+
+	"github.com/Peripli/service-manager/pkg/web"
 )
 
 // ListVisibilitiesCmd wraps the smctl list-visibilities command
@@ -36,12 +40,21 @@ func NewListVisibilitiesCmd(context *cmd.Context) *ListVisibilitiesCmd {
 
 //Run runs the command's logic
 func (lv *ListVisibilitiesCmd) Run() error {
-	visibilities, err := lv.Client.ListVisibilities(&lv.Parameters)
+	if lv.Paging.IsSet() {
+		return cmd.PrintPages(lv.Context, smclient.NewPager(lv.Ctx, lv.Client, web.VisibilitiesURL, &lv.Parameters, &lv.Paging.PageOptions), lv.outputFormat, func() (types.ServiceManagerObject, interface{}) {
+			visibilities := &types.Visibilities{}
+			return visibilities, &visibilities.Visibilities
+		}, nil)
+	}
+
+	visibilities, err := lv.Client.ListVisibilities(lv.Ctx, &lv.Parameters)
 	if err != nil {
 		return err
 	}
 
-	output.PrintServiceManagerObject(lv.Output, lv.outputFormat, visibilities)
+	if err := output.PrintServiceManagerObject(lv.Output, lv.outputFormat, visibilities); err != nil {
+		return err
+	}
 	output.Println(lv.Output)
 	return nil
 }
@@ -70,6 +83,7 @@ func (lv *ListVisibilitiesCmd) Prepare(prepare cmd.PrepareFunc) *cobra.Command {
 	cmd.AddFormatFlag(result.Flags())
 	cmd.AddQueryingFlags(result.Flags(), &lv.Parameters)
 	cmd.AddCommonQueryFlag(result.Flags(), &lv.Parameters)
+	cmd.AddPagingFlags(result.Flags(), &lv.Paging)
 
 	return result
 }
@@ -83,7 +83,7 @@ var _ = Describe("List visibilities command test", func() {
 			err := executeWithArgs([]string{"--param", param})
 			Expect(err).ShouldNot(HaveOccurred())
 
-			args := client.ListVisibilitiesArgsForCall(0)
+			_, args := client.ListVisibilitiesArgsForCall(0)
 
 			Expect(args.GeneralParams).To(ConsistOf(param))
 			Expect(args.FieldQuery).To(BeEmpty())
@@ -97,7 +97,7 @@ var _ = Describe("List visibilities command test", func() {
 			client.ListVisibilitiesReturns(result, nil)
 			err := executeWithArgs([]string{"-f", "planId eq 'plan1'"})
 
-			queryArg := client.ListVisibilitiesArgsForCall(0)
+			_, queryArg := client.ListVisibilitiesArgsForCall(0)
 
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(queryArg.FieldQuery[0]).To(Equal("planId eq 'plan1'"))
@@ -111,7 +111,7 @@ var _ = Describe("List visibilities command test", func() {
 			client.ListVisibilitiesReturns(result, nil)
 			err := executeWithArgs([]string{"-l", "test eq false"})
 
-			queryArg := client.ListVisibilitiesArgsForCall(0)
+			_, queryArg := client.ListVisibilitiesArgsForCall(0)
 
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(queryArg.FieldQuery).To(BeEmpty())
@@ -51,11 +51,13 @@ func (rv *RegisterVisibilityCmd) Validate(args []string) error {
 
 // Run runs command's logic
 func (rv *RegisterVisibilityCmd) Run() error {
-	resultVisibility, err := rv.Client.RegisterVisibility(&rv.visibility, &rv.Parameters)
+	resultVisibility, err := rv.Client.RegisterVisibility(rv.Ctx, &rv.visibility, &rv.Parameters)
 	if err != nil {
 		return err
 	}
-	output.PrintServiceManagerObject(rv.Output, rv.outputFormat, resultVisibility)
+	if err := output.PrintServiceManagerObject(rv.Output, rv.outputFormat, resultVisibility); err != nil {
+		return err
+	}
 	output.Println(rv.Output)
 	return nil
 }
@@ -84,6 +86,7 @@ func (rv *RegisterVisibilityCmd) Prepare(prepare cmd.PrepareFunc) *cobra.Command
 
 	cmd.AddFormatFlag(result.Flags())
 	cmd.AddCommonQueryFlag(result.Flags(), &rv.Parameters)
+	result.ValidArgsFunction = cmd.CompleteArgs(rv.Context, cmd.PlatformIDs, cmd.PlanIDs)
 
 	return result
 }
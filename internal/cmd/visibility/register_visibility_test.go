@@ -57,7 +57,7 @@ var _ = Describe("Register visibility command test", func() {
 			It("argument values should be as expected", func() {
 				validRegisterVisibilityExecution("platformId", "planId")
 
-				v, _ := client.RegisterVisibilityArgsForCall(0)
+				_, v, _ := client.RegisterVisibilityArgsForCall(0)
 
 				Expect(v.PlatformID).To(Equal("platformId"))
 				Expect(v.ServicePlanID).To(Equal("planId"))
@@ -90,7 +90,7 @@ var _ = Describe("Register visibility command test", func() {
 			It("should pass it to SM", func() {
 				validRegisterVisibilityExecution("platformId", "planId", "--param", "paramKey=paramValue")
 
-				_, args := client.RegisterVisibilityArgsForCall(0)
+				_, _, args := client.RegisterVisibilityArgsForCall(0)
 
 				Expect(args.GeneralParams).To(ConsistOf("paramKey=paramValue"))
 				Expect(args.FieldQuery).To(BeEmpty())
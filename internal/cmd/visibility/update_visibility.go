@@ -61,11 +61,13 @@ func (uv *UpdateVisibilityCmd) Validate(args []string) error {
 
 // Run runs the command's logic
 func (uv *UpdateVisibilityCmd) Run() error {
-	updatedVisibility, err := uv.Client.UpdateVisibility(uv.id, uv.updatedVisibility, &uv.Parameters)
+	updatedVisibility, err := uv.Client.UpdateVisibility(uv.Ctx, uv.id, uv.updatedVisibility, &uv.Parameters)
 	if err != nil {
 		return err
 	}
-	output.PrintServiceManagerObject(uv.Output, uv.outputFormat, updatedVisibility)
+	if err := output.PrintServiceManagerObject(uv.Output, uv.outputFormat, updatedVisibility); err != nil {
+		return err
+	}
 	output.Println(uv.Output)
 	return nil
 }
@@ -58,7 +58,7 @@ var _ = Describe("Update visibility command test", func() {
 			It("argument values should be as expected", func() {
 				err := validUpdateVisibilityExecution("id", `{"platform_id":"newPlatformID"}`)
 
-				id, v, _ := client.UpdateVisibilityArgsForCall(0)
+				_, id, v, _ := client.UpdateVisibilityArgsForCall(0)
 
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(id).To(Equal("id"))
@@ -95,7 +95,7 @@ var _ = Describe("Update visibility command test", func() {
 				err := validUpdateVisibilityExecution("platformId", `{"platform_id":"newPlatformID"}`, "--param", "paramKey=paramValue")
 				Expect(err).ShouldNot(HaveOccurred())
 
-				_, _, args := client.UpdateVisibilityArgsForCall(0)
+				_, _, _, args := client.UpdateVisibilityArgsForCall(0)
 
 				Expect(args.GeneralParams).To(ConsistOf("paramKey=paramValue"))
 				Expect(args.FieldQuery).To(BeEmpty())
@@ -0,0 +1,105 @@
+/*
+ * Copyright 2018 The Service Manager Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package cmd
+
+import (
+	"time"
+
+	smtypes "github.com/Peripli/service-manager/pkg/types"
+
+	"github.com/Peripli/service-manager-cli/pkg/query"
+	"github.com/Peripli/service-manager-cli/pkg/types"
+)
+
+const (
+	// DefaultWaitTimeout is the default maximum time to wait for an asynchronous operation
+	DefaultWaitTimeout = 30 * time.Minute
+
+	waitInitialInterval = time.Second
+	waitMaxInterval     = 15 * time.Second
+)
+
+// WaitOptions holds the values of the --wait and --timeout flags
+type WaitOptions struct {
+	Enabled bool
+	Timeout time.Duration
+}
+
+// WaitForOperation polls the operation at location with exponential backoff until it reaches a terminal state.
+// Zero or negative timeout means that there is no time limit.
+func WaitForOperation(ctx *Context, location string) (*types.Operation, error) {
+	var deadline time.Time
+	if ctx.Wait.Timeout > 0 {
+		deadline = time.Now().Add(ctx.Wait.Timeout)
+	}
+
+	interval := waitInitialInterval
+	for {
+		operation, err := ctx.Client.Status(ctx.Ctx, location, &query.Parameters{})
+		if err != nil {
+			return nil, err
+		}
+		if isTerminalState(operation.State) {
+			return operation, nil
+		}
+
+		sleep := interval
+		if !deadline.IsZero() {
+			remaining := time.Until(deadline)
+			if remaining <= 0 {
+				return nil, NewError(ErrorKindTimeout, "timed out after %s waiting for operation to complete. To see status of the operation use:\nsmctl status %s", ctx.Wait.Timeout, location)
+			}
+			if remaining < sleep {
+				sleep = remaining
+			}
+		}
+		select {
+		case <-ctx.Ctx.Done():
+			return nil, ctx.Ctx.Err()
+		case <-time.After(sleep):
+		}
+
+		interval *= 2
+		if interval > waitMaxInterval {
+			interval = waitMaxInterval
+		}
+	}
+}
+
+func isTerminalState(state string) bool {
+	return state == string(smtypes.SUCCEEDED) || state == string(smtypes.FAILED)
+}
+
+// getOperationResource fetches the resource created or updated by a succeeded operation.
+// It returns nil if the operation deleted the resource or the resource type is not supported.
+func getOperationResource(ctx *Context, operation *types.Operation) (types.ServiceManagerObject, error) {
+	if operation.Type == string(smtypes.DELETE) || operation.ResourceID == "" {
+		return nil, nil
+	}
+
+	q := &query.Parameters{}
+	switch smtypes.ObjectType(operation.ResourceType) {
+	case smtypes.ServiceInstanceType:
+		return ctx.Client.GetInstanceByID(ctx.Ctx, operation.ResourceID, q)
+	case smtypes.ServiceBindingType:
+		return ctx.Client.GetBindingByID(ctx.Ctx, operation.ResourceID, q)
+	case smtypes.ServiceBrokerType:
+		return ctx.Client.GetBrokerByID(ctx.Ctx, operation.ResourceID, q)
+	default:
+		return nil, nil
+	}
+}
@@ -0,0 +1,237 @@
+/*
+ * Copyright 2018 The Service Manager Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/spf13/pflag"
+	"golang.org/x/crypto/ssh/terminal"
+
+	"github.com/Peripli/service-manager-cli/internal/output"
+	"github.com/Peripli/service-manager-cli/pkg/types"
+)
+
+// DefaultWatchInterval is the refresh interval of --watch without a value
+const DefaultWatchInterval = 2 * time.Second
+
+// WatchOptions holds the value of the --watch flag
+type WatchOptions struct {
+	Interval time.Duration
+}
+
+// IsSet returns whether the --watch flag was provided
+func (o *WatchOptions) IsSet() bool {
+	return o.Interval != 0
+}
+
+// AddWatchFlag adds the --watch flag of commands watching single resources or operations, see WatchObject.
+// Its interval is optional and has to be given as --watch=5s.
+func AddWatchFlag(flags *pflag.FlagSet, options *WatchOptions) {
+	addWatchFlag(flags, options, "Refresh the output every interval until no operation is in progress")
+}
+
+// AddListWatchFlag adds the --watch flag of list commands, see WatchList.
+// Its interval is optional and has to be given as --watch=5s.
+func AddListWatchFlag(flags *pflag.FlagSet, options *WatchOptions) {
+	addWatchFlag(flags, options, "Refresh the output every interval until interrupted")
+}
+
+func addWatchFlag(flags *pflag.FlagSet, options *WatchOptions, usage string) {
+	flags.DurationVarP(&options.Interval, "watch", "", 0, usage)
+	flags.Lookup("watch").NoOptDefVal = DefaultWatchInterval.String()
+}
+
+// FetchFunc fetches the object printed by a watched command
+type FetchFunc func() (types.ServiceManagerObject, error)
+
+// WatchObject prints the resource or operation returned by fetch and refreshes it with the interval of the --watch flag,
+// until none of its items has an operation in progress.
+// An object which is not found anymore after the first refresh, e.g. because it was deleted, ends the watch.
+// See watch for the output.
+func WatchObject(ctx *Context, outputFormat output.Format, fetch FetchFunc) error {
+	return watch(ctx, outputFormat, fetch, true)
+}
+
+// WatchList prints the list returned by fetch and refreshes it with the interval of the --watch flag until the command is interrupted.
+// See watch for the output.
+func WatchList(ctx *Context, outputFormat output.Format, fetch FetchFunc) error {
+	return watch(ctx, outputFormat, fetch, false)
+}
+
+// watch prints the object returned by fetch and refreshes it with the interval of the --watch flag,
+// until the command is interrupted or, if untilDone is set, none of its items has an operation in progress.
+// On a terminal the text output is redrawn in place and the rows whose operation state changed are highlighted.
+// Otherwise only the added, modified and deleted items are printed as NDJSON events.
+// Credentials are masked unless --show-credentials is used.
+func watch(ctx *Context, outputFormat output.Format, fetch FetchFunc, untilDone bool) error {
+	if ctx.Watch.Interval < 0 {
+		return newValidationError(fmt.Errorf("--watch interval must be positive"))
+	}
+	if ctx.Paging.IsSet() {
+		return newValidationError(fmt.Errorf("--watch can't be combined with paging flags"))
+	}
+	redraw := outputFormat == output.FormatText && isTerminal(ctx.Output)
+
+	var previous []watchedItem
+	for refresh := 0; ; refresh++ {
+		object, err := fetch()
+		gone := err != nil && refresh > 0 && KindOf(err) == ErrorKindNotFound
+		if err != nil && !gone {
+			return err
+		}
+
+		var items []watchedItem
+		if !gone {
+			object = Printable(ctx, object)
+			if items, err = watchedItems(object); err != nil {
+				return err
+			}
+		}
+
+		if redraw {
+			title := fmt.Sprintf("Every %s, refreshed at %s. Press Ctrl-C to stop.", ctx.Watch.Interval, time.Now().Format("15:04:05"))
+			if gone {
+				title += "\n" + err.Error()
+			}
+			output.PrintWatchFrame(ctx.Output, title, object, changedStates(previous, items, refresh == 0))
+		} else {
+			output.PrintWatchEvents(ctx.Output, watchEvents(previous, items))
+		}
+
+		if gone || (untilDone && !anyInProgress(items)) {
+			return nil
+		}
+		previous = items
+
+		select {
+		case <-ctx.Ctx.Done():
+			return ctx.Ctx.Err()
+		case <-time.After(ctx.Watch.Interval):
+		}
+	}
+}
+
+// watchedItem is an item of a watched object, identified by its ID or its position if it has none
+type watchedItem struct {
+	id    string
+	state string
+	json  json.RawMessage
+}
+
+// watchedItems returns the items of a list, or the object itself if it is not a list.
+// The state of an item is the state of its last operation, or its own state if it is an operation.
+func watchedItems(object types.ServiceManagerObject) ([]watchedItem, error) {
+	b, err := json.Marshal(object)
+	if err != nil {
+		return nil, err
+	}
+	raws := []json.RawMessage{b}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err == nil {
+		if list, isList := fields["items"]; isList {
+			raws = nil
+			if err := json.Unmarshal(list, &raws); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	items := make([]watchedItem, 0, len(raws))
+	for i, raw := range raws {
+		var fields struct {
+			ID            string `json:"id"`
+			State         string `json:"state"`
+			LastOperation *struct {
+				State string `json:"state"`
+			} `json:"last_operation"`
+		}
+		if err := json.Unmarshal(raw, &fields); err != nil {
+			return nil, err
+		}
+		item := watchedItem{id: fields.ID, state: fields.State, json: raw}
+		if fields.LastOperation != nil {
+			item.state = fields.LastOperation.State
+		}
+		if item.id == "" {
+			item.id = strconv.Itoa(i)
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// changedStates returns the indexes of the items whose state changed since the previous refresh, including new items
+func changedStates(previous, items []watchedItem, first bool) map[int]bool {
+	states := make(map[string]string, len(previous))
+	for _, item := range previous {
+		states[item.id] = item.state
+	}
+	changed := make(map[int]bool)
+	for i, item := range items {
+		state, existed := states[item.id]
+		if (existed && state != item.state) || (!existed && !first) {
+			changed[i] = true
+		}
+	}
+	return changed
+}
+
+// watchEvents returns the events for the items added, modified or deleted since the previous refresh
+func watchEvents(previous, items []watchedItem) []output.WatchEvent {
+	existing := make(map[string]json.RawMessage, len(previous))
+	for _, item := range previous {
+		existing[item.id] = item.json
+	}
+
+	var events []output.WatchEvent
+	for _, item := range items {
+		before, existed := existing[item.id]
+		switch {
+		case !existed:
+			events = append(events, output.WatchEvent{Type: output.WatchEventAdded, Object: item.json})
+		case string(before) != string(item.json):
+			events = append(events, output.WatchEvent{Type: output.WatchEventModified, Object: item.json})
+		}
+		delete(existing, item.id)
+	}
+	for _, item := range previous {
+		if _, deleted := existing[item.id]; deleted {
+			events = append(events, output.WatchEvent{Type: output.WatchEventDeleted, Object: item.json})
+		}
+	}
+	return events
+}
+
+func anyInProgress(items []watchedItem) bool {
+	for _, item := range items {
+		if item.state != "" && !isTerminalState(item.state) {
+			return true
+		}
+	}
+	return false
+}
+
+func isTerminal(wr io.Writer) bool {
+	file, isFile := wr.(*os.File)
+	return isFile && terminal.IsTerminal(int(file.Fd()))
+}
@@ -17,13 +17,18 @@
 package configuration
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io/fs"
 	"os"
+	"regexp"
 	"time"
 
 	"github.com/Peripli/service-manager-cli/internal/util"
 	"github.com/Peripli/service-manager-cli/pkg/auth"
+	"github.com/spf13/cast"
 	"github.com/spf13/viper"
 )
 
@@ -63,16 +68,42 @@ func (settings Settings) GetToken() auth.Token {
 // Configuration should be implemented for load and save of SM client config
 //go:generate counterfeiter . Configuration
 type Configuration interface {
+	// Save saves the settings of the selected target
 	Save(*Settings) error
+	// Load loads the settings of the selected target
 	Load() (*Settings, error)
+
+	// SelectTarget selects the target used by Load and Save instead of the current one
+	SelectTarget(name string) error
+	// ListTargets returns the settings of all targets along with the name of the current one
+	ListTargets() (map[string]*Settings, string, error)
+	// UseTarget makes the target with the given name the current one
+	UseTarget(name string) error
+	// DeleteTarget deletes the target with the given name
+	DeleteTarget(name string) error
 }
 
+const (
+	// DefaultTargetName is the name of the target used when no other target is selected
+	DefaultTargetName = "default"
+
+	currentTargetKey = "current_target"
+	targetsKey       = "targets"
+)
+
+var targetNameRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+var credentialKeys = []string{"access_token", "refresh_token", "client_secret"}
+
 type smConfiguration struct {
-	viperEnv *viper.Viper
+	viperEnv       *viper.Viper
+	credentials    CredentialStore
+	selectedTarget string
 }
 
-// NewSMConfiguration returns implementation of Configuration interface
-func NewSMConfiguration(viperEnv *viper.Viper, cfgFile string) (Configuration, error) {
+// NewSMConfiguration returns implementation of Configuration interface. Secrets are kept in the credential store
+// and not in the config file. If credentialStore is nil, the store is selected by the SM_CREDENTIAL_STORE environment variable.
+func NewSMConfiguration(viperEnv *viper.Viper, cfgFile string, credentialStore CredentialStore) (Configuration, error) {
 	if cfgFile == "" {
 		var err error
 		cfgFile, err = defaultFilePath()
@@ -84,31 +115,235 @@ func NewSMConfiguration(viperEnv *viper.Viper, cfgFile string) (Configuration, e
 		return nil, err
 	}
 
+	if credentialStore == nil {
+		var err error
+		if credentialStore, err = newCredentialStoreFromEnv(cfgFile); err != nil {
+			return nil, err
+		}
+	}
+
 	viperEnv.SetConfigFile(cfgFile)
-	viperEnv.SetDefault("token_basic_auth", true) // RFC 6749 section 2.3.1
 
-	return &smConfiguration{viperEnv}, nil
+	return &smConfiguration{viperEnv: viperEnv, credentials: credentialStore}, nil
+}
+
+// ValidateTargetName validates the name of a target
+func ValidateTargetName(name string) error {
+	if !targetNameRegexp.MatchString(name) {
+		return fmt.Errorf("invalid target name %q: only lowercase letters, digits, '-' and '_' are allowed", name)
+	}
+	return nil
+}
+
+// SelectTarget implements target selection for the current invocation
+func (smCfg *smConfiguration) SelectTarget(name string) error {
+	if err := ValidateTargetName(name); err != nil {
+		return err
+	}
+	smCfg.selectedTarget = name
+	return nil
 }
 
 // Save implements configuration save
 func (smCfg *smConfiguration) Save(settings *Settings) error {
-	smCfg.viperEnv.Set("url", settings.URL)
-	smCfg.viperEnv.Set("user", settings.User)
-	smCfg.viperEnv.Set("ssl_disabled", settings.SSLDisabled)
-	smCfg.viperEnv.Set("token_basic_auth", settings.TokenBasicAuth)
-
-	smCfg.viperEnv.Set("access_token", settings.AccessToken)
-	smCfg.viperEnv.Set("refresh_token", settings.RefreshToken)
-	smCfg.viperEnv.Set("expiry", settings.ExpiresIn.Format(time.RFC1123Z))
-
-	smCfg.viperEnv.Set("client_id", settings.ClientID)
-	smCfg.viperEnv.Set("client_secret", settings.ClientSecret)
-	smCfg.viperEnv.Set("issuer_url", settings.IssuerURL)
-	smCfg.viperEnv.Set("token_url", settings.TokenEndpoint)
-	smCfg.viperEnv.Set("auth_url", settings.AuthorizationEndpoint)
-	smCfg.viperEnv.Set("auth_flow", string(settings.AuthFlow))
+	targets, current, err := smCfg.readTargets()
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	if _, err := smCfg.migrateCredentials(targets); err != nil {
+		return err
+	}
+
+	name := smCfg.targetName(current)
+	if err := smCfg.credentials.Set(name, &Credentials{
+		AccessToken:  settings.AccessToken,
+		RefreshToken: settings.RefreshToken,
+		ClientSecret: settings.ClientSecret,
+	}); err != nil {
+		return err
+	}
+	targets[name] = settingsToMap(settings)
+	if current == "" {
+		current = name
+	}
+	return smCfg.write(targets, current)
+}
+
+// Load implements configuration load
+func (smCfg *smConfiguration) Load() (*Settings, error) {
+	targets, current, err := smCfg.readTargets()
+	if err != nil {
+		return nil, err
+	}
+	migrated, err := smCfg.migrateCredentials(targets)
+	if err != nil {
+		return nil, err
+	}
+	if migrated {
+		if err := smCfg.write(targets, current); err != nil {
+			return nil, err
+		}
+	}
+
+	name := smCfg.targetName(current)
+	target, found := targets[name]
+	if !found {
+		return nil, fmt.Errorf("target %s not found", name)
+	}
+
+	settings := settingsFromMap(target)
+	credentials, err := smCfg.targetCredentials(name, target)
+	if err != nil {
+		return nil, err
+	}
+	if credentials != nil {
+		settings.AccessToken = credentials.AccessToken
+		settings.RefreshToken = credentials.RefreshToken
+		settings.ClientSecret = credentials.ClientSecret
+	}
+	if err := settings.Validate(); err != nil {
+		return settings, err
+	}
+
+	return settings, nil
+}
+
+// ListTargets implements listing of all configured targets. The returned settings do not contain secrets.
+func (smCfg *smConfiguration) ListTargets() (map[string]*Settings, string, error) {
+	targets, current, err := smCfg.readTargets()
+	if err != nil {
+		return nil, "", err
+	}
+
+	result := make(map[string]*Settings, len(targets))
+	for name, target := range targets {
+		result[name] = settingsFromMap(target)
+	}
+	return result, current, nil
+}
+
+// UseTarget implements switching of the current target
+func (smCfg *smConfiguration) UseTarget(name string) error {
+	targets, _, err := smCfg.readTargets()
+	if err != nil {
+		return err
+	}
+	if _, found := targets[name]; !found {
+		return fmt.Errorf("target %s not found", name)
+	}
+	return smCfg.write(targets, name)
+}
+
+// DeleteTarget implements deletion of a target
+func (smCfg *smConfiguration) DeleteTarget(name string) error {
+	targets, current, err := smCfg.readTargets()
+	if err != nil {
+		return err
+	}
+	if _, found := targets[name]; !found {
+		return fmt.Errorf("target %s not found", name)
+	}
+
+	if err := smCfg.credentials.Delete(name); err != nil {
+		return err
+	}
+	delete(targets, name)
+	if current == name {
+		current = ""
+	}
+	return smCfg.write(targets, current)
+}
+
+func (smCfg *smConfiguration) targetName(current string) string {
+	if smCfg.selectedTarget != "" {
+		return smCfg.selectedTarget
+	}
+	if current != "" {
+		return current
+	}
+	return DefaultTargetName
+}
+
+// readTargets reads all targets from the config file. Config files written before targets were introduced
+// hold the settings on top level, in which case they are returned as the default target.
+func (smCfg *smConfiguration) readTargets() (map[string]map[string]interface{}, string, error) {
+	targets := make(map[string]map[string]interface{})
+	if err := smCfg.viperEnv.ReadInConfig(); err != nil {
+		return targets, "", err
+	}
+
+	if !smCfg.viperEnv.IsSet(targetsKey) && smCfg.viperEnv.IsSet("url") {
+		targets[DefaultTargetName] = smCfg.viperEnv.AllSettings()
+		return targets, DefaultTargetName, nil
+	}
+
+	for name, target := range smCfg.viperEnv.GetStringMap(targetsKey) {
+		targets[name] = cast.ToStringMap(target)
+	}
+	return targets, smCfg.viperEnv.GetString(currentTargetKey), nil
+}
+
+// targetCredentials returns the secrets of the target from the credential store. Secrets which are still written in plain text
+// to the config file are used if a store keeping secrets in memory only has none.
+func (smCfg *smConfiguration) targetCredentials(name string, target map[string]interface{}) (*Credentials, error) {
+	credentials, err := smCfg.credentials.Get(name)
+	if err != nil || credentials != nil || isPersistent(smCfg.credentials) {
+		return credentials, err
+	}
+	if plain := plainCredentials(target); !plain.IsEmpty() {
+		return plain, nil
+	}
+	return nil, nil
+}
+
+// migrateCredentials moves secrets written in plain text to the config file by older versions to the credential store.
+// It returns true if the targets have changed and have to be written. The secrets are kept in the config file if the store
+// keeps them in memory only, as they would be lost after the command.
+func (smCfg *smConfiguration) migrateCredentials(targets map[string]map[string]interface{}) (bool, error) {
+	if !isPersistent(smCfg.credentials) {
+		return false, nil
+	}
+	migrated := false
+	for name, target := range targets {
+		credentials := plainCredentials(target)
+		if credentials.IsEmpty() {
+			continue
+		}
+		if err := smCfg.credentials.Set(name, credentials); err != nil {
+			return false, err
+		}
+		for _, key := range credentialKeys {
+			delete(target, key)
+		}
+		migrated = true
+	}
+	return migrated, nil
+}
+
+func plainCredentials(target map[string]interface{}) *Credentials {
+	return &Credentials{
+		AccessToken:  cast.ToString(target["access_token"]),
+		RefreshToken: cast.ToString(target["refresh_token"]),
+		ClientSecret: cast.ToString(target["client_secret"]),
+	}
+}
+
+func (smCfg *smConfiguration) write(targets map[string]map[string]interface{}, current string) error {
+	content, err := json.Marshal(map[string]interface{}{
+		currentTargetKey: current,
+		targetsKey:       targets,
+	})
+	if err != nil {
+		return err
+	}
 
 	cfgFile := smCfg.viperEnv.ConfigFileUsed()
+	// ReadConfig replaces the whole configuration, so settings of deleted targets and
+	// top level settings of old config files are not written back
+	if err := smCfg.viperEnv.ReadConfig(bytes.NewReader(content)); err != nil {
+		return fmt.Errorf("could not save config file %s: %s", cfgFile, err)
+	}
 	if err := smCfg.viperEnv.WriteConfig(); err != nil {
 		return fmt.Errorf("could not save config file %s: %s", cfgFile, err)
 	}
@@ -119,33 +354,42 @@ func (smCfg *smConfiguration) Save(settings *Settings) error {
 	return nil
 }
 
-// Load implements configuration load
-func (smCfg *smConfiguration) Load() (*Settings, error) {
-	if err := smCfg.viperEnv.ReadInConfig(); err != nil {
-		return nil, err
-	}
+func settingsToMap(settings *Settings) map[string]interface{} {
+	return map[string]interface{}{
+		"url":              settings.URL,
+		"user":             settings.User,
+		"ssl_disabled":     settings.SSLDisabled,
+		"token_basic_auth": settings.TokenBasicAuth,
 
-	settings := &Settings{}
+		"expiry": settings.ExpiresIn.Format(time.RFC1123Z),
 
-	if err := smCfg.viperEnv.Unmarshal(&settings); err != nil {
-		return nil, err
+		"client_id":  settings.ClientID,
+		"issuer_url": settings.IssuerURL,
+		"token_url":  settings.TokenEndpoint,
+		"auth_url":   settings.AuthorizationEndpoint,
+		"auth_flow":  string(settings.AuthFlow),
 	}
+}
 
-	settings.SSLDisabled = smCfg.viperEnv.Get("ssl_disabled").(bool)
-	settings.TokenBasicAuth = smCfg.viperEnv.Get("token_basic_auth").(bool)
-	settings.AccessToken = smCfg.viperEnv.Get("access_token").(string)
-	settings.RefreshToken = smCfg.viperEnv.Get("refresh_token").(string)
-	settings.ExpiresIn, _ = time.Parse(time.RFC1123Z, smCfg.viperEnv.Get("expiry").(string))
-	settings.TokenEndpoint = smCfg.viperEnv.Get("token_url").(string)
-	settings.AuthorizationEndpoint = smCfg.viperEnv.Get("auth_url").(string)
-	settings.AuthFlow = auth.Flow(smCfg.viperEnv.Get("auth_flow").(string))
-	settings.IssuerURL = smCfg.viperEnv.Get("issuer_url").(string)
-	settings.ClientID = smCfg.viperEnv.Get("client_id").(string)
-	settings.ClientSecret = smCfg.viperEnv.Get("client_secret").(string)
+func settingsFromMap(target map[string]interface{}) *Settings {
+	settings := &Settings{
+		URL:         cast.ToString(target["url"]),
+		User:        cast.ToString(target["user"]),
+		SSLDisabled: cast.ToBool(target["ssl_disabled"]),
+		// RFC 6749 section 2.3.1
+		TokenBasicAuth: true,
 
-	if err := settings.Validate(); err != nil {
-		return settings, err
+		ClientID:              cast.ToString(target["client_id"]),
+		IssuerURL:             cast.ToString(target["issuer_url"]),
+		TokenEndpoint:         cast.ToString(target["token_url"]),
+		AuthorizationEndpoint: cast.ToString(target["auth_url"]),
+		AuthFlow:              auth.Flow(cast.ToString(target["auth_flow"])),
+	}
+	if tokenBasicAuth, found := target["token_basic_auth"]; found {
+		settings.TokenBasicAuth = cast.ToBool(tokenBasicAuth)
 	}
 
-	return settings, nil
+	settings.ExpiresIn, _ = time.Parse(time.RFC1123Z, cast.ToString(target["expiry"]))
+
+	return settings
 }
@@ -1,6 +1,7 @@
 package configuration
 
 import (
+	"encoding/json"
 	"path/filepath"
 	"time"
 
@@ -37,7 +38,8 @@ var _ = Describe("Configuration test", func() {
 				fs := afero.NewMemMapFs()
 				viperEnv := viper.New()
 				viperEnv.SetFs(fs)
-				configuration, err := NewSMConfiguration(viperEnv, configPath)
+				credentialStore := NewMemoryCredentialStore()
+				configuration, err := NewSMConfiguration(viperEnv, configPath, credentialStore)
 
 				timeNow, _ := time.Parse(time.RFC1123Z, time.Now().Format(time.RFC1123Z))
 				settings := Settings{
@@ -54,7 +56,7 @@ var _ = Describe("Configuration test", func() {
 
 				viperEnv = viper.New()
 				viperEnv.SetFs(fs)
-				configuration, err = NewSMConfiguration(viperEnv, configPath)
+				configuration, err = NewSMConfiguration(viperEnv, configPath, credentialStore)
 				clientConfig, errLoad := configuration.Load()
 
 				Expect(err).ShouldNot(HaveOccurred())
@@ -64,4 +66,209 @@ var _ = Describe("Configuration test", func() {
 		})
 	})
 
+	Describe("Targets", func() {
+		var fs afero.Fs
+		var configPath string
+		var configuration Configuration
+		var credentialStore CredentialStore
+
+		newConfiguration := func() Configuration {
+			viperEnv := viper.New()
+			viperEnv.SetFs(fs)
+			configuration, err := NewSMConfiguration(viperEnv, configPath, credentialStore)
+			Expect(err).ShouldNot(HaveOccurred())
+			return configuration
+		}
+
+		saveTarget := func(name string, settings *Settings) {
+			configuration := newConfiguration()
+			Expect(configuration.SelectTarget(name)).To(Succeed())
+			Expect(configuration.Save(settings)).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			// Save restricts the access rights of the config file, which requires a real file system
+			fs = afero.NewOsFs()
+			dir, err := afero.TempDir(fs, "", "smctl")
+			Expect(err).ShouldNot(HaveOccurred())
+			configPath = filepath.Join(dir, "config.json")
+			credentialStore = NewFileCredentialStore(filepath.Join(dir, credentialsFileName), func() (string, error) { return "secret", nil })
+			configuration = newConfiguration()
+		})
+
+		AfterEach(func() {
+			Expect(fs.RemoveAll(filepath.Dir(configPath))).To(Succeed())
+		})
+
+		Context("when saving without selected target", func() {
+			It("should save to the default target and make it current", func() {
+				Expect(configuration.Save(&Settings{URL: "http://sm.com", User: "admin"})).To(Succeed())
+
+				targets, current, err := newConfiguration().ListTargets()
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(current).To(Equal(DefaultTargetName))
+				Expect(targets).To(HaveKey(DefaultTargetName))
+			})
+		})
+
+		Context("when several targets are saved", func() {
+			BeforeEach(func() {
+				saveTarget("dev", &Settings{URL: "http://dev-sm.com", User: "dev-user"})
+				saveTarget("prod", &Settings{URL: "http://prod-sm.com", User: "prod-user"})
+			})
+
+			It("should keep the first saved target as current", func() {
+				settings, err := newConfiguration().Load()
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(settings.URL).To(Equal("http://dev-sm.com"))
+			})
+
+			It("should load the selected target", func() {
+				configuration := newConfiguration()
+				Expect(configuration.SelectTarget("prod")).To(Succeed())
+
+				settings, err := configuration.Load()
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(settings.User).To(Equal("prod-user"))
+			})
+
+			It("should switch the current target", func() {
+				Expect(configuration.UseTarget("prod")).To(Succeed())
+
+				settings, err := newConfiguration().Load()
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(settings.URL).To(Equal("http://prod-sm.com"))
+			})
+
+			It("should delete a target", func() {
+				Expect(configuration.DeleteTarget("dev")).To(Succeed())
+				Expect(credentialStore.Get("dev")).To(BeNil())
+
+				targets, current, err := newConfiguration().ListTargets()
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(targets).To(HaveLen(1))
+				Expect(targets).To(HaveKey("prod"))
+				Expect(current).To(BeEmpty())
+			})
+
+			It("should fail to use a missing target", func() {
+				Expect(configuration.UseTarget("canary")).To(MatchError("target canary not found"))
+			})
+
+			It("should fail to load a missing selected target", func() {
+				Expect(configuration.SelectTarget("canary")).To(Succeed())
+
+				_, err := configuration.Load()
+				Expect(err).To(MatchError("target canary not found"))
+			})
+		})
+
+		Context("when target name is invalid", func() {
+			It("should return error", func() {
+				Expect(configuration.SelectTarget("Prod.EU")).Should(HaveOccurred())
+			})
+		})
+
+		Context("when config file was written before targets were introduced", func() {
+			BeforeEach(func() {
+				legacyConfig := `{"url": "http://sm.com", "user": "admin", "access_token": "token", "auth_flow": "password-grant", "token_basic_auth": false}`
+				Expect(afero.WriteFile(fs, configPath, []byte(legacyConfig), 0600)).To(Succeed())
+			})
+
+			It("should load it as the default target", func() {
+				settings, err := configuration.Load()
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(settings.URL).To(Equal("http://sm.com"))
+				Expect(settings.AccessToken).To(Equal("token"))
+				Expect(settings.TokenBasicAuth).To(BeFalse())
+			})
+
+			It("should migrate it to a target on save", func() {
+				Expect(configuration.SelectTarget("prod")).To(Succeed())
+				Expect(configuration.Save(&Settings{URL: "http://prod-sm.com", User: "prod-user"})).To(Succeed())
+
+				content, err := afero.ReadFile(fs, configPath)
+				Expect(err).ShouldNot(HaveOccurred())
+				var savedConfig map[string]interface{}
+				Expect(json.Unmarshal(content, &savedConfig)).To(Succeed())
+				Expect(savedConfig).To(ConsistOf(DefaultTargetName, HaveKey("prod")))
+
+				targets, current, err := newConfiguration().ListTargets()
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(current).To(Equal(DefaultTargetName))
+				Expect(targets).To(HaveLen(2))
+				Expect(string(content)).ToNot(ContainSubstring(`"access_token"`))
+				Expect(credentialStore.Get(DefaultTargetName)).To(Equal(&Credentials{AccessToken: "token"}))
+			})
+		})
+
+		Context("when saving secrets", func() {
+			It("should keep them in the credential store only", func() {
+				saveTarget("prod", &Settings{
+					URL:          "http://prod-sm.com",
+					User:         "prod-user",
+					ClientSecret: "client-secret",
+					Token: auth.Token{
+						AccessToken:  "access-token",
+						RefreshToken: "refresh-token",
+					},
+				})
+
+				content, err := afero.ReadFile(fs, configPath)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(string(content)).ToNot(ContainSubstring("access-token"))
+				Expect(string(content)).ToNot(ContainSubstring("refresh-token"))
+				Expect(string(content)).ToNot(ContainSubstring("client-secret"))
+
+				settings, err := newConfiguration().Load()
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(settings.AccessToken).To(Equal("access-token"))
+				Expect(settings.RefreshToken).To(Equal("refresh-token"))
+				Expect(settings.ClientSecret).To(Equal("client-secret"))
+			})
+		})
+
+		Context("when config file contains plain text secrets", func() {
+			BeforeEach(func() {
+				plainConfig := `{"current_target": "dev", "targets": {"dev": {"url": "http://dev-sm.com", "user": "dev-user", "access_token": "access-token", "client_secret": "client-secret"}}}`
+				Expect(afero.WriteFile(fs, configPath, []byte(plainConfig), 0600)).To(Succeed())
+			})
+
+			It("should migrate them to the credential store on load", func() {
+				settings, err := configuration.Load()
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(settings.AccessToken).To(Equal("access-token"))
+				Expect(settings.ClientSecret).To(Equal("client-secret"))
+
+				content, err := afero.ReadFile(fs, configPath)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(string(content)).ToNot(ContainSubstring("access-token"))
+				Expect(string(content)).ToNot(ContainSubstring("client-secret"))
+				Expect(credentialStore.Get("dev")).To(Equal(&Credentials{AccessToken: "access-token", ClientSecret: "client-secret"}))
+			})
+
+			Context("and the credential store keeps secrets in memory only", func() {
+				BeforeEach(func() {
+					credentialStore = NewMemoryCredentialStore()
+					configuration = newConfiguration()
+				})
+
+				It("should keep them in the config file", func() {
+					settings, err := configuration.Load()
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(settings.AccessToken).To(Equal("access-token"))
+					Expect(settings.ClientSecret).To(Equal("client-secret"))
+
+					content, err := afero.ReadFile(fs, configPath)
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(string(content)).To(ContainSubstring("access-token"))
+					Expect(string(content)).To(ContainSubstring("client-secret"))
+
+					settings, err = newConfiguration().Load()
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(settings.AccessToken).To(Equal("access-token"))
+				})
+			})
+		})
+	})
 })
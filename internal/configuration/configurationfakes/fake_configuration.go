@@ -8,6 +8,31 @@ import (
 )
 
 type FakeConfiguration struct {
+	DeleteTargetStub        func(string) error
+	deleteTargetMutex       sync.RWMutex
+	deleteTargetArgsForCall []struct {
+		arg1 string
+	}
+	deleteTargetReturns struct {
+		result1 error
+	}
+	deleteTargetReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ListTargetsStub        func() (map[string]*configuration.Settings, string, error)
+	listTargetsMutex       sync.RWMutex
+	listTargetsArgsForCall []struct {
+	}
+	listTargetsReturns struct {
+		result1 map[string]*configuration.Settings
+		result2 string
+		result3 error
+	}
+	listTargetsReturnsOnCall map[int]struct {
+		result1 map[string]*configuration.Settings
+		result2 string
+		result3 error
+	}
 	LoadStub        func() (*configuration.Settings, error)
 	loadMutex       sync.RWMutex
 	loadArgsForCall []struct {
@@ -31,24 +56,167 @@ type FakeConfiguration struct {
 	saveReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SelectTargetStub        func(string) error
+	selectTargetMutex       sync.RWMutex
+	selectTargetArgsForCall []struct {
+		arg1 string
+	}
+	selectTargetReturns struct {
+		result1 error
+	}
+	selectTargetReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UseTargetStub        func(string) error
+	useTargetMutex       sync.RWMutex
+	useTargetArgsForCall []struct {
+		arg1 string
+	}
+	useTargetReturns struct {
+		result1 error
+	}
+	useTargetReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeConfiguration) DeleteTarget(arg1 string) error {
+	fake.deleteTargetMutex.Lock()
+	ret, specificReturn := fake.deleteTargetReturnsOnCall[len(fake.deleteTargetArgsForCall)]
+	fake.deleteTargetArgsForCall = append(fake.deleteTargetArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.DeleteTargetStub
+	fakeReturns := fake.deleteTargetReturns
+	fake.recordInvocation("DeleteTarget", []interface{}{arg1})
+	fake.deleteTargetMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfiguration) DeleteTargetCallCount() int {
+	fake.deleteTargetMutex.RLock()
+	defer fake.deleteTargetMutex.RUnlock()
+	return len(fake.deleteTargetArgsForCall)
+}
+
+func (fake *FakeConfiguration) DeleteTargetCalls(stub func(string) error) {
+	fake.deleteTargetMutex.Lock()
+	defer fake.deleteTargetMutex.Unlock()
+	fake.DeleteTargetStub = stub
+}
+
+func (fake *FakeConfiguration) DeleteTargetArgsForCall(i int) string {
+	fake.deleteTargetMutex.RLock()
+	defer fake.deleteTargetMutex.RUnlock()
+	argsForCall := fake.deleteTargetArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfiguration) DeleteTargetReturns(result1 error) {
+	fake.deleteTargetMutex.Lock()
+	defer fake.deleteTargetMutex.Unlock()
+	fake.DeleteTargetStub = nil
+	fake.deleteTargetReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfiguration) DeleteTargetReturnsOnCall(i int, result1 error) {
+	fake.deleteTargetMutex.Lock()
+	defer fake.deleteTargetMutex.Unlock()
+	fake.DeleteTargetStub = nil
+	if fake.deleteTargetReturnsOnCall == nil {
+		fake.deleteTargetReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteTargetReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfiguration) ListTargets() (map[string]*configuration.Settings, string, error) {
+	fake.listTargetsMutex.Lock()
+	ret, specificReturn := fake.listTargetsReturnsOnCall[len(fake.listTargetsArgsForCall)]
+	fake.listTargetsArgsForCall = append(fake.listTargetsArgsForCall, struct {
+	}{})
+	stub := fake.ListTargetsStub
+	fakeReturns := fake.listTargetsReturns
+	fake.recordInvocation("ListTargets", []interface{}{})
+	fake.listTargetsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeConfiguration) ListTargetsCallCount() int {
+	fake.listTargetsMutex.RLock()
+	defer fake.listTargetsMutex.RUnlock()
+	return len(fake.listTargetsArgsForCall)
+}
+
+func (fake *FakeConfiguration) ListTargetsCalls(stub func() (map[string]*configuration.Settings, string, error)) {
+	fake.listTargetsMutex.Lock()
+	defer fake.listTargetsMutex.Unlock()
+	fake.ListTargetsStub = stub
+}
+
+func (fake *FakeConfiguration) ListTargetsReturns(result1 map[string]*configuration.Settings, result2 string, result3 error) {
+	fake.listTargetsMutex.Lock()
+	defer fake.listTargetsMutex.Unlock()
+	fake.ListTargetsStub = nil
+	fake.listTargetsReturns = struct {
+		result1 map[string]*configuration.Settings
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeConfiguration) ListTargetsReturnsOnCall(i int, result1 map[string]*configuration.Settings, result2 string, result3 error) {
+	fake.listTargetsMutex.Lock()
+	defer fake.listTargetsMutex.Unlock()
+	fake.ListTargetsStub = nil
+	if fake.listTargetsReturnsOnCall == nil {
+		fake.listTargetsReturnsOnCall = make(map[int]struct {
+			result1 map[string]*configuration.Settings
+			result2 string
+			result3 error
+		})
+	}
+	fake.listTargetsReturnsOnCall[i] = struct {
+		result1 map[string]*configuration.Settings
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeConfiguration) Load() (*configuration.Settings, error) {
 	fake.loadMutex.Lock()
 	ret, specificReturn := fake.loadReturnsOnCall[len(fake.loadArgsForCall)]
 	fake.loadArgsForCall = append(fake.loadArgsForCall, struct {
 	}{})
+	stub := fake.LoadStub
+	fakeReturns := fake.loadReturns
 	fake.recordInvocation("Load", []interface{}{})
 	fake.loadMutex.Unlock()
-	if fake.LoadStub != nil {
-		return fake.LoadStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.loadReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -96,15 +264,16 @@ func (fake *FakeConfiguration) Save(arg1 *configuration.Settings) error {
 	fake.saveArgsForCall = append(fake.saveArgsForCall, struct {
 		arg1 *configuration.Settings
 	}{arg1})
+	stub := fake.SaveStub
+	fakeReturns := fake.saveReturns
 	fake.recordInvocation("Save", []interface{}{arg1})
 	fake.saveMutex.Unlock()
-	if fake.SaveStub != nil {
-		return fake.SaveStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.saveReturns
 	return fakeReturns.result1
 }
 
@@ -150,13 +319,143 @@ func (fake *FakeConfiguration) SaveReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeConfiguration) SelectTarget(arg1 string) error {
+	fake.selectTargetMutex.Lock()
+	ret, specificReturn := fake.selectTargetReturnsOnCall[len(fake.selectTargetArgsForCall)]
+	fake.selectTargetArgsForCall = append(fake.selectTargetArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.SelectTargetStub
+	fakeReturns := fake.selectTargetReturns
+	fake.recordInvocation("SelectTarget", []interface{}{arg1})
+	fake.selectTargetMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfiguration) SelectTargetCallCount() int {
+	fake.selectTargetMutex.RLock()
+	defer fake.selectTargetMutex.RUnlock()
+	return len(fake.selectTargetArgsForCall)
+}
+
+func (fake *FakeConfiguration) SelectTargetCalls(stub func(string) error) {
+	fake.selectTargetMutex.Lock()
+	defer fake.selectTargetMutex.Unlock()
+	fake.SelectTargetStub = stub
+}
+
+func (fake *FakeConfiguration) SelectTargetArgsForCall(i int) string {
+	fake.selectTargetMutex.RLock()
+	defer fake.selectTargetMutex.RUnlock()
+	argsForCall := fake.selectTargetArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfiguration) SelectTargetReturns(result1 error) {
+	fake.selectTargetMutex.Lock()
+	defer fake.selectTargetMutex.Unlock()
+	fake.SelectTargetStub = nil
+	fake.selectTargetReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfiguration) SelectTargetReturnsOnCall(i int, result1 error) {
+	fake.selectTargetMutex.Lock()
+	defer fake.selectTargetMutex.Unlock()
+	fake.SelectTargetStub = nil
+	if fake.selectTargetReturnsOnCall == nil {
+		fake.selectTargetReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.selectTargetReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfiguration) UseTarget(arg1 string) error {
+	fake.useTargetMutex.Lock()
+	ret, specificReturn := fake.useTargetReturnsOnCall[len(fake.useTargetArgsForCall)]
+	fake.useTargetArgsForCall = append(fake.useTargetArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.UseTargetStub
+	fakeReturns := fake.useTargetReturns
+	fake.recordInvocation("UseTarget", []interface{}{arg1})
+	fake.useTargetMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfiguration) UseTargetCallCount() int {
+	fake.useTargetMutex.RLock()
+	defer fake.useTargetMutex.RUnlock()
+	return len(fake.useTargetArgsForCall)
+}
+
+func (fake *FakeConfiguration) UseTargetCalls(stub func(string) error) {
+	fake.useTargetMutex.Lock()
+	defer fake.useTargetMutex.Unlock()
+	fake.UseTargetStub = stub
+}
+
+func (fake *FakeConfiguration) UseTargetArgsForCall(i int) string {
+	fake.useTargetMutex.RLock()
+	defer fake.useTargetMutex.RUnlock()
+	argsForCall := fake.useTargetArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfiguration) UseTargetReturns(result1 error) {
+	fake.useTargetMutex.Lock()
+	defer fake.useTargetMutex.Unlock()
+	fake.UseTargetStub = nil
+	fake.useTargetReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfiguration) UseTargetReturnsOnCall(i int, result1 error) {
+	fake.useTargetMutex.Lock()
+	defer fake.useTargetMutex.Unlock()
+	fake.UseTargetStub = nil
+	if fake.useTargetReturnsOnCall == nil {
+		fake.useTargetReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.useTargetReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeConfiguration) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.deleteTargetMutex.RLock()
+	defer fake.deleteTargetMutex.RUnlock()
+	fake.listTargetsMutex.RLock()
+	defer fake.listTargetsMutex.RUnlock()
 	fake.loadMutex.RLock()
 	defer fake.loadMutex.RUnlock()
 	fake.saveMutex.RLock()
 	defer fake.saveMutex.RUnlock()
+	fake.selectTargetMutex.RLock()
+	defer fake.selectTargetMutex.RUnlock()
+	fake.useTargetMutex.RLock()
+	defer fake.useTargetMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
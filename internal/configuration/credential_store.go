@@ -0,0 +1,307 @@
+/*
+ * Copyright 2018 The Service Manager Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package configuration
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
+
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+const (
+	// CredentialStoreEnvVar selects the credential store: "file" (default) or "memory"
+	CredentialStoreEnvVar = "SM_CREDENTIAL_STORE"
+	// PassphraseEnvVar holds the passphrase of the file credential store. If not set, the passphrase is prompted for on a terminal.
+	PassphraseEnvVar = "SM_CREDENTIAL_PASSPHRASE"
+
+	// AccessTokenEnvVar, RefreshTokenEnvVar and ClientSecretEnvVar provide the secrets to the memory credential store
+	AccessTokenEnvVar  = "SM_ACCESS_TOKEN"
+	RefreshTokenEnvVar = "SM_REFRESH_TOKEN"
+	ClientSecretEnvVar = "SM_CLIENT_SECRET"
+
+	fileCredentialStore   = "file"
+	memoryCredentialStore = "memory"
+
+	credentialsFileName = "credentials.enc"
+)
+
+// Credentials contains the secrets of a target, which are not written to the CLI config file
+type Credentials struct {
+	AccessToken  string `json:"access_token,omitempty"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	ClientSecret string `json:"client_secret,omitempty"`
+}
+
+// IsEmpty returns true if no secret is set
+func (c *Credentials) IsEmpty() bool {
+	return c.AccessToken == "" && c.RefreshToken == "" && c.ClientSecret == ""
+}
+
+// CredentialStore should be implemented for storing the secrets of targets
+//go:generate counterfeiter . CredentialStore
+type CredentialStore interface {
+	// Get returns the credentials of the target or nil if there are none
+	Get(target string) (*Credentials, error)
+	// Set stores the credentials of the target
+	Set(target string, credentials *Credentials) error
+	// Delete deletes the credentials of the target
+	Delete(target string) error
+}
+
+// newCredentialStoreFromEnv returns the credential store selected by the SM_CREDENTIAL_STORE environment variable
+func newCredentialStoreFromEnv(cfgFile string) (CredentialStore, error) {
+	switch store := os.Getenv(CredentialStoreEnvVar); store {
+	case "", fileCredentialStore:
+		path := filepath.Join(filepath.Dir(cfgFile), credentialsFileName)
+		return NewFileCredentialStore(path, readPassphrase), nil
+	case memoryCredentialStore:
+		return NewMemoryCredentialStore(), nil
+	default:
+		return nil, fmt.Errorf("unknown credential store %q set in %s: use %q or %q", store, CredentialStoreEnvVar, fileCredentialStore, memoryCredentialStore)
+	}
+}
+
+// isPersistent returns false for stores which keep the secrets in memory only, for the current command
+func isPersistent(store CredentialStore) bool {
+	_, inMemory := store.(*memoryStore)
+	return !inMemory
+}
+
+func readPassphrase() (string, error) {
+	if passphrase := os.Getenv(PassphraseEnvVar); passphrase != "" {
+		return passphrase, nil
+	}
+	if !terminal.IsTerminal(int(syscall.Stdin)) {
+		return "", fmt.Errorf("the credentials passphrase can't be prompted for without a terminal, set it in %s", PassphraseEnvVar)
+	}
+
+	fmt.Fprint(os.Stderr, "Credentials passphrase: ")
+	passphrase, err := terminal.ReadPassword(int(syscall.Stdin))
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", fmt.Errorf("could not read credentials passphrase, set it in %s: %s", PassphraseEnvVar, err)
+	}
+	if len(passphrase) == 0 {
+		return "", errors.New("credentials passphrase must not be empty")
+	}
+	return string(passphrase), nil
+}
+
+type memoryStore struct {
+	mutex       sync.Mutex
+	credentials map[string]*Credentials
+}
+
+// NewMemoryCredentialStore returns a credential store which keeps the credentials in memory only.
+// Targets without credentials get the ones set in the SM_ACCESS_TOKEN, SM_REFRESH_TOKEN and SM_CLIENT_SECRET environment variables.
+func NewMemoryCredentialStore() CredentialStore {
+	return &memoryStore{credentials: make(map[string]*Credentials)}
+}
+
+func (s *memoryStore) Get(target string) (*Credentials, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if credentials, found := s.credentials[target]; found {
+		result := *credentials
+		return &result, nil
+	}
+
+	credentials := &Credentials{
+		AccessToken:  os.Getenv(AccessTokenEnvVar),
+		RefreshToken: os.Getenv(RefreshTokenEnvVar),
+		ClientSecret: os.Getenv(ClientSecretEnvVar),
+	}
+	if credentials.IsEmpty() {
+		return nil, nil
+	}
+	return credentials, nil
+}
+
+func (s *memoryStore) Set(target string, credentials *Credentials) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	stored := *credentials
+	s.credentials[target] = &stored
+	return nil
+}
+
+func (s *memoryStore) Delete(target string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.credentials, target)
+	return nil
+}
+
+// encryptedFile is the content of the credentials file. Data holds the JSON encoded credentials
+// of all targets encrypted with AES-GCM using a key derived from the passphrase with scrypt.
+type encryptedFile struct {
+	Salt  []byte `json:"salt"`
+	Nonce []byte `json:"nonce"`
+	Data  []byte `json:"data"`
+}
+
+type fileStore struct {
+	path       string
+	passphrase func() (string, error)
+
+	salt []byte
+	key  []byte
+}
+
+// NewFileCredentialStore returns a credential store which keeps the credentials in a file encrypted with a passphrase.
+// passphrase is called only once and only if the file has to be decrypted or encrypted.
+func NewFileCredentialStore(path string, passphrase func() (string, error)) CredentialStore {
+	return &fileStore{path: path, passphrase: passphrase}
+}
+
+func (s *fileStore) Get(target string) (*Credentials, error) {
+	credentials, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+	return credentials[target], nil
+}
+
+func (s *fileStore) Set(target string, credentials *Credentials) error {
+	all, err := s.read()
+	if err != nil {
+		return err
+	}
+	stored := *credentials
+	all[target] = &stored
+	return s.write(all)
+}
+
+func (s *fileStore) Delete(target string) error {
+	all, err := s.read()
+	if err != nil {
+		return err
+	}
+	if _, found := all[target]; !found {
+		return nil
+	}
+	delete(all, target)
+	return s.write(all)
+}
+
+func (s *fileStore) read() (map[string]*Credentials, error) {
+	credentials := make(map[string]*Credentials)
+	content, err := os.ReadFile(s.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return credentials, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read credentials file %s: %s", s.path, err)
+	}
+
+	var file encryptedFile
+	if err := json.Unmarshal(content, &file); err != nil {
+		return nil, fmt.Errorf("could not read credentials file %s: %s", s.path, err)
+	}
+	gcm, err := s.cipher(file.Salt)
+	if err != nil {
+		return nil, err
+	}
+	data, err := gcm.Open(nil, file.Nonce, file.Data, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt credentials file %s: wrong passphrase", s.path)
+	}
+	if err := json.Unmarshal(data, &credentials); err != nil {
+		return nil, fmt.Errorf("could not read credentials file %s: %s", s.path, err)
+	}
+	return credentials, nil
+}
+
+func (s *fileStore) write(credentials map[string]*Credentials) error {
+	data, err := json.Marshal(credentials)
+	if err != nil {
+		return err
+	}
+
+	salt := s.salt
+	if salt == nil {
+		if salt, err = randomBytes(32); err != nil {
+			return err
+		}
+	}
+	gcm, err := s.cipher(salt)
+	if err != nil {
+		return err
+	}
+	nonce, err := randomBytes(gcm.NonceSize())
+	if err != nil {
+		return err
+	}
+
+	content, err := json.Marshal(&encryptedFile{
+		Salt:  salt,
+		Nonce: nonce,
+		Data:  gcm.Seal(nil, nonce, data, nil),
+	})
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(s.path, content, 0600); err != nil {
+		return fmt.Errorf("could not save credentials file %s: %s", s.path, err)
+	}
+	return nil
+}
+
+// cipher returns AES-GCM with the key derived from the passphrase and salt. The key of the last used salt is cached.
+func (s *fileStore) cipher(salt []byte) (cipher.AEAD, error) {
+	if s.key == nil || string(s.salt) != string(salt) {
+		passphrase, err := s.passphrase()
+		if err != nil {
+			return nil, err
+		}
+		s.passphrase = func() (string, error) { return passphrase, nil }
+
+		key, err := scrypt.Key([]byte(passphrase), salt, 1<<15, 8, 1, 32)
+		if err != nil {
+			return nil, err
+		}
+		s.salt, s.key = salt, key
+	}
+
+	block, err := aes.NewCipher(s.key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func randomBytes(length int) ([]byte, error) {
+	b := make([]byte, length)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
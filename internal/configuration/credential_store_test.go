@@ -0,0 +1,133 @@
+package configuration
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Credential store test", func() {
+	credentials := &Credentials{
+		AccessToken:  "access-token",
+		RefreshToken: "refresh-token",
+		ClientSecret: "client-secret",
+	}
+
+	Describe("File credential store", func() {
+		var dir, path string
+		var passphraseCalls int
+
+		passphrase := func(value string) func() (string, error) {
+			return func() (string, error) {
+				passphraseCalls++
+				return value, nil
+			}
+		}
+
+		BeforeEach(func() {
+			var err error
+			dir, err = os.MkdirTemp("", "smctl")
+			Expect(err).ShouldNot(HaveOccurred())
+			path = filepath.Join(dir, credentialsFileName)
+			passphraseCalls = 0
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(dir)).To(Succeed())
+		})
+
+		It("should store the credentials encrypted", func() {
+			Expect(NewFileCredentialStore(path, passphrase("secret")).Set("dev", credentials)).To(Succeed())
+
+			content, err := os.ReadFile(path)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(content)).ToNot(ContainSubstring("access-token"))
+			info, err := os.Stat(path)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+
+			store := NewFileCredentialStore(path, passphrase("secret"))
+			Expect(store.Get("dev")).To(Equal(credentials))
+			Expect(store.Get("prod")).To(BeNil())
+			Expect(passphraseCalls).To(Equal(2))
+		})
+
+		It("should not ask for the passphrase when there are no credentials", func() {
+			Expect(NewFileCredentialStore(path, passphrase("secret")).Get("dev")).To(BeNil())
+			Expect(passphraseCalls).To(BeZero())
+		})
+
+		It("should delete the credentials of a target", func() {
+			store := NewFileCredentialStore(path, passphrase("secret"))
+			Expect(store.Set("dev", credentials)).To(Succeed())
+			Expect(store.Set("prod", credentials)).To(Succeed())
+			Expect(store.Delete("dev")).To(Succeed())
+
+			store = NewFileCredentialStore(path, passphrase("secret"))
+			Expect(store.Get("dev")).To(BeNil())
+			Expect(store.Get("prod")).To(Equal(credentials))
+		})
+
+		It("should fail with wrong passphrase", func() {
+			Expect(NewFileCredentialStore(path, passphrase("secret")).Set("dev", credentials)).To(Succeed())
+
+			_, err := NewFileCredentialStore(path, passphrase("wrong")).Get("dev")
+			Expect(err).To(MatchError(ContainSubstring("wrong passphrase")))
+		})
+
+		It("should fail when the passphrase cannot be read", func() {
+			store := NewFileCredentialStore(path, func() (string, error) {
+				return "", errors.New("no terminal")
+			})
+			Expect(store.Set("dev", credentials)).To(MatchError("no terminal"))
+		})
+	})
+
+	Describe("Memory credential store", func() {
+		AfterEach(func() {
+			os.Unsetenv(AccessTokenEnvVar)
+		})
+
+		It("should keep the credentials in memory", func() {
+			store := NewMemoryCredentialStore()
+			Expect(store.Set("dev", credentials)).To(Succeed())
+			Expect(store.Get("dev")).To(Equal(credentials))
+			Expect(NewMemoryCredentialStore().Get("dev")).To(BeNil())
+
+			Expect(store.Delete("dev")).To(Succeed())
+			Expect(store.Get("dev")).To(BeNil())
+		})
+
+		It("should return the credentials from the environment", func() {
+			os.Setenv(AccessTokenEnvVar, "env-token")
+			Expect(NewMemoryCredentialStore().Get("dev")).To(Equal(&Credentials{AccessToken: "env-token"}))
+		})
+	})
+
+	Describe("Credential store from environment", func() {
+		AfterEach(func() {
+			os.Unsetenv(CredentialStoreEnvVar)
+		})
+
+		It("should select the store", func() {
+			os.Setenv(CredentialStoreEnvVar, "memory")
+			store, err := newCredentialStoreFromEnv("/home/user/.sm/config.json")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(store).To(BeAssignableToTypeOf(&memoryStore{}))
+
+			os.Setenv(CredentialStoreEnvVar, "")
+			store, err = newCredentialStoreFromEnv("/home/user/.sm/config.json")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(store.(*fileStore).path).To(Equal(filepath.Join("/home/user/.sm", credentialsFileName)))
+		})
+
+		It("should fail for unknown store", func() {
+			os.Setenv(CredentialStoreEnvVar, "keychain")
+			_, err := newCredentialStoreFromEnv("/home/user/.sm/config.json")
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,66 @@
+/*
+ * Copyright 2018 The Service Manager Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package output
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Column is a column of the custom-columns output format
+type Column struct {
+	Header string
+	// Path selects the column value from the JSON representation of an item, e.g. .last_operation.state or .labels.tenant[0]
+	Path string
+}
+
+func parseColumns(spec string) ([]Column, error) {
+	if spec == "" {
+		return nil, fmt.Errorf("custom-columns format requires columns, e.g. custom-columns=NAME:.name,ID:.id")
+	}
+
+	var columns []Column
+	for _, part := range strings.Split(spec, ",") {
+		s := strings.SplitN(part, ":", 2)
+		if len(s) != 2 || s[0] == "" || !strings.HasPrefix(s[1], ".") {
+			return nil, fmt.Errorf("invalid custom-columns column %q: expected <HEADER>:<path>, e.g. NAME:.name", part)
+		}
+		if _, err := parseJSONPath(s[1]); err != nil {
+			return nil, fmt.Errorf("invalid custom-columns column %q: %s", part, err)
+		}
+		columns = append(columns, Column{Header: s[0], Path: s[1]})
+	}
+	return columns, nil
+}
+
+// cell returns the column value of an item decoded from JSON. Multiple values are separated by commas.
+func (c Column) cell(item interface{}) string {
+	path, err := parseJSONPath(c.Path)
+	if err != nil {
+		return "<none>"
+	}
+	values := path.evaluate(item, item)
+	if len(values) == 0 {
+		return "<none>"
+	}
+
+	formatted := make([]string, 0, len(values))
+	for _, value := range values {
+		formatted = append(formatted, formatValue(value))
+	}
+	return strings.Join(formatted, ",")
+}
@@ -0,0 +1,337 @@
+/*
+ * Copyright 2018 The Service Manager Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// jsonPathTemplate is a parsed kubectl style JSONPath template, e.g. {.items[*].id} or {range .items[*]}{.name}{"\n"}{end}.
+// It is evaluated against the JSON representation of the printed object.
+type jsonPathTemplate struct {
+	nodes []jsonPathNode
+}
+
+// jsonPathNode is either literal text, a path whose values are printed, or a range over the values of a path
+type jsonPathNode struct {
+	text    string
+	path    *jsonPath
+	body    []jsonPathNode
+	isRange bool
+}
+
+type jsonPath struct {
+	fromRoot bool
+	steps    []pathStep
+}
+
+type stepKind int
+
+const (
+	stepField stepKind = iota
+	stepIndex
+	stepWildcard
+)
+
+type pathStep struct {
+	kind  stepKind
+	field string
+	index int
+}
+
+func parseJSONPathTemplate(template string) (*jsonPathTemplate, error) {
+	if !strings.Contains(template, "{") {
+		template = "{" + template + "}"
+	}
+
+	nodes, rest, err := parseJSONPathNodes(template, false)
+	if err != nil {
+		return nil, fmt.Errorf("invalid jsonpath %q: %s", template, err)
+	}
+	if rest != "" {
+		return nil, fmt.Errorf("invalid jsonpath %q: {end} without {range}", template)
+	}
+	return &jsonPathTemplate{nodes: nodes}, nil
+}
+
+// parseJSONPathNodes parses nodes until the end of the template or, inside a range, until the matching {end}.
+// It returns the unparsed remainder, which starts right after {end}.
+func parseJSONPathNodes(template string, inRange bool) ([]jsonPathNode, string, error) {
+	var nodes []jsonPathNode
+	for template != "" {
+		start := strings.Index(template, "{")
+		if start < 0 {
+			nodes = append(nodes, jsonPathNode{text: template})
+			template = ""
+			break
+		}
+		if start > 0 {
+			nodes = append(nodes, jsonPathNode{text: template[:start]})
+		}
+		end := closingIndex(template[start:], '{', '}')
+		if end < 0 {
+			return nil, "", fmt.Errorf("unclosed action %q", template[start:])
+		}
+		action := strings.TrimSpace(template[start+1 : start+end])
+		template = template[start+end+1:]
+
+		switch {
+		case action == "end":
+			if !inRange {
+				return nil, "", fmt.Errorf("{end} without {range}")
+			}
+			return nodes, template, nil
+		case strings.HasPrefix(action, "range "):
+			path, err := parseJSONPath(strings.TrimSpace(strings.TrimPrefix(action, "range ")))
+			if err != nil {
+				return nil, "", err
+			}
+			var body []jsonPathNode
+			if body, template, err = parseJSONPathNodes(template, true); err != nil {
+				return nil, "", err
+			}
+			nodes = append(nodes, jsonPathNode{path: path, body: body, isRange: true})
+		case strings.HasPrefix(action, `"`) || strings.HasPrefix(action, "'"):
+			text, err := unquote(action)
+			if err != nil {
+				return nil, "", fmt.Errorf("invalid string literal %s", action)
+			}
+			nodes = append(nodes, jsonPathNode{text: text})
+		default:
+			path, err := parseJSONPath(action)
+			if err != nil {
+				return nil, "", err
+			}
+			nodes = append(nodes, jsonPathNode{path: path})
+		}
+	}
+	if inRange {
+		return nil, "", fmt.Errorf("{range} without {end}")
+	}
+	return nodes, "", nil
+}
+
+// parseJSONPath parses a path such as .items[0].name, $.items[*] or .labels['tenant-id']
+func parseJSONPath(expr string) (*jsonPath, error) {
+	path := &jsonPath{}
+	switch {
+	case strings.HasPrefix(expr, "$"):
+		path.fromRoot = true
+		expr = expr[1:]
+	case strings.HasPrefix(expr, "@"):
+		expr = expr[1:]
+	}
+
+	for expr != "" {
+		switch expr[0] {
+		case '.':
+			if strings.HasPrefix(expr, "..") {
+				return nil, fmt.Errorf("recursive descent is not supported")
+			}
+			expr = expr[1:]
+			end := strings.IndexAny(expr, ".[")
+			if end < 0 {
+				end = len(expr)
+			}
+			name := expr[:end]
+			expr = expr[end:]
+			if name == "" {
+				if expr == "" {
+					continue
+				}
+				return nil, fmt.Errorf("missing field name after '.'")
+			}
+			if name == "*" {
+				path.steps = append(path.steps, pathStep{kind: stepWildcard})
+			} else {
+				path.steps = append(path.steps, pathStep{kind: stepField, field: name})
+			}
+		case '[':
+			end := closingIndex(expr, '[', ']')
+			if end < 0 {
+				return nil, fmt.Errorf("unclosed bracket in %q", expr)
+			}
+			step, err := parseBracket(strings.TrimSpace(expr[1:end]))
+			if err != nil {
+				return nil, err
+			}
+			path.steps = append(path.steps, step)
+			expr = expr[end+1:]
+		default:
+			return nil, fmt.Errorf("unexpected %q, paths should start with '.', e.g. .name", expr)
+		}
+	}
+	return path, nil
+}
+
+func parseBracket(inner string) (pathStep, error) {
+	switch {
+	case inner == "*":
+		return pathStep{kind: stepWildcard}, nil
+	case strings.HasPrefix(inner, `"`) || strings.HasPrefix(inner, "'"):
+		field, err := unquote(inner)
+		if err != nil {
+			return pathStep{}, fmt.Errorf("invalid field name %s", inner)
+		}
+		return pathStep{kind: stepField, field: field}, nil
+	case strings.HasPrefix(inner, "?"):
+		return pathStep{}, fmt.Errorf("filter expressions are not supported")
+	}
+
+	index, err := strconv.Atoi(inner)
+	if err != nil {
+		return pathStep{}, fmt.Errorf("invalid index [%s]", inner)
+	}
+	return pathStep{kind: stepIndex, index: index}, nil
+}
+
+// closingIndex returns the index of the close character matching the open character at the beginning of s.
+// Quoted strings are skipped. It returns -1 if there is no matching close character.
+func closingIndex(s string, open, close byte) int {
+	depth := 0
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == open:
+			depth++
+		case c == close:
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func unquote(s string) (string, error) {
+	if strings.HasPrefix(s, "'") && strings.HasSuffix(s, "'") && len(s) > 1 {
+		s = `"` + strings.ReplaceAll(s[1:len(s)-1], `"`, `\"`) + `"`
+	}
+	return strconv.Unquote(s)
+}
+
+// execute writes the template evaluated against data
+func (t *jsonPathTemplate) execute(data interface{}) string {
+	var sb strings.Builder
+	executeJSONPathNodes(&sb, t.nodes, data, data)
+	return sb.String()
+}
+
+func executeJSONPathNodes(sb *strings.Builder, nodes []jsonPathNode, current, root interface{}) {
+	for _, node := range nodes {
+		switch {
+		case node.path == nil:
+			sb.WriteString(node.text)
+		case node.isRange:
+			for _, value := range node.path.evaluate(current, root) {
+				executeJSONPathNodes(sb, node.body, value, root)
+			}
+		default:
+			values := node.path.evaluate(current, root)
+			formatted := make([]string, 0, len(values))
+			for _, value := range values {
+				formatted = append(formatted, formatValue(value))
+			}
+			sb.WriteString(strings.Join(formatted, " "))
+		}
+	}
+}
+
+// evaluate returns the values selected by the path. Missing fields and indexes select nothing.
+func (p *jsonPath) evaluate(current, root interface{}) []interface{} {
+	values := []interface{}{current}
+	if p.fromRoot {
+		values = []interface{}{root}
+	}
+
+	for _, step := range p.steps {
+		var next []interface{}
+		for _, value := range values {
+			next = append(next, step.apply(value)...)
+		}
+		values = next
+	}
+	return values
+}
+
+func (s pathStep) apply(value interface{}) []interface{} {
+	switch s.kind {
+	case stepField:
+		if object, ok := value.(map[string]interface{}); ok {
+			if fieldValue, found := object[s.field]; found {
+				return []interface{}{fieldValue}
+			}
+		}
+	case stepIndex:
+		if list, ok := value.([]interface{}); ok {
+			index := s.index
+			if index < 0 {
+				index += len(list)
+			}
+			if index >= 0 && index < len(list) {
+				return []interface{}{list[index]}
+			}
+		}
+	case stepWildcard:
+		switch v := value.(type) {
+		case []interface{}:
+			return v
+		case map[string]interface{}:
+			keys := make([]string, 0, len(v))
+			for key := range v {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			result := make([]interface{}, 0, len(keys))
+			for _, key := range keys {
+				result = append(result, v[key])
+			}
+			return result
+		}
+	}
+	return nil
+}
+
+// toJSONValue returns the decoded JSON representation of data, as printed by the JSONPrinter
+func toJSONValue(data interface{}) (interface{}, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var value interface{}
+	decoder := json.NewDecoder(strings.NewReader(string(b)))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
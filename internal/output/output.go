@@ -17,34 +17,122 @@
 package output
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
-	"encoding/json"
+	"strings"
 
 	"github.com/Peripli/service-manager-cli/pkg/types"
+
+	yaml "gopkg.in/yaml.v3"
 )
 
-// Format is predefined type for output format
-type Format int
+// Format is the output format of a command. Some formats carry an argument, such as the columns of custom-columns.
+// The zero value is FormatText.
+type Format struct {
+	kind formatKind
+	arg  string
+}
+
+type formatKind int
 
 const (
-	// FormatText const for text output format
-	FormatText = iota
-	// FormatJSON const for json output format
-	FormatJSON
-	// FormatYAML const for yaml output format
-	FormatYAML
-	// FormatUnknown const for unknown output format
-	FormatUnknown
+	formatText formatKind = iota
+	formatJSON
+	formatYAML
+	formatCSV
+	formatNDJSON
+	formatCustomColumns
+	formatJSONPath
+	formatGoTemplate
+	formatUnknown
 )
 
 var (
-	printers = map[Format]Printer{
-		FormatJSON: &JSONPrinter{},
-		FormatYAML: &YAMLPrinter{},
+	// FormatText for text output format
+	FormatText = Format{kind: formatText}
+	// FormatJSON for json output format
+	FormatJSON = Format{kind: formatJSON}
+	// FormatYAML for yaml output format
+	FormatYAML = Format{kind: formatYAML}
+	// FormatCSV for csv output format
+	FormatCSV = Format{kind: formatCSV}
+	// FormatNDJSON for newline delimited json output format
+	FormatNDJSON = Format{kind: formatNDJSON}
+	// FormatUnknown for unknown output format
+	FormatUnknown = Format{kind: formatUnknown}
+
+	supportedFormats = map[string]Format{
+		"text":   FormatText,
+		"json":   FormatJSON,
+		"yaml":   FormatYAML,
+		"csv":    FormatCSV,
+		"ndjson": FormatNDJSON,
 	}
 )
 
+// ParseFormat parses the value of the --output flag. Besides the plain formats it accepts
+// custom-columns=<HEADER>:<path>[,<HEADER>:<path>...], jsonpath=<template> and go-template=<template>.
+func ParseFormat(value string) (Format, error) {
+	name, arg := value, ""
+	if i := strings.Index(value, "="); i >= 0 {
+		name, arg = value[:i], value[i+1:]
+	}
+	name = strings.ToLower(name)
+
+	switch name {
+	case "custom-columns":
+		if _, err := parseColumns(arg); err != nil {
+			return FormatUnknown, err
+		}
+		return Format{kind: formatCustomColumns, arg: arg}, nil
+	case "jsonpath":
+		if arg == "" {
+			return FormatUnknown, errors.New("jsonpath format requires a template, e.g. jsonpath={.id}")
+		}
+		if _, err := parseJSONPathTemplate(arg); err != nil {
+			return FormatUnknown, err
+		}
+		return Format{kind: formatJSONPath, arg: arg}, nil
+	case "go-template":
+		if arg == "" {
+			return FormatUnknown, errors.New("go-template format requires a template, e.g. go-template={{.id}}")
+		}
+		if _, err := parseGoTemplate(arg); err != nil {
+			return FormatUnknown, err
+		}
+		return Format{kind: formatGoTemplate, arg: arg}, nil
+	}
+	format, exists := supportedFormats[name]
+	if !exists || arg != "" {
+		return FormatUnknown, errors.New("unknown output: " + value)
+	}
+	return format, nil
+}
+
+// printer returns the printer for the format. Text has no generic printer, since it depends on the printed object.
+func (f Format) printer() (Printer, bool) {
+	switch f.kind {
+	case formatJSON:
+		return &JSONPrinter{}, true
+	case formatYAML:
+		return &YAMLPrinter{}, true
+	case formatCSV:
+		return &CSVPrinter{}, true
+	case formatNDJSON:
+		return &NDJSONPrinter{}, true
+	case formatCustomColumns:
+		columns, _ := parseColumns(f.arg)
+		return &CustomColumnsPrinter{Columns: columns}, true
+	case formatJSONPath:
+		return &JSONPathPrinter{Template: f.arg}, true
+	case formatGoTemplate:
+		return &GoTemplatePrinter{Template: f.arg}, true
+	}
+	return nil, false
+}
+
 // PrintError prints an error.
 func PrintError(wr io.Writer, err error) {
 	if _, err := fmt.Fprintf(wr, "Error: %s\n", err); err != nil {
@@ -52,6 +140,31 @@ func PrintError(wr io.Writer, err error) {
 	}
 }
 
+// PrintErrorObject prints a machine-readable error object in json or yaml format.
+// It returns false without printing anything if format is neither json nor yaml.
+func PrintErrorObject(wr io.Writer, format Format, errObject interface{}) bool {
+	var (
+		b   []byte
+		err error
+	)
+	switch format.kind {
+	case formatJSON:
+		b, err = json.MarshalIndent(errObject, "", "  ")
+		b = append(b, '\n')
+	case formatYAML:
+		b, err = yaml.Marshal(errObject)
+	default:
+		return false
+	}
+	if err != nil {
+		panic(err)
+	}
+	if _, err := wr.Write(b); err != nil {
+		panic(err)
+	}
+	return true
+}
+
 // PrintMessage prints a message.
 func PrintMessage(wr io.Writer, format string, a ...interface{}) {
 	if _, err := fmt.Fprintf(wr, format, a...); err != nil {
@@ -73,8 +186,9 @@ func PrintTable(wr io.Writer, data *types.TableData) {
 	}
 }
 
-// PrintServiceManagerObject should be used for printing SM objects in different formats
-func PrintServiceManagerObject(wr io.Writer, outputFormat Format, object types.ServiceManagerObject) {
+// PrintServiceManagerObject should be used for printing SM objects in different formats.
+// It returns a TemplateError if the go-template or jsonpath template of the format cannot be executed against the object.
+func PrintServiceManagerObject(wr io.Writer, outputFormat Format, object types.ServiceManagerObject) error {
 	tableDataPrinter, isTableDataPrinter := object.(types.TableDataPrinter)
 	if outputFormat == FormatText && isTableDataPrinter {
 		PrintMessage(wr, object.Message())
@@ -83,9 +197,62 @@ func PrintServiceManagerObject(wr io.Writer, outputFormat Format, object types.S
 			PrintTable(wr, tableDataPrinter.TableData())
 			Println(wr)
 		}
-	} else {
-		printers[outputFormat].Print(wr, object)
+	} else if printer, found := outputFormat.printer(); found {
+		return printWith(wr, printer, object)
 	}
+	return nil
+}
+
+// ListPagePrinter prints the pages of a streamed list.
+// Table based formats print headers only with the first page and keep the column widths of the previous pages,
+// widening the columns for longer cells of later pages. JSON prints every item on a separate line
+// and YAML prints every item as a separate document.
+type ListPagePrinter struct {
+	Format Format
+
+	printed      bool
+	columnWidths []int
+}
+
+// Print prints the next page of the list. It returns a TemplateError like PrintServiceManagerObject.
+func (p *ListPagePrinter) Print(wr io.Writer, page types.ServiceManagerObject) error {
+	if page.IsEmpty() {
+		return nil
+	}
+	first := !p.printed
+	p.printed = true
+
+	switch p.Format.kind {
+	case formatText:
+		if tableDataPrinter, isTableDataPrinter := page.(types.TableDataPrinter); isTableDataPrinter {
+			p.printTable(wr, tableDataPrinter.TableData(), first)
+		}
+	case formatCSV:
+		(&CSVPrinter{HideHeaders: !first}).Print(wr, page)
+	case formatCustomColumns:
+		columns, _ := parseColumns(p.Format.arg)
+		table, err := (&CustomColumnsPrinter{Columns: columns}).table(page)
+		if err != nil {
+			return err
+		}
+		p.printTable(wr, table, first)
+	case formatJSON, formatNDJSON:
+		(&NDJSONPrinter{}).Print(wr, page)
+	case formatYAML:
+		printYAMLDocuments(wr, page)
+	default:
+		if printer, found := p.Format.printer(); found {
+			return printWith(wr, printer, page)
+		}
+	}
+	return nil
+}
+
+func (p *ListPagePrinter) printTable(wr io.Writer, table *types.TableData, first bool) {
+	table.HideHeaders = !first
+	table.Widths = p.columnWidths
+	p.columnWidths = table.ColumnWidths()
+	PrintTable(wr, table)
 }
 
 type converterFunc func([]byte) (interface{}, error)
@@ -96,14 +263,14 @@ func PrintFormat(wr io.Writer, outputFormat Format, encodedObject []byte, conver
 	if err != nil {
 		return err
 	}
-	printer, found := printers[outputFormat]
+	printer, found := outputFormat.printer()
 	if !found {
 		PrintMessage(wr, string(encodedObject))
 		return nil
 	}
-	printer.Print(wr, object)
-	return nil
+	return printWith(wr, printer, object)
 }
+
 // PrintParameters convert map to string
 func PrintParameters(parameters map[string]interface{}) string{
 	jsonParameters,_ := json.MarshalIndent(parameters, "", "   ")
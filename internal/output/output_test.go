@@ -0,0 +1,227 @@
+package output_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/Peripli/service-manager-cli/internal/output"
+	"github.com/Peripli/service-manager-cli/pkg/types"
+	smtypes "github.com/Peripli/service-manager/pkg/types"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Output", func() {
+	var buffer *bytes.Buffer
+
+	instances := &types.ServiceInstances{ServiceInstances: []types.ServiceInstance{
+		{ID: "id1", Name: "instance1", ServicePlanID: "plan1", Labels: smtypes.Labels{"tenant": {"t1"}}},
+		{ID: "id2", Name: "instance, 2", ServicePlanID: "plan2"},
+	}}
+
+	BeforeEach(func() {
+		buffer = &bytes.Buffer{}
+	})
+
+	printAs := func(value string, object types.ServiceManagerObject) {
+		format, err := output.ParseFormat(value)
+		Expect(err).ShouldNot(HaveOccurred())
+		output.PrintServiceManagerObject(buffer, format, object)
+	}
+
+	DescribeTable("ParseFormat",
+		func(value string, expected output.Format) {
+			format, err := output.ParseFormat(value)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(format).To(Equal(expected))
+		},
+		Entry("text", "text", output.FormatText),
+		Entry("json", "json", output.FormatJSON),
+		Entry("upper case yaml", "YAML", output.FormatYAML),
+		Entry("csv", "csv", output.FormatCSV),
+		Entry("ndjson", "ndjson", output.FormatNDJSON),
+	)
+
+	DescribeTable("ParseFormat errors",
+		func(value string, expectedErr string) {
+			_, err := output.ParseFormat(value)
+			Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+		},
+		Entry("unknown format", "xml", "unknown output: xml"),
+		Entry("argument for a plain format", "json=x", "unknown output: json=x"),
+		Entry("custom-columns without columns", "custom-columns", "custom-columns format requires columns"),
+		Entry("custom-columns without path", "custom-columns=NAME", `invalid custom-columns column "NAME"`),
+		Entry("custom-columns with invalid index", "custom-columns=NAME:.labels[x]", "invalid index [x]"),
+	)
+
+	It("should print csv using the table columns", func() {
+		printAs("csv", instances)
+
+		lines := bytes.Split(bytes.TrimSpace(buffer.Bytes()), []byte("\n"))
+		Expect(lines).To(HaveLen(3))
+		Expect(string(lines[0])).To(HavePrefix("ID,Name,Service Plan ID"))
+		Expect(string(lines[1])).To(HavePrefix("id1,instance1,plan1"))
+		Expect(string(lines[2])).To(HavePrefix(`id2,"instance, 2",plan2`))
+	})
+
+	It("should print csv using the json fields of objects without table", func() {
+		(&output.CSVPrinter{}).Print(buffer, []interface{}{
+			map[string]interface{}{"name": "a", "size": 1},
+			map[string]interface{}{"name": "b", "enabled": true},
+		})
+
+		Expect(buffer.String()).To(Equal("enabled,name,size\n,a,1\ntrue,b,\n"))
+	})
+
+	It("should print ndjson with one item per line", func() {
+		printAs("ndjson", instances)
+
+		item1, _ := json.Marshal(instances.ServiceInstances[0])
+		item2, _ := json.Marshal(instances.ServiceInstances[1])
+		Expect(buffer.String()).To(Equal(string(item1) + "\n" + string(item2) + "\n"))
+	})
+
+	It("should print custom columns", func() {
+		printAs("custom-columns=NAME:.name,PLAN:.service_plan_id,TENANT:.labels.tenant[0]", instances)
+
+		Expect(buffer.String()).To(Equal(
+			"NAME         PLAN   TENANT  \n" +
+				"-----------  -----  ------  \n" +
+				"instance1    plan1  t1      \n" +
+				"instance, 2  plan2  <none>  \n"))
+	})
+
+	It("should print custom columns of a single object", func() {
+		printAs("custom-columns=ID:.id", &types.ServiceInstance{ID: "id1"})
+
+		Expect(buffer.String()).To(ContainSubstring("id1"))
+	})
+
+	DescribeTable("jsonpath",
+		func(template string, object types.ServiceManagerObject, expected string) {
+			printAs("jsonpath="+template, object)
+			Expect(buffer.String()).To(Equal(expected))
+		},
+		Entry("field of a single object", "{.name}", &instances.ServiceInstances[0], "instance1"),
+		Entry("expression without braces", ".id", &instances.ServiceInstances[0], "id1"),
+		Entry("wildcard over a list", "{.items[*].id}", instances, "id1 id2"),
+		Entry("negative index", "{.items[-1].name}", instances, "instance, 2"),
+		Entry("quoted field name", "{.items[0].labels['tenant'][0]}", instances, "t1"),
+		Entry("range with literals", `{range .items[*]}{.id}{"\t"}{.service_plan_id}{"\n"}{end}`, instances, "id1\tplan1\nid2\tplan2\n"),
+		Entry("missing field", "{.items[*].missing}", instances, ""),
+	)
+
+	DescribeTable("jsonpath errors",
+		func(template string, expectedErr string) {
+			_, err := output.ParseFormat("jsonpath=" + template)
+			Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+		},
+		Entry("unclosed action", "{.items", "unclosed action"),
+		Entry("unclosed bracket", "{.items[0}", "unclosed bracket"),
+		Entry("range without end", "{range .items[*]}{.id}", "{range} without {end}"),
+		Entry("end without range", "{.id}{end}", "{end} without {range}"),
+		Entry("filter", "{.items[?(@.id)]}", "filter expressions are not supported"),
+		Entry("invalid index", "{.items[x]}", "invalid index [x]"),
+		Entry("missing template", "", "jsonpath format requires a template"),
+	)
+
+	It("should print go-template results for lists", func() {
+		printAs(`go-template={{range .items}}{{.id}} {{end}}`, instances)
+
+		Expect(buffer.String()).To(Equal("id1 id2 "))
+	})
+
+	It("should return go-template execution errors", func() {
+		format, err := output.ParseFormat(`go-template={{template "missing"}}`)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		err = output.PrintServiceManagerObject(buffer, format, instances)
+
+		var templateErr *output.TemplateError
+		Expect(errors.As(err, &templateErr)).To(BeTrue())
+		Expect(err).To(MatchError(ContainSubstring("error executing go-template")))
+		Expect(buffer.String()).To(BeEmpty())
+	})
+
+	Describe("ListPagePrinter", func() {
+		It("should print table headers only with the first page", func() {
+			printer := &output.ListPagePrinter{Format: output.FormatCSV}
+			printer.Print(buffer, instances)
+			printer.Print(buffer, instances)
+
+			Expect(bytes.Count(buffer.Bytes(), []byte("Service Plan ID"))).To(Equal(1))
+			Expect(bytes.Count(buffer.Bytes(), []byte("instance1"))).To(Equal(2))
+		})
+
+		It("should keep the column widths of the previous pages in text tables", func() {
+			printer := &output.ListPagePrinter{Format: output.FormatText}
+			printer.Print(buffer, &types.ServiceInstances{ServiceInstances: []types.ServiceInstance{{ID: "id1", Name: "a-much-longer-instance-name", ServicePlanID: "plan1"}}})
+			printer.Print(buffer, &types.ServiceInstances{ServiceInstances: []types.ServiceInstance{{ID: "id2", Name: "db", ServicePlanID: "plan2"}}})
+
+			lines := strings.Split(strings.TrimSuffix(buffer.String(), "\n"), "\n")
+			Expect(lines).To(HaveLen(4))
+			Expect(lines[3]).To(HaveLen(len(lines[2])))
+			Expect(strings.Index(lines[3], "plan2")).To(Equal(strings.Index(lines[2], "plan1")))
+		})
+
+		It("should widen the columns for longer cells of later pages instead of cutting them", func() {
+			format, err := output.ParseFormat("custom-columns=NAME:.name,ID:.id")
+			Expect(err).ShouldNot(HaveOccurred())
+			printer := &output.ListPagePrinter{Format: format}
+			printer.Print(buffer, &types.ServiceInstances{ServiceInstances: []types.ServiceInstance{{ID: "id1", Name: "db"}}})
+			printer.Print(buffer, &types.ServiceInstances{ServiceInstances: []types.ServiceInstance{{ID: "id2", Name: "a-long-name"}}})
+			printer.Print(buffer, &types.ServiceInstances{ServiceInstances: []types.ServiceInstance{{ID: "id3", Name: "db2"}}})
+
+			Expect(buffer.String()).To(Equal("NAME  ID   \n----  ---  \ndb    id1  \na-long-name  id2  \ndb2          id3  \n"))
+		})
+
+		It("should print every yaml item as a separate document", func() {
+			printer := &output.ListPagePrinter{Format: output.FormatYAML}
+			printer.Print(buffer, instances)
+
+			Expect(buffer.String()).To(HavePrefix("---\nid: id1\nname: instance1\n"))
+			Expect(bytes.Count(buffer.Bytes(), []byte("---\n"))).To(Equal(2))
+		})
+	})
+
+	Describe("PrintWatchFrame", func() {
+		It("should clear the screen and highlight the changed rows", func() {
+			output.PrintWatchFrame(buffer, "Every 2s", instances, map[int]bool{1: true})
+
+			Expect(buffer.String()).To(HavePrefix("\033[H\033[2JEvery 2s\n\n2 service instances.\n"))
+			lines := strings.Split(buffer.String(), "\n")
+			Expect(lines[5]).To(HavePrefix("id1"))
+			Expect(lines[6]).To(HavePrefix("\033[7mid2"))
+			Expect(lines[6]).To(HaveSuffix("\033[0m"))
+		})
+
+		It("should highlight a vertical table as a whole", func() {
+			output.PrintWatchFrame(buffer, "Every 2s", &types.Operation{ID: "op", State: "succeeded"}, map[int]bool{0: true})
+
+			table := strings.Split(strings.TrimSpace(buffer.String()), "\n")[3:]
+			Expect(table).To(HaveLen(3))
+			for _, line := range table {
+				Expect(line).To(HavePrefix("\033[7m| "))
+			}
+		})
+
+		It("should print only the title without object", func() {
+			output.PrintWatchFrame(buffer, "Every 2s\nno instance found", nil, nil)
+
+			Expect(buffer.String()).To(Equal("\033[H\033[2JEvery 2s\nno instance found\n\n"))
+		})
+	})
+
+	It("should print watch events as ndjson", func() {
+		output.PrintWatchEvents(buffer, []output.WatchEvent{
+			{Type: output.WatchEventAdded, Object: []byte(`{"id":"id1"}`)},
+			{Type: output.WatchEventDeleted, Object: []byte(`{"id":"id2"}`)},
+		})
+
+		Expect(buffer.String()).To(Equal(`{"type":"ADDED","object":{"id":"id1"}}` + "\n" + `{"type":"DELETED","object":{"id":"id2"}}` + "\n"))
+	})
+})
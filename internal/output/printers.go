@@ -18,8 +18,15 @@ package output
 
 import (
 	"bytes"
+	"encoding/csv"
 	"encoding/json"
+	"fmt"
 	"io"
+	"sort"
+	"strconv"
+	"text/template"
+
+	"github.com/Peripli/service-manager-cli/pkg/types"
 
 	yaml "gopkg.in/yaml.v3"
 )
@@ -51,12 +58,323 @@ func (p *JSONPrinter) Print(wr io.Writer, data interface{}) {
 // YAMLPrinter implements Printer interface and outputs in YAML format
 type YAMLPrinter struct{}
 
-// Print prints in yaml format
+// Print prints in yaml format.
+// The data is encoded as JSON first, so raw JSON fields are printed as nested YAML and both formats carry the same information.
 func (p *YAMLPrinter) Print(wr io.Writer, data interface{}) {
-	b, err := yaml.Marshal(data)
+	b, err := json.Marshal(data)
+	if err == nil {
+		b, err = jsonToYAML(b)
+	}
 	if err != nil {
 		PrintError(wr, err)
 	} else {
 		PrintMessage(wr, string(b))
 	}
 }
+
+// NDJSONPrinter implements Printer interface and outputs every list item as JSON on a separate line
+type NDJSONPrinter struct{}
+
+// Print prints in newline delimited json format
+func (p *NDJSONPrinter) Print(wr io.Writer, data interface{}) {
+	items, err := jsonItems(data)
+	if err != nil {
+		PrintError(wr, err)
+		return
+	}
+	for _, item := range items {
+		PrintMessage(wr, "%s\n", item)
+	}
+}
+
+// CSVPrinter implements Printer interface and outputs in csv format.
+// Objects which can be printed as a table use the table columns, other objects use their JSON fields.
+type CSVPrinter struct {
+	HideHeaders bool
+}
+
+// Print prints in csv format
+func (p *CSVPrinter) Print(wr io.Writer, data interface{}) {
+	var headers []string
+	var rows [][]string
+	if tableDataPrinter, ok := data.(types.TableDataPrinter); ok {
+		table := tableDataPrinter.TableData()
+		headers, rows = table.Headers, table.Data
+	} else {
+		var err error
+		if headers, rows, err = jsonRows(data); err != nil {
+			PrintError(wr, err)
+			return
+		}
+	}
+
+	writer := csv.NewWriter(wr)
+	if !p.HideHeaders {
+		if err := writer.Write(headers); err != nil {
+			PrintError(wr, err)
+			return
+		}
+	}
+	if err := writer.WriteAll(rows); err != nil {
+		PrintError(wr, err)
+	}
+}
+
+// CustomColumnsPrinter implements Printer interface and outputs a table with user defined columns
+type CustomColumnsPrinter struct {
+	Columns     []Column
+	HideHeaders bool
+}
+
+// Print prints a table with the configured columns
+func (p *CustomColumnsPrinter) Print(wr io.Writer, data interface{}) {
+	table, err := p.table(data)
+	if err != nil {
+		PrintError(wr, err)
+		return
+	}
+	table.HideHeaders = p.HideHeaders
+	PrintTable(wr, table)
+}
+
+// table returns the table with the configured columns of the items of data
+func (p *CustomColumnsPrinter) table(data interface{}) (*types.TableData, error) {
+	value, err := toJSONValue(data)
+	if err != nil {
+		return nil, err
+	}
+
+	table := &types.TableData{}
+	for _, column := range p.Columns {
+		table.Headers = append(table.Headers, column.Header)
+	}
+	for _, item := range listItems(value) {
+		row := make([]string, 0, len(p.Columns))
+		for _, column := range p.Columns {
+			row = append(row, column.cell(item))
+		}
+		table.Data = append(table.Data, row)
+	}
+	return table, nil
+}
+
+// TemplateError is returned when a go-template or jsonpath template cannot be executed against the printed data
+type TemplateError struct {
+	Err error
+}
+
+func (e *TemplateError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error
+func (e *TemplateError) Unwrap() error {
+	return e.Err
+}
+
+// templatePrinter is implemented by the printers of user provided templates, which can fail for the printed data
+type templatePrinter interface {
+	// execute prints the result of the template or returns a TemplateError without printing anything
+	execute(io.Writer, interface{}) error
+}
+
+// printWith prints data with the printer and returns the error of a template printer
+func printWith(wr io.Writer, printer Printer, data interface{}) error {
+	if templatePrinter, ok := printer.(templatePrinter); ok {
+		return templatePrinter.execute(wr, data)
+	}
+	printer.Print(wr, data)
+	return nil
+}
+
+// JSONPathPrinter implements Printer interface and outputs the result of a JSONPath template
+type JSONPathPrinter struct {
+	Template string
+}
+
+// Print prints the JSONPath template evaluated against the JSON representation of data
+func (p *JSONPathPrinter) Print(wr io.Writer, data interface{}) {
+	if err := p.execute(wr, data); err != nil {
+		PrintError(wr, err)
+	}
+}
+
+func (p *JSONPathPrinter) execute(wr io.Writer, data interface{}) error {
+	template, err := parseJSONPathTemplate(p.Template)
+	if err != nil {
+		return &TemplateError{Err: err}
+	}
+	value, err := toJSONValue(data)
+	if err != nil {
+		return err
+	}
+	PrintMessage(wr, "%s", template.execute(value))
+	return nil
+}
+
+// GoTemplatePrinter implements Printer interface and outputs the result of a Go template
+type GoTemplatePrinter struct {
+	Template string
+}
+
+// Print prints the Go template executed against the JSON representation of data
+func (p *GoTemplatePrinter) Print(wr io.Writer, data interface{}) {
+	if err := p.execute(wr, data); err != nil {
+		PrintError(wr, err)
+	}
+}
+
+func (p *GoTemplatePrinter) execute(wr io.Writer, data interface{}) error {
+	tmpl, err := parseGoTemplate(p.Template)
+	if err != nil {
+		return &TemplateError{Err: err}
+	}
+	value, err := toJSONValue(data)
+	if err != nil {
+		return err
+	}
+	var out bytes.Buffer
+	if err := tmpl.Execute(&out, value); err != nil {
+		return &TemplateError{Err: fmt.Errorf("error executing go-template: %s", err)}
+	}
+	PrintMessage(wr, "%s", out.String())
+	return nil
+}
+
+func parseGoTemplate(text string) (*template.Template, error) {
+	tmpl, err := template.New("output").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid go-template: %s", err)
+	}
+	return tmpl, nil
+}
+
+// listItems returns the items of a decoded JSON list, or the value itself if it is not a list
+func listItems(value interface{}) []interface{} {
+	switch v := value.(type) {
+	case []interface{}:
+		return v
+	case map[string]interface{}:
+		if items, ok := v["items"].([]interface{}); ok {
+			return items
+		}
+	}
+	return []interface{}{value}
+}
+
+// printYAMLDocuments prints every item of a list as a separate YAML document
+func printYAMLDocuments(wr io.Writer, data interface{}) {
+	items, err := jsonItems(data)
+	if err != nil {
+		PrintError(wr, err)
+		return
+	}
+	for _, item := range items {
+		b, err := jsonToYAML(item)
+		if err != nil {
+			PrintError(wr, err)
+			return
+		}
+		PrintMessage(wr, "---\n%s", b)
+	}
+}
+
+// jsonToYAML converts a JSON document to block style YAML
+func jsonToYAML(b []byte) ([]byte, error) {
+	// JSON is valid YAML, so decoding into a node keeps the field order
+	var node yaml.Node
+	if err := yaml.Unmarshal(b, &node); err != nil {
+		return nil, err
+	}
+	resetStyle(&node)
+	return yaml.Marshal(&node)
+}
+
+func resetStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetStyle(child)
+	}
+}
+
+// jsonItems returns the JSON encoded items of a list, or the JSON encoded data itself if it is not a list
+func jsonItems(data interface{}) ([]json.RawMessage, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []json.RawMessage
+	if bytes.HasPrefix(b, []byte("[")) {
+		err = json.Unmarshal(b, &items)
+		return items, err
+	}
+	list := struct {
+		Items *[]json.RawMessage `json:"items"`
+	}{}
+	if err := json.Unmarshal(b, &list); err == nil && list.Items != nil {
+		return *list.Items, nil
+	}
+	return []json.RawMessage{b}, nil
+}
+
+// jsonRows returns the sorted JSON field names of the list items and a row of values for every item
+func jsonRows(data interface{}) ([]string, [][]string, error) {
+	items, err := jsonItems(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	objects := make([]map[string]interface{}, 0, len(items))
+	fields := map[string]bool{}
+	for _, item := range items {
+		object := map[string]interface{}{}
+		if err := json.Unmarshal(item, &object); err != nil {
+			return nil, nil, err
+		}
+		for field := range object {
+			fields[field] = true
+		}
+		objects = append(objects, object)
+	}
+
+	headers := make([]string, 0, len(fields))
+	for field := range fields {
+		headers = append(headers, field)
+	}
+	sort.Strings(headers)
+
+	rows := make([][]string, 0, len(objects))
+	for _, object := range objects {
+		row := make([]string, 0, len(headers))
+		for _, header := range headers {
+			value, found := object[header]
+			if !found {
+				row = append(row, "")
+				continue
+			}
+			row = append(row, formatValue(value))
+		}
+		rows = append(rows, row)
+	}
+	return headers, rows, nil
+}
+
+// formatValue formats a decoded JSON value as a table cell
+func formatValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "<none>"
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+}
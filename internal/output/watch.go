@@ -0,0 +1,96 @@
+/*
+ * Copyright 2018 The Service Manager Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package output
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/Peripli/service-manager-cli/pkg/types"
+)
+
+const (
+	clearScreen    = "\033[H\033[2J"
+	highlightStart = "\033[7m"
+	highlightEnd   = "\033[0m"
+)
+
+// Types of watch events
+const (
+	WatchEventAdded    = "ADDED"
+	WatchEventModified = "MODIFIED"
+	WatchEventDeleted  = "DELETED"
+)
+
+// WatchEvent is a change of an item between two refreshes of a watched object
+type WatchEvent struct {
+	Type   string          `json:"type"`
+	Object json.RawMessage `json:"object"`
+}
+
+// PrintWatchEvents prints every event as JSON on a separate line
+func PrintWatchEvents(wr io.Writer, events []WatchEvent) {
+	for _, event := range events {
+		b, err := json.Marshal(event)
+		if err != nil {
+			PrintError(wr, err)
+			continue
+		}
+		PrintMessage(wr, "%s\n", b)
+	}
+}
+
+// PrintWatchFrame clears the terminal and prints the title followed by the object in text format.
+// The table rows at the highlighted indexes are printed in reverse video. Vertical tables show a single object and are highlighted as a whole.
+// A nil object prints only the title.
+func PrintWatchFrame(wr io.Writer, title string, object types.ServiceManagerObject, highlighted map[int]bool) {
+	PrintMessage(wr, clearScreen)
+	PrintMessage(wr, "%s\n\n", title)
+	if object == nil {
+		return
+	}
+
+	tableDataPrinter, isTableDataPrinter := object.(types.TableDataPrinter)
+	if !isTableDataPrinter {
+		PrintServiceManagerObject(wr, FormatText, object)
+		return
+	}
+	PrintMessage(wr, object.Message())
+	Println(wr)
+	if !object.IsEmpty() {
+		PrintMessage(wr, "%s", highlightRows(tableDataPrinter.TableData(), highlighted))
+		Println(wr)
+	}
+}
+
+func highlightRows(table *types.TableData, highlighted map[int]bool) string {
+	lines := strings.SplitAfter(table.String(), "\n")
+	firstRow := 0
+	if !table.Vertical && !table.HideHeaders {
+		firstRow = 2
+	}
+	for i, line := range lines {
+		if line == "" {
+			continue
+		}
+		if (table.Vertical && len(highlighted) > 0) || (!table.Vertical && highlighted[i-firstRow]) {
+			lines[i] = highlightStart + strings.TrimSuffix(line, "\n") + highlightEnd + "\n"
+		}
+	}
+	return strings.Join(lines, "")
+}
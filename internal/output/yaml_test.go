@@ -0,0 +1,126 @@
+/*
+ * Copyright 2018 The Service Manager Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package output_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+
+	"github.com/Peripli/service-manager-cli/internal/output"
+	"github.com/Peripli/service-manager-cli/pkg/types"
+	smtypes "github.com/Peripli/service-manager/pkg/types"
+	yaml "gopkg.in/yaml.v3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("YAML output", func() {
+	labels := smtypes.Labels{"tenant": {"t1", "t2"}}
+	credentials := &types.Credentials{Basic: types.Basic{User: "user", Password: "password"}}
+	offering := types.ServiceOffering{
+		ID:          "offering-id",
+		Name:        "postgres",
+		Description: "database",
+		Bindable:    true,
+		Tags:        json.RawMessage(`["sql","database"]`),
+		Requires:    json.RawMessage(`["route_forwarding"]`),
+		Metadata:    json.RawMessage(`{"displayName":"PostgreSQL","nested":{"list":[1,2]}}`),
+		BrokerID:    "broker-id",
+		Labels:      labels,
+	}
+	plan := types.ServicePlan{
+		ID:                "plan-id",
+		Name:              "standard",
+		Free:              true,
+		Metadata:          json.RawMessage(`{"costs":[{"amount":{"usd":10}}]}`),
+		Schemas:           json.RawMessage(`{"service_instance":{"create":{"parameters":{"type":"object"}}}}`),
+		ServiceOfferingID: "offering-id",
+	}
+	offeringWithPlans := offering
+	offeringWithPlans.Plans = []types.ServicePlan{plan}
+	ready := true
+	instance := types.ServiceInstance{
+		ID:              "instance-id",
+		Name:            "instance",
+		ServicePlanID:   "plan-id",
+		Parameters:      json.RawMessage(`{"size":"large"}`),
+		MaintenanceInfo: json.RawMessage(`{"version":"1.0.0"}`),
+		Context:         json.RawMessage(`{"platform":"service-manager"}`),
+		Ready:           &ready,
+		Labels:          labels,
+	}
+	binding := types.ServiceBinding{
+		ID:                "binding-id",
+		Name:              "binding",
+		Credentials:       json.RawMessage(`{"password":"secret","uri":"postgres://host"}`),
+		ServiceInstanceID: "instance-id",
+		RouteServiceURL:   "https://route",
+		Parameters:        json.RawMessage(`{"role":"admin"}`),
+	}
+	broker := types.Broker{ID: "broker-id", Name: "broker", URL: "https://broker", Credentials: credentials, Labels: labels, Ready: true}
+	platform := types.Platform{ID: "platform-id", Name: "platform", Type: "kubernetes", Credentials: credentials, Ready: true}
+	visibility := types.Visibility{ID: "visibility-id", PlatformID: "platform-id", ServicePlanID: "plan-id", Labels: labels}
+
+	DescribeTable("should carry the same data as json and decode back to the original",
+		func(object interface{}) {
+			jsonBuffer, yamlBuffer := &bytes.Buffer{}, &bytes.Buffer{}
+			(&output.JSONPrinter{}).Print(jsonBuffer, object)
+			(&output.YAMLPrinter{}).Print(yamlBuffer, object)
+
+			var fromYAML interface{}
+			Expect(yaml.Unmarshal(yamlBuffer.Bytes(), &fromYAML)).To(Succeed())
+			encoded, err := json.Marshal(fromYAML)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(encoded).To(MatchJSON(jsonBuffer.Bytes()))
+
+			decoded := reflect.New(reflect.TypeOf(object).Elem()).Interface()
+			Expect(json.Unmarshal(encoded, decoded)).To(Succeed())
+			Expect(decoded).To(Equal(object))
+		},
+		Entry("broker", &broker),
+		Entry("brokers", &types.Brokers{Brokers: []types.Broker{broker}}),
+		Entry("platform", &platform),
+		Entry("platforms", &types.Platforms{Platforms: []types.Platform{platform}}),
+		Entry("credentials", credentials),
+		Entry("info", &types.Info{TokenIssuerURL: "https://issuer", TokenBasicAuth: true}),
+		Entry("label changes", &types.LabelChanges{LabelChanges: []*smtypes.LabelChange{{Operation: smtypes.AddLabelOperation, Key: "tenant", Values: []string{"t1"}}}}),
+		Entry("operation", &types.Operation{ID: "operation-id", Type: "create", State: "failed", ResourceID: "instance-id", Errors: json.RawMessage(`{"description":"failed","error":"BadRequest"}`), Labels: labels}),
+		Entry("service binding", &binding),
+		Entry("service bindings", &types.ServiceBindings{ServiceBindings: []types.ServiceBinding{binding}}),
+		Entry("service instance", &instance),
+		Entry("service instances", &types.ServiceInstances{ServiceInstances: []types.ServiceInstance{instance}}),
+		Entry("service offering", &offeringWithPlans),
+		Entry("service offerings", &types.ServiceOfferings{ServiceOfferings: []types.ServiceOffering{offering}}),
+		Entry("marketplace", &types.Marketplace{ServiceOfferings: []types.ServiceOffering{offeringWithPlans}}),
+		Entry("service plan", &plan),
+		Entry("service plans", &types.ServicePlans{ServicePlans: []types.ServicePlan{plan}}),
+		Entry("service plans for offering", &types.ServicePlansForOffering{ServicePlans: []types.ServicePlan{plan}}),
+		Entry("visibility", &visibility),
+		Entry("visibilities", &types.Visibilities{Visibilities: []types.Visibility{visibility}}),
+	)
+
+	It("should print raw json fields as nested yaml", func() {
+		buffer := &bytes.Buffer{}
+		(&output.YAMLPrinter{}).Print(buffer, &offering)
+
+		Expect(buffer.String()).To(ContainSubstring("tags:\n    - sql\n    - database\n"))
+		Expect(buffer.String()).To(ContainSubstring("metadata:\n    displayName: PostgreSQL\n    nested:\n        list:\n            - 1\n            - 2\n"))
+	})
+})
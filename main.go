@@ -17,11 +17,13 @@
 package main
 
 import (
-	"context"
 	"github.com/Peripli/service-manager-cli/internal/cmd"
+	"github.com/Peripli/service-manager-cli/internal/cmd/apply"
 	"github.com/Peripli/service-manager-cli/internal/cmd/binding"
 	"github.com/Peripli/service-manager-cli/internal/cmd/broker"
+	"github.com/Peripli/service-manager-cli/internal/cmd/completion"
 	"github.com/Peripli/service-manager-cli/internal/cmd/curl"
+	"github.com/Peripli/service-manager-cli/internal/cmd/export"
 	"github.com/Peripli/service-manager-cli/internal/cmd/info"
 	"github.com/Peripli/service-manager-cli/internal/cmd/instance"
 	"github.com/Peripli/service-manager-cli/internal/cmd/label"
@@ -31,6 +33,7 @@ import (
 	"github.com/Peripli/service-manager-cli/internal/cmd/plan"
 	"github.com/Peripli/service-manager-cli/internal/cmd/platform"
 	"github.com/Peripli/service-manager-cli/internal/cmd/status"
+	"github.com/Peripli/service-manager-cli/internal/cmd/target"
 	"github.com/Peripli/service-manager-cli/internal/cmd/version"
 	"github.com/Peripli/service-manager-cli/internal/cmd/visibility"
 	"github.com/Peripli/service-manager-cli/pkg/auth"
@@ -46,11 +49,13 @@ func oidcAuthBuilder(options *auth.Options) (auth.Authenticator, *auth.Options,
 }
 
 func main() {
+	fs := afero.NewOsFs()
 	cmdContext := &cmd.Context{
-		Ctx: context.Background(),
+		Ctx:                cmd.NewInterruptibleContext(),
+		Fs:                 fs,
+		CompletionCacheDir: cmd.DefaultCompletionCacheDir(),
 	}
 	rootCmd := cmd.BuildRootCommand(cmdContext)
-	fs := afero.NewOsFs()
 
 	normalCommandsGroup := cmd.Group{
 		Commands: []cmd.CommandPreparator{
@@ -58,6 +63,8 @@ func main() {
 			version.NewVersionCmd(cmdContext),
 			logout.NewLogoutCmd(cmdContext),
 			info.NewInfoCmd(cmdContext),
+			target.NewTargetCmd(cmdContext, os.Stdin),
+			completion.NewCompletionCmd(cmdContext),
 		},
 		PrepareFn: cmd.CommonPrepare,
 	}
@@ -65,34 +72,43 @@ func main() {
 	smCommandsGroup := cmd.Group{
 		Commands: []cmd.CommandPreparator{
 			curl.NewCurlCmd(cmdContext, fs),
+			apply.NewApplyCmd(cmdContext, os.Stdin, fs),
+			export.NewExportCmd(cmdContext),
 			binding.NewListBindingsCmd(cmdContext),
 			binding.NewGetBindingCmd(cmdContext),
-			binding.NewBindCmd(cmdContext),
+			binding.NewBindCmd(cmdContext, os.Stdin, fs),
 			binding.NewUnbindCmd(cmdContext, os.Stdin),
+			binding.NewExportCredentialsCmd(cmdContext, fs),
+			binding.NewExecCmd(cmdContext, os.Stdin, os.Stderr),
 			broker.NewRegisterBrokerCmd(cmdContext),
 			broker.NewGetBrokerCmd(cmdContext),
 			broker.NewListBrokersCmd(cmdContext),
 			broker.NewDeleteBrokerCmd(cmdContext, os.Stdin),
 			broker.NewUpdateBrokerCmd(cmdContext),
 			platform.NewRegisterPlatformCmd(cmdContext),
+			platform.NewGetPlatformCmd(cmdContext),
 			platform.NewListPlatformsCmd(cmdContext),
 			platform.NewDeletePlatformCmd(cmdContext, os.Stdin),
 			platform.NewUpdatePlatformCmd(cmdContext),
 			visibility.NewRegisterVisibilityCmd(cmdContext),
+			visibility.NewGetVisibilityCmd(cmdContext),
 			visibility.NewListVisibilitiesCmd(cmdContext),
 			visibility.NewUpdateVisibilityCmd(cmdContext),
 			visibility.NewDeleteVisibilityCmd(cmdContext, os.Stdin),
+			offering.NewGetOfferingCmd(cmdContext),
 			offering.NewListOfferingsCmd(cmdContext),
 			offering.NewMarketplaceCmd(cmdContext),
+			plan.NewGetPlanCmd(cmdContext),
+			plan.NewDescribePlanCmd(cmdContext),
 			plan.NewListPlansCmd(cmdContext),
 			label.NewLabelCmd(cmdContext),
 			status.NewStatusCmd(cmdContext),
 			instance.NewListInstancesCmd(cmdContext),
 			instance.NewGetInstanceCmd(cmdContext),
-			instance.NewProvisionCmd(cmdContext),
+			instance.NewProvisionCmd(cmdContext, os.Stdin, fs),
 			instance.NewDeprovisionCmd(cmdContext, os.Stdin),
 			instance.NewTransferCmd(cmdContext, os.Stdin),
-			instance.NewUpdateInstanceCmd(cmdContext),
+			instance.NewUpdateInstanceCmd(cmdContext, os.Stdin, fs),
 			instance.NewUpdateSharingCmd(cmdContext,true),
 			instance.NewUpdateSharingCmd(cmdContext,false),
 
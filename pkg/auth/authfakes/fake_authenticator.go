@@ -8,6 +8,19 @@ import (
 )
 
 type FakeAuthenticator struct {
+	AuthorizationCodeStub        func(func(authorizationURL string) error) (*auth.Token, error)
+	authorizationCodeMutex       sync.RWMutex
+	authorizationCodeArgsForCall []struct {
+		arg1 func(authorizationURL string) error
+	}
+	authorizationCodeReturns struct {
+		result1 *auth.Token
+		result2 error
+	}
+	authorizationCodeReturnsOnCall map[int]struct {
+		result1 *auth.Token
+		result2 error
+	}
 	ClientCredentialsStub        func() (*auth.Token, error)
 	clientCredentialsMutex       sync.RWMutex
 	clientCredentialsArgsForCall []struct {
@@ -20,6 +33,19 @@ type FakeAuthenticator struct {
 		result1 *auth.Token
 		result2 error
 	}
+	DeviceCodeStub        func(func(userCode string, verificationURI string)) (*auth.Token, error)
+	deviceCodeMutex       sync.RWMutex
+	deviceCodeArgsForCall []struct {
+		arg1 func(userCode string, verificationURI string)
+	}
+	deviceCodeReturns struct {
+		result1 *auth.Token
+		result2 error
+	}
+	deviceCodeReturnsOnCall map[int]struct {
+		result1 *auth.Token
+		result2 error
+	}
 	PasswordCredentialsStub        func(string, string) (*auth.Token, error)
 	passwordCredentialsMutex       sync.RWMutex
 	passwordCredentialsArgsForCall []struct {
@@ -38,20 +64,85 @@ type FakeAuthenticator struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeAuthenticator) AuthorizationCode(arg1 func(authorizationURL string) error) (*auth.Token, error) {
+	fake.authorizationCodeMutex.Lock()
+	ret, specificReturn := fake.authorizationCodeReturnsOnCall[len(fake.authorizationCodeArgsForCall)]
+	fake.authorizationCodeArgsForCall = append(fake.authorizationCodeArgsForCall, struct {
+		arg1 func(authorizationURL string) error
+	}{arg1})
+	stub := fake.AuthorizationCodeStub
+	fakeReturns := fake.authorizationCodeReturns
+	fake.recordInvocation("AuthorizationCode", []interface{}{arg1})
+	fake.authorizationCodeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAuthenticator) AuthorizationCodeCallCount() int {
+	fake.authorizationCodeMutex.RLock()
+	defer fake.authorizationCodeMutex.RUnlock()
+	return len(fake.authorizationCodeArgsForCall)
+}
+
+func (fake *FakeAuthenticator) AuthorizationCodeCalls(stub func(func(authorizationURL string) error) (*auth.Token, error)) {
+	fake.authorizationCodeMutex.Lock()
+	defer fake.authorizationCodeMutex.Unlock()
+	fake.AuthorizationCodeStub = stub
+}
+
+func (fake *FakeAuthenticator) AuthorizationCodeArgsForCall(i int) func(authorizationURL string) error {
+	fake.authorizationCodeMutex.RLock()
+	defer fake.authorizationCodeMutex.RUnlock()
+	argsForCall := fake.authorizationCodeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAuthenticator) AuthorizationCodeReturns(result1 *auth.Token, result2 error) {
+	fake.authorizationCodeMutex.Lock()
+	defer fake.authorizationCodeMutex.Unlock()
+	fake.AuthorizationCodeStub = nil
+	fake.authorizationCodeReturns = struct {
+		result1 *auth.Token
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuthenticator) AuthorizationCodeReturnsOnCall(i int, result1 *auth.Token, result2 error) {
+	fake.authorizationCodeMutex.Lock()
+	defer fake.authorizationCodeMutex.Unlock()
+	fake.AuthorizationCodeStub = nil
+	if fake.authorizationCodeReturnsOnCall == nil {
+		fake.authorizationCodeReturnsOnCall = make(map[int]struct {
+			result1 *auth.Token
+			result2 error
+		})
+	}
+	fake.authorizationCodeReturnsOnCall[i] = struct {
+		result1 *auth.Token
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeAuthenticator) ClientCredentials() (*auth.Token, error) {
 	fake.clientCredentialsMutex.Lock()
 	ret, specificReturn := fake.clientCredentialsReturnsOnCall[len(fake.clientCredentialsArgsForCall)]
 	fake.clientCredentialsArgsForCall = append(fake.clientCredentialsArgsForCall, struct {
 	}{})
+	stub := fake.ClientCredentialsStub
+	fakeReturns := fake.clientCredentialsReturns
 	fake.recordInvocation("ClientCredentials", []interface{}{})
 	fake.clientCredentialsMutex.Unlock()
-	if fake.ClientCredentialsStub != nil {
-		return fake.ClientCredentialsStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.clientCredentialsReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -93,6 +184,70 @@ func (fake *FakeAuthenticator) ClientCredentialsReturnsOnCall(i int, result1 *au
 	}{result1, result2}
 }
 
+func (fake *FakeAuthenticator) DeviceCode(arg1 func(userCode string, verificationURI string)) (*auth.Token, error) {
+	fake.deviceCodeMutex.Lock()
+	ret, specificReturn := fake.deviceCodeReturnsOnCall[len(fake.deviceCodeArgsForCall)]
+	fake.deviceCodeArgsForCall = append(fake.deviceCodeArgsForCall, struct {
+		arg1 func(userCode string, verificationURI string)
+	}{arg1})
+	stub := fake.DeviceCodeStub
+	fakeReturns := fake.deviceCodeReturns
+	fake.recordInvocation("DeviceCode", []interface{}{arg1})
+	fake.deviceCodeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAuthenticator) DeviceCodeCallCount() int {
+	fake.deviceCodeMutex.RLock()
+	defer fake.deviceCodeMutex.RUnlock()
+	return len(fake.deviceCodeArgsForCall)
+}
+
+func (fake *FakeAuthenticator) DeviceCodeCalls(stub func(func(userCode string, verificationURI string)) (*auth.Token, error)) {
+	fake.deviceCodeMutex.Lock()
+	defer fake.deviceCodeMutex.Unlock()
+	fake.DeviceCodeStub = stub
+}
+
+func (fake *FakeAuthenticator) DeviceCodeArgsForCall(i int) func(userCode string, verificationURI string) {
+	fake.deviceCodeMutex.RLock()
+	defer fake.deviceCodeMutex.RUnlock()
+	argsForCall := fake.deviceCodeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAuthenticator) DeviceCodeReturns(result1 *auth.Token, result2 error) {
+	fake.deviceCodeMutex.Lock()
+	defer fake.deviceCodeMutex.Unlock()
+	fake.DeviceCodeStub = nil
+	fake.deviceCodeReturns = struct {
+		result1 *auth.Token
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuthenticator) DeviceCodeReturnsOnCall(i int, result1 *auth.Token, result2 error) {
+	fake.deviceCodeMutex.Lock()
+	defer fake.deviceCodeMutex.Unlock()
+	fake.DeviceCodeStub = nil
+	if fake.deviceCodeReturnsOnCall == nil {
+		fake.deviceCodeReturnsOnCall = make(map[int]struct {
+			result1 *auth.Token
+			result2 error
+		})
+	}
+	fake.deviceCodeReturnsOnCall[i] = struct {
+		result1 *auth.Token
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeAuthenticator) PasswordCredentials(arg1 string, arg2 string) (*auth.Token, error) {
 	fake.passwordCredentialsMutex.Lock()
 	ret, specificReturn := fake.passwordCredentialsReturnsOnCall[len(fake.passwordCredentialsArgsForCall)]
@@ -100,15 +255,16 @@ func (fake *FakeAuthenticator) PasswordCredentials(arg1 string, arg2 string) (*a
 		arg1 string
 		arg2 string
 	}{arg1, arg2})
+	stub := fake.PasswordCredentialsStub
+	fakeReturns := fake.passwordCredentialsReturns
 	fake.recordInvocation("PasswordCredentials", []interface{}{arg1, arg2})
 	fake.passwordCredentialsMutex.Unlock()
-	if fake.PasswordCredentialsStub != nil {
-		return fake.PasswordCredentialsStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.passwordCredentialsReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -160,8 +316,12 @@ func (fake *FakeAuthenticator) PasswordCredentialsReturnsOnCall(i int, result1 *
 func (fake *FakeAuthenticator) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.authorizationCodeMutex.RLock()
+	defer fake.authorizationCodeMutex.RUnlock()
 	fake.clientCredentialsMutex.RLock()
 	defer fake.clientCredentialsMutex.RUnlock()
+	fake.deviceCodeMutex.RLock()
+	defer fake.deviceCodeMutex.RUnlock()
 	fake.passwordCredentialsMutex.RLock()
 	defer fake.passwordCredentialsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
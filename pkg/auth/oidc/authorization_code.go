@@ -0,0 +1,140 @@
+/*
+ * Copyright 2018 The Service Manager Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/Peripli/service-manager-cli/pkg/auth"
+	"golang.org/x/oauth2"
+)
+
+const (
+	callbackPath = "/callback"
+
+	authorizationCodeTimeout = 5 * time.Minute
+)
+
+type callbackResult struct {
+	code string
+	err  error
+}
+
+// AuthorizationCode is used to perform authorization code grant type flow with PKCE (RFC 7636).
+// It starts a loopback HTTP listener for the redirect and calls openURL with the authorization URL.
+func (s *OpenIDStrategy) AuthorizationCode(openURL func(authorizationURL string) error) (*auth.Token, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("could not start callback listener: %s", err)
+	}
+
+	config := *s.oauth2Config
+	config.RedirectURL = "http://" + listener.Addr().String() + callbackPath
+
+	state, err := randomState()
+	if err != nil {
+		listener.Close()
+		return nil, err
+	}
+	verifier := oauth2.GenerateVerifier()
+
+	results := make(chan callbackResult, 1)
+	server := &http.Server{Handler: callbackHandler(state, results)}
+	go server.Serve(listener)
+	defer server.Close()
+
+	if err := openURL(config.AuthCodeURL(state, oauth2.S256ChallengeOption(verifier))); err != nil {
+		return nil, err
+	}
+
+	var result callbackResult
+	select {
+	case result = <-results:
+	case <-time.After(authorizationCodeTimeout):
+		return nil, errors.New("timed out waiting for authorization")
+	}
+	if result.err != nil {
+		return nil, result.err
+	}
+
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, s.httpClient)
+	token, err := config.Exchange(ctx, result.code, oauth2.VerifierOption(verifier))
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	return &auth.Token{
+		AccessToken:  token.AccessToken,
+		RefreshToken: token.RefreshToken,
+		ExpiresIn:    token.Expiry,
+		TokenType:    token.TokenType,
+	}, nil
+}
+
+func callbackHandler(state string, results chan<- callbackResult) http.HandlerFunc {
+	return func(response http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != callbackPath {
+			http.NotFound(response, req)
+			return
+		}
+
+		query := req.URL.Query()
+		var result callbackResult
+		switch {
+		case query.Get("error") != "":
+			description := query.Get("error_description")
+			if description == "" {
+				description = query.Get("error")
+			}
+			result.err = fmt.Errorf("auth error: %s", description)
+		case query.Get("state") != state:
+			result.err = errors.New("auth error: invalid state parameter in authorization response")
+		case query.Get("code") == "":
+			result.err = errors.New("auth error: authorization code missing in authorization response")
+		default:
+			result.code = query.Get("code")
+		}
+
+		if result.err != nil {
+			http.Error(response, result.err.Error(), http.StatusBadRequest)
+		} else {
+			response.Header().Set("Content-Type", "text/plain; charset=utf-8")
+			fmt.Fprintln(response, "Logged in successfully. You can close this window and return to smctl.")
+		}
+
+		select {
+		case results <- result:
+		default:
+			// the first callback already completed the flow
+		}
+	}
+}
+
+func randomState() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,120 @@
+package oidc
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	"github.com/Peripli/service-manager-cli/pkg/auth"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Authorization code flow", func() {
+	var server *httptest.Server
+	var authStrategy auth.Authenticator
+	var codeChallenge string
+	var authorizationError string
+	var redirectState string
+
+	browser := func(authorizationURL string) error {
+		response, err := http.Get(authorizationURL)
+		if err != nil {
+			return err
+		}
+		response.Body.Close()
+		return nil
+	}
+
+	BeforeEach(func() {
+		authorizationError = ""
+		redirectState = ""
+		mux := http.NewServeMux()
+		mux.HandleFunc("/.well-known/openid-configuration", func(response http.ResponseWriter, req *http.Request) {
+			response.Header().Add("Content-Type", "application/json")
+			response.Write([]byte(`{"authorization_endpoint": "` + server.URL + `/authorize", "token_endpoint": "` + server.URL + `/token"}`))
+		})
+		mux.HandleFunc("/authorize", func(response http.ResponseWriter, req *http.Request) {
+			query := req.URL.Query()
+			Expect(query.Get("response_type")).To(Equal("code"))
+			Expect(query.Get("code_challenge_method")).To(Equal("S256"))
+			codeChallenge = query.Get("code_challenge")
+
+			redirect, _ := url.Parse(query.Get("redirect_uri"))
+			params := url.Values{}
+			if authorizationError != "" {
+				params.Set("error", authorizationError)
+			} else {
+				params.Set("code", "auth-code")
+			}
+			params.Set("state", query.Get("state"))
+			if redirectState != "" {
+				params.Set("state", redirectState)
+			}
+			redirect.RawQuery = params.Encode()
+			http.Redirect(response, req, redirect.String(), http.StatusFound)
+		})
+		mux.HandleFunc("/token", func(response http.ResponseWriter, req *http.Request) {
+			req.ParseForm()
+			hash := sha256.Sum256([]byte(req.Form.Get("code_verifier")))
+			response.Header().Add("Content-Type", "application/json")
+			if req.Form.Get("grant_type") != "authorization_code" || req.Form.Get("code") != "auth-code" ||
+				base64.RawURLEncoding.EncodeToString(hash[:]) != codeChallenge {
+				response.WriteHeader(http.StatusBadRequest)
+				response.Write([]byte(`{"error": "invalid_grant", "error_description": "invalid code verifier"}`))
+				return
+			}
+			response.Write([]byte(`{"access_token": "access-token", "refresh_token": "refresh-token", "token_type": "bearer", "expires_in": 599}`))
+		})
+		server = httptest.NewServer(mux)
+
+		var err error
+		authStrategy, _, err = NewOpenIDStrategy(&auth.Options{
+			IssuerURL: server.URL,
+			ClientID:  "cf",
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Context("when the user authorizes the login", func() {
+		It("should exchange the authorization code for a token", func() {
+			token, err := authStrategy.AuthorizationCode(browser)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(token.AccessToken).To(Equal("access-token"))
+			Expect(token.RefreshToken).To(Equal("refresh-token"))
+		})
+	})
+
+	Context("when the authorization server returns an error", func() {
+		It("should return it", func() {
+			authorizationError = "access_denied"
+			_, err := authStrategy.AuthorizationCode(browser)
+			Expect(err).To(MatchError("auth error: access_denied"))
+		})
+	})
+
+	Context("when the state does not match", func() {
+		It("should return error", func() {
+			redirectState = "forged"
+			_, err := authStrategy.AuthorizationCode(browser)
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid state"))
+		})
+	})
+
+	Context("when the authorization URL cannot be opened", func() {
+		It("should return error", func() {
+			_, err := authStrategy.AuthorizationCode(func(string) error {
+				return errors.New("cannot open")
+			})
+			Expect(err).To(MatchError("cannot open"))
+		})
+	})
+})
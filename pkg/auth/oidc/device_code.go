@@ -0,0 +1,56 @@
+/*
+ * Copyright 2018 The Service Manager Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package oidc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Peripli/service-manager-cli/pkg/auth"
+	"golang.org/x/oauth2"
+)
+
+// DeviceCode is used to perform device authorization grant type flow (RFC 8628).
+// prompt is called with the code the user has to enter at the verification URI, then the token endpoint is polled until the login is authorized.
+func (s *OpenIDStrategy) DeviceCode(prompt func(userCode, verificationURI string)) (*auth.Token, error) {
+	if s.oauth2Config.Endpoint.DeviceAuthURL == "" {
+		return nil, errors.New("the token issuer does not support device authorization")
+	}
+
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, s.httpClient)
+	deviceAuth, err := s.oauth2Config.DeviceAuth(ctx)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	prompt(deviceAuth.UserCode, deviceAuth.VerificationURI)
+
+	token, err := s.oauth2Config.DeviceAccessToken(ctx, deviceAuth)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return nil, errors.New("device code expired before the login was authorized")
+		}
+		return nil, wrapError(err)
+	}
+
+	return &auth.Token{
+		AccessToken:  token.AccessToken,
+		RefreshToken: token.RefreshToken,
+		ExpiresIn:    token.Expiry,
+		TokenType:    token.TokenType,
+	}, nil
+}
@@ -0,0 +1,101 @@
+package oidc
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/Peripli/service-manager-cli/pkg/auth"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Device code flow", func() {
+	var server *httptest.Server
+	var configurationResponseBody string
+	var tokenResponses []string
+	var tokenRequests int
+	var userCode, verificationURI string
+
+	prompt := func(code, uri string) {
+		userCode = code
+		verificationURI = uri
+	}
+
+	newStrategy := func() auth.Authenticator {
+		authStrategy, _, err := NewOpenIDStrategy(&auth.Options{
+			IssuerURL: server.URL,
+			ClientID:  "cf",
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		return authStrategy
+	}
+
+	BeforeEach(func() {
+		userCode, verificationURI = "", ""
+		tokenRequests = 0
+		tokenResponses = []string{
+			`{"error": "authorization_pending"}`,
+			`{"access_token": "access-token", "refresh_token": "refresh-token", "token_type": "bearer", "expires_in": 599}`,
+		}
+
+		mux := http.NewServeMux()
+		mux.HandleFunc("/.well-known/openid-configuration", func(response http.ResponseWriter, req *http.Request) {
+			response.Header().Add("Content-Type", "application/json")
+			response.Write([]byte(configurationResponseBody))
+		})
+		mux.HandleFunc("/device", func(response http.ResponseWriter, req *http.Request) {
+			req.ParseForm()
+			Expect(req.Form.Get("client_id")).To(Equal("cf"))
+			response.Header().Add("Content-Type", "application/json")
+			response.Write([]byte(`{"device_code": "device-code", "user_code": "ABCD-EFGH", "verification_uri": "https://uaa.com/activate", "expires_in": 60, "interval": 1}`))
+		})
+		mux.HandleFunc("/token", func(response http.ResponseWriter, req *http.Request) {
+			req.ParseForm()
+			Expect(req.Form.Get("grant_type")).To(Equal("urn:ietf:params:oauth:grant-type:device_code"))
+			Expect(req.Form.Get("device_code")).To(Equal("device-code"))
+
+			body := tokenResponses[tokenRequests]
+			tokenRequests++
+			response.Header().Add("Content-Type", "application/json")
+			if tokenRequests < len(tokenResponses) {
+				response.WriteHeader(http.StatusBadRequest)
+			}
+			response.Write([]byte(body))
+		})
+		server = httptest.NewServer(mux)
+		configurationResponseBody = `{"token_endpoint": "` + server.URL + `/token", "device_authorization_endpoint": "` + server.URL + `/device"}`
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Context("when the user authorizes the device", func() {
+		It("should poll until the token is issued", func() {
+			token, err := newStrategy().DeviceCode(prompt)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(token.AccessToken).To(Equal("access-token"))
+			Expect(token.RefreshToken).To(Equal("refresh-token"))
+			Expect(tokenRequests).To(Equal(2))
+			Expect(userCode).To(Equal("ABCD-EFGH"))
+			Expect(verificationURI).To(Equal("https://uaa.com/activate"))
+		})
+	})
+
+	Context("when the user denies the authorization", func() {
+		It("should return error", func() {
+			tokenResponses = []string{`{"error": "access_denied", "error_description": "user denied the request"}`, ""}
+			_, err := newStrategy().DeviceCode(prompt)
+			Expect(err).To(MatchError("auth error: user denied the request"))
+		})
+	})
+
+	Context("when the token issuer does not support device authorization", func() {
+		It("should return error", func() {
+			configurationResponseBody = `{"token_endpoint": "` + server.URL + `/token"}`
+			_, err := newStrategy().DeviceCode(prompt)
+			Expect(err).To(MatchError("the token issuer does not support device authorization"))
+			Expect(userCode).To(BeEmpty())
+		})
+	})
+})
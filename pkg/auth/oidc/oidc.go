@@ -30,9 +30,10 @@ import (
 )
 
 type openIDConfiguration struct {
-	TokenEndpoint         string              `json:"token_endpoint"`
-	AuthorizationEndpoint string              `json:"authorization_endpoint"`
-	MTLSEndpointAliases   MTLSEndpointAliases `json:"mtls_endpoint_aliases"`
+	TokenEndpoint               string              `json:"token_endpoint"`
+	AuthorizationEndpoint       string              `json:"authorization_endpoint"`
+	DeviceAuthorizationEndpoint string              `json:"device_authorization_endpoint"`
+	MTLSEndpointAliases         MTLSEndpointAliases `json:"mtls_endpoint_aliases"`
 }
 
 type MTLSEndpointAliases struct {
@@ -70,6 +71,7 @@ func NewOpenIDStrategy(options *auth.Options) (*OpenIDStrategy, *auth.Options, e
 	options.AuthorizationEndpoint, options.TokenEndpoint = RetrieveAuthEndpoints(openIDConfig, util.MtlsEnabled(options))
 
 	oauthConfig = newOauth2Config(options)
+	oauthConfig.Endpoint.DeviceAuthURL = openIDConfig.DeviceAuthorizationEndpoint
 
 	ccConfig = newClientCredentialsConfig(options)
 
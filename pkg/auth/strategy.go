@@ -31,6 +31,10 @@ const (
 	ClientCredentials Flow = "client-credentials"
 	// PasswordGrant flow used for named users
 	PasswordGrant Flow = "password-grant"
+	// AuthorizationCode flow with PKCE used for named users logging in through a browser
+	AuthorizationCode Flow = "authorization-code"
+	// DeviceCode flow used for named users logging in from a machine without a browser
+	DeviceCode Flow = "device-code"
 )
 
 // Options is used to configure new authenticators and clients
@@ -66,6 +70,8 @@ type Token struct {
 type Authenticator interface {
 	ClientCredentials() (*Token, error)
 	PasswordCredentials(user, password string) (*Token, error)
+	AuthorizationCode(openURL func(authorizationURL string) error) (*Token, error)
+	DeviceCode(prompt func(userCode, verificationURI string)) (*Token, error)
 }
 
 // Client should be implemented for http like clients which do automatic authentication
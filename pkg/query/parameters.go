@@ -13,6 +13,8 @@ type Parameters struct {
 	LabelQuery    []string
 	GeneralParams []string
 	Environment   string
+	// IdempotencyKey is sent as a header of POST requests, which makes them safe to retry. It is not part of the URL.
+	IdempotencyKey string
 }
 
 // Encode encodes the parameters as URL query parameters
@@ -24,8 +24,7 @@ import (
 	"github.com/Peripli/service-manager/pkg/log"
 	"io"
 	"net/http"
-
-	"github.com/Peripli/service-manager/pkg/util"
+	"reflect"
 
 	"github.com/Peripli/service-manager/pkg/web"
 
@@ -37,68 +36,76 @@ import (
 	"github.com/Peripli/service-manager-cli/pkg/types"
 )
 
-// Client should be implemented by SM clients
+// Client should be implemented by SM clients.
+// Every method takes a context, whose cancellation or deadline aborts the HTTP requests of the call.
 //go:generate counterfeiter . Client
 type Client interface {
-	GetInfo(*query.Parameters) (*types.Info, error)
-
-	RegisterPlatform(*types.Platform, *query.Parameters) (*types.Platform, error)
-	ListPlatforms(*query.Parameters) (*types.Platforms, error)
-	UpdatePlatform(string, *types.Platform, *query.Parameters) (*types.Platform, error)
-	DeletePlatforms(*query.Parameters) error
-	DeletePlatform(id string, q *query.Parameters) (string, error)
-
-	RegisterBroker(*types.Broker, *query.Parameters) (*types.Broker, string, error)
-	GetBrokerByID(string, *query.Parameters) (*types.Broker, error)
-	ListBrokers(*query.Parameters) (*types.Brokers, error)
-	UpdateBroker(string, *types.Broker, *query.Parameters) (*types.Broker, string, error)
-	DeleteBroker(string, *query.Parameters) (string, error)
-
-	RegisterVisibility(*types.Visibility, *query.Parameters) (*types.Visibility, error)
-	ListVisibilities(*query.Parameters) (*types.Visibilities, error)
-	UpdateVisibility(string, *types.Visibility, *query.Parameters) (*types.Visibility, error)
-	DeleteVisibilities(*query.Parameters) error
-
-	ListOfferings(*query.Parameters) (*types.ServiceOfferings, error)
-	ListPlans(*query.Parameters) (*types.ServicePlans, error)
-	GetPlanByID(string, *query.Parameters) (*types.ServicePlan, error)
-	ListInstances(*query.Parameters) (*types.ServiceInstances, error)
-	GetInstanceByID(string, *query.Parameters) (*types.ServiceInstance, error)
-	GetInstanceParameters(string, *query.Parameters) (map[string]interface{}, error)
-	UpdateInstance(string, *types.ServiceInstance, *query.Parameters) (*types.ServiceInstance, string, error)
-	Provision(*types.ServiceInstance, *query.Parameters) (*types.ServiceInstance, string, error)
-	Deprovision(string, *query.Parameters) (string, error)
-
-	ListBindings(*query.Parameters) (*types.ServiceBindings, error)
-	GetBindingByID(string, *query.Parameters) (*types.ServiceBinding, error)
-	GetBindingParameters(string, *query.Parameters) (map[string]interface{}, error)
-	Bind(*types.ServiceBinding, *query.Parameters) (*types.ServiceBinding, string, error)
-	Unbind(string, *query.Parameters) (string, error)
-
-	Label(string, string, *types.LabelChanges, *query.Parameters) error
-
-	Status(string, *query.Parameters) (*types.Operation, error)
-
-	Marketplace(*query.Parameters) (*types.Marketplace, error)
+	GetInfo(context.Context, *query.Parameters) (*types.Info, error)
+
+	RegisterPlatform(context.Context, *types.Platform, *query.Parameters) (*types.Platform, error)
+	GetPlatformByID(context.Context, string, *query.Parameters) (*types.Platform, error)
+	ListPlatforms(context.Context, *query.Parameters) (*types.Platforms, error)
+	UpdatePlatform(context.Context, string, *types.Platform, *query.Parameters) (*types.Platform, error)
+	DeletePlatforms(context.Context, *query.Parameters) error
+	DeletePlatform(ctx context.Context, id string, q *query.Parameters) (string, error)
+
+	RegisterBroker(context.Context, *types.Broker, *query.Parameters) (*types.Broker, string, error)
+	GetBrokerByID(context.Context, string, *query.Parameters) (*types.Broker, error)
+	ListBrokers(context.Context, *query.Parameters) (*types.Brokers, error)
+	UpdateBroker(context.Context, string, *types.Broker, *query.Parameters) (*types.Broker, string, error)
+	DeleteBroker(context.Context, string, *query.Parameters) (string, error)
+
+	RegisterVisibility(context.Context, *types.Visibility, *query.Parameters) (*types.Visibility, error)
+	GetVisibilityByID(context.Context, string, *query.Parameters) (*types.Visibility, error)
+	ListVisibilities(context.Context, *query.Parameters) (*types.Visibilities, error)
+	UpdateVisibility(context.Context, string, *types.Visibility, *query.Parameters) (*types.Visibility, error)
+	DeleteVisibilities(context.Context, *query.Parameters) error
+
+	ListOfferings(context.Context, *query.Parameters) (*types.ServiceOfferings, error)
+	GetOfferingByID(context.Context, string, *query.Parameters) (*types.ServiceOffering, error)
+	ListPlans(context.Context, *query.Parameters) (*types.ServicePlans, error)
+	GetPlanByID(context.Context, string, *query.Parameters) (*types.ServicePlan, error)
+	ListInstances(context.Context, *query.Parameters) (*types.ServiceInstances, error)
+	ListInstancesPaged(context.Context, *query.Parameters, *PageOptions) *InstancesPager
+	GetInstanceByID(context.Context, string, *query.Parameters) (*types.ServiceInstance, error)
+	GetInstanceParameters(context.Context, string, *query.Parameters) (map[string]interface{}, error)
+	UpdateInstance(context.Context, string, *types.ServiceInstance, *query.Parameters) (*types.ServiceInstance, string, error)
+	Provision(context.Context, *types.ServiceInstance, *query.Parameters) (*types.ServiceInstance, string, error)
+	Deprovision(context.Context, string, *query.Parameters) (string, error)
+
+	ListBindings(context.Context, *query.Parameters) (*types.ServiceBindings, error)
+	GetBindingByID(context.Context, string, *query.Parameters) (*types.ServiceBinding, error)
+	GetBindingParameters(context.Context, string, *query.Parameters) (map[string]interface{}, error)
+	Bind(context.Context, *types.ServiceBinding, *query.Parameters) (*types.ServiceBinding, string, error)
+	Unbind(context.Context, string, *query.Parameters) (string, error)
+
+	Label(context.Context, string, string, *types.LabelChanges, *query.Parameters) error
+
+	Status(context.Context, string, *query.Parameters) (*types.Operation, error)
+
+	Marketplace(context.Context, *query.Parameters) (*types.Marketplace, error)
+
+	// ListPage loads a single page of the list at url into items, which should be a pointer to a slice.
+	// It returns the token of the next page or empty string if this is the last one.
+	ListPage(ctx context.Context, url string, q *query.Parameters, pageSize int, pageToken string, items interface{}) (string, error)
 
 	// Call makes HTTP request to the Service Manager server with authentication.
 	// It should be used only in case there is no already implemented method for such an operation
-	Call(method string, smpath string, body io.Reader, q *query.Parameters) (*http.Response, error)
+	Call(ctx context.Context, method string, smpath string, body io.Reader, q *query.Parameters) (*http.Response, error)
 }
 
 type serviceManagerClient struct {
-	ctx        context.Context
 	config     *ClientConfig
 	httpClient auth.Client
 }
 
 // NewClientWithAuth returns new SM Client configured with the provided configuration
-func NewClientWithAuth(httpClient auth.Client, config *ClientConfig) (Client, error) {
+func NewClientWithAuth(ctx context.Context, httpClient auth.Client, config *ClientConfig) (Client, error) {
 	if httpClient == nil {
 		httpClient = http.DefaultClient
 	}
-	client := &serviceManagerClient{ctx: context.Background(), config: config, httpClient: httpClient}
-	info, err := client.GetInfo(nil)
+	client := &serviceManagerClient{config: config, httpClient: httpClient}
+	info, err := client.GetInfo(ctx, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -124,24 +131,30 @@ func NewClientWithAuth(httpClient auth.Client, config *ClientConfig) (Client, er
 	if err != nil {
 		return nil, err
 	}
-	client = &serviceManagerClient{ctx: context.Background(), config: config, httpClient: authClient}
+	client = &serviceManagerClient{config: config, httpClient: authClient}
 
 	return client, nil
 }
 
 // NewClient returns new SM client which will use the http client provided to make calls
-func NewClient(ctx context.Context, httpClient auth.Client, URL string) Client {
-	return &serviceManagerClient{ctx: ctx, config: &ClientConfig{URL: URL}, httpClient: httpClient}
+func NewClient(httpClient auth.Client, URL string) Client {
+	return NewClientWithConfig(httpClient, &ClientConfig{URL: URL})
+}
+
+// NewClientWithConfig returns new SM client which will use the http client provided to make calls
+// and the URL and retry policy of the provided configuration
+func NewClientWithConfig(httpClient auth.Client, config *ClientConfig) Client {
+	return &serviceManagerClient{config: config, httpClient: httpClient}
 }
 
-func (client *serviceManagerClient) GetInfo(q *query.Parameters) (*types.Info, error) {
-	response, err := client.Call(http.MethodGet, web.InfoURL, nil, q)
+func (client *serviceManagerClient) GetInfo(ctx context.Context, q *query.Parameters) (*types.Info, error) {
+	response, err := client.Call(ctx, http.MethodGet, web.InfoURL, nil, q)
 	if err != nil {
 		return nil, err
 	}
 
 	if response.StatusCode != http.StatusOK {
-		return nil, util.HandleResponseError(response)
+		return nil, NewAPIError(response)
 	}
 
 	info := types.DefaultInfo
@@ -154,9 +167,9 @@ func (client *serviceManagerClient) GetInfo(q *query.Parameters) (*types.Info, e
 }
 
 // RegisterPlatform registers a platform in the service manager
-func (client *serviceManagerClient) RegisterPlatform(platform *types.Platform, q *query.Parameters) (*types.Platform, error) {
+func (client *serviceManagerClient) RegisterPlatform(ctx context.Context, platform *types.Platform, q *query.Parameters) (*types.Platform, error) {
 	var newPlatform *types.Platform
-	_, err := client.register(platform, web.PlatformsURL, q, &newPlatform)
+	_, err := client.register(ctx, platform, web.PlatformsURL, q, &newPlatform)
 	if err != nil {
 		return nil, err
 	}
@@ -164,9 +177,9 @@ func (client *serviceManagerClient) RegisterPlatform(platform *types.Platform, q
 }
 
 // RegisterBroker registers a broker in the service manager
-func (client *serviceManagerClient) RegisterBroker(broker *types.Broker, q *query.Parameters) (*types.Broker, string, error) {
+func (client *serviceManagerClient) RegisterBroker(ctx context.Context, broker *types.Broker, q *query.Parameters) (*types.Broker, string, error) {
 	var newBroker *types.Broker
-	location, err := client.register(broker, web.ServiceBrokersURL, q, &newBroker)
+	location, err := client.register(ctx, broker, web.ServiceBrokersURL, q, &newBroker)
 	if err != nil {
 		return nil, "", err
 	}
@@ -174,9 +187,9 @@ func (client *serviceManagerClient) RegisterBroker(broker *types.Broker, q *quer
 }
 
 // RegisterVisibility registers a visibility in the service manager
-func (client *serviceManagerClient) RegisterVisibility(visibility *types.Visibility, q *query.Parameters) (*types.Visibility, error) {
+func (client *serviceManagerClient) RegisterVisibility(ctx context.Context, visibility *types.Visibility, q *query.Parameters) (*types.Visibility, error) {
 	var newVisibility *types.Visibility
-	_, err := client.register(visibility, web.VisibilitiesURL, q, &newVisibility)
+	_, err := client.register(ctx, visibility, web.VisibilitiesURL, q, &newVisibility)
 	if err != nil {
 		return nil, err
 	}
@@ -184,9 +197,9 @@ func (client *serviceManagerClient) RegisterVisibility(visibility *types.Visibil
 }
 
 // Provision provisions a new service instance in service manager
-func (client *serviceManagerClient) Provision(instance *types.ServiceInstance, q *query.Parameters) (*types.ServiceInstance, string, error) {
+func (client *serviceManagerClient) Provision(ctx context.Context, instance *types.ServiceInstance, q *query.Parameters) (*types.ServiceInstance, string, error) {
 	var newInstance *types.ServiceInstance
-	location, err := client.register(instance, web.ServiceInstancesURL, q, &newInstance)
+	location, err := client.register(ctx, instance, web.ServiceInstancesURL, q, &newInstance)
 	if err != nil {
 		return nil, "", err
 	}
@@ -194,23 +207,23 @@ func (client *serviceManagerClient) Provision(instance *types.ServiceInstance, q
 }
 
 // Bind creates binding to an instance in service manager
-func (client *serviceManagerClient) Bind(binding *types.ServiceBinding, q *query.Parameters) (*types.ServiceBinding, string, error) {
+func (client *serviceManagerClient) Bind(ctx context.Context, binding *types.ServiceBinding, q *query.Parameters) (*types.ServiceBinding, string, error) {
 	var newBinding *types.ServiceBinding
-	location, err := client.register(binding, web.ServiceBindingsURL, q, &newBinding)
+	location, err := client.register(ctx, binding, web.ServiceBindingsURL, q, &newBinding)
 	if err != nil {
 		return nil, "", err
 	}
 	return newBinding, location, nil
 }
 
-func (client *serviceManagerClient) register(resource interface{}, url string, q *query.Parameters, result interface{}) (string, error) {
+func (client *serviceManagerClient) register(ctx context.Context, resource interface{}, url string, q *query.Parameters, result interface{}) (string, error) {
 	requestBody, err := json.Marshal(resource)
 	if err != nil {
 		return "", err
 	}
 
 	buffer := bytes.NewBuffer(requestBody)
-	response, err := client.Call(http.MethodPost, url, buffer, q)
+	response, err := client.Call(ctx, http.MethodPost, url, buffer, q)
 	if err != nil {
 		return "", err
 	}
@@ -221,14 +234,14 @@ func (client *serviceManagerClient) register(resource interface{}, url string, q
 	case http.StatusAccepted:
 		return response.Header.Get("Location"), nil
 	default:
-		return "", util.HandleResponseError(response)
+		return "", NewAPIError(response)
 	}
 }
 
 // GetBrokerByID returns broker registered in the Service Manager satisfying provided queries
-func (client *serviceManagerClient) GetBrokerByID(id string, q *query.Parameters) (*types.Broker, error) {
+func (client *serviceManagerClient) GetBrokerByID(ctx context.Context, id string, q *query.Parameters) (*types.Broker, error) {
 	broker := &types.Broker{}
-	err := client.get(broker, web.ServiceBrokersURL+"/"+id, &query.Parameters{
+	err := client.get(ctx, broker, web.ServiceBrokersURL+"/"+id, &query.Parameters{
 		GeneralParams: q.GeneralParams,
 	})
 
@@ -236,64 +249,99 @@ func (client *serviceManagerClient) GetBrokerByID(id string, q *query.Parameters
 }
 
 // ListBrokers returns brokers registered in the Service Manager satisfying provided queries
-func (client *serviceManagerClient) ListBrokers(q *query.Parameters) (*types.Brokers, error) {
+func (client *serviceManagerClient) ListBrokers(ctx context.Context, q *query.Parameters) (*types.Brokers, error) {
 	brokers := &types.Brokers{}
-	err := client.list(&brokers.Brokers, web.ServiceBrokersURL, q)
+	err := client.list(ctx, &brokers.Brokers, web.ServiceBrokersURL, q)
 
 	return brokers, err
 }
 
+// GetPlatformByID returns platform registered in the Service Manager satisfying provided queries
+func (client *serviceManagerClient) GetPlatformByID(ctx context.Context, id string, q *query.Parameters) (*types.Platform, error) {
+	platform := &types.Platform{}
+	err := client.get(ctx, platform, web.PlatformsURL+"/"+id, &query.Parameters{
+		GeneralParams: q.GeneralParams,
+	})
+
+	return platform, err
+}
+
 // ListPlatforms returns platforms registered in the Service Manager satisfying provided queries
-func (client *serviceManagerClient) ListPlatforms(q *query.Parameters) (*types.Platforms, error) {
+func (client *serviceManagerClient) ListPlatforms(ctx context.Context, q *query.Parameters) (*types.Platforms, error) {
 	platforms := &types.Platforms{}
-	err := client.list(&platforms.Platforms, web.PlatformsURL, q)
+	err := client.list(ctx, &platforms.Platforms, web.PlatformsURL, q)
 
 	return platforms, err
 }
 
 // ListOfferings returns offerings registered in the Service Manager satisfying provided queries
-func (client *serviceManagerClient) ListOfferings(q *query.Parameters) (*types.ServiceOfferings, error) {
+func (client *serviceManagerClient) ListOfferings(ctx context.Context, q *query.Parameters) (*types.ServiceOfferings, error) {
 	offerings := &types.ServiceOfferings{}
-	err := client.list(&offerings.ServiceOfferings, web.ServiceOfferingsURL, q)
+	err := client.list(ctx, &offerings.ServiceOfferings, web.ServiceOfferingsURL, q)
 
 	return offerings, err
 }
 
+// GetOfferingByID returns offering registered in the Service Manager satisfying provided queries
+func (client *serviceManagerClient) GetOfferingByID(ctx context.Context, id string, q *query.Parameters) (*types.ServiceOffering, error) {
+	offering := &types.ServiceOffering{}
+	err := client.get(ctx, offering, web.ServiceOfferingsURL+"/"+id, &query.Parameters{
+		GeneralParams: q.GeneralParams,
+	})
+
+	return offering, err
+}
+
 // ListPlans returns plans registered in the Service Manager satisfying provided queries
-func (client *serviceManagerClient) ListPlans(q *query.Parameters) (*types.ServicePlans, error) {
+func (client *serviceManagerClient) ListPlans(ctx context.Context, q *query.Parameters) (*types.ServicePlans, error) {
 	plans := &types.ServicePlans{}
-	err := client.list(&plans.ServicePlans, web.ServicePlansURL, q)
+	err := client.list(ctx, &plans.ServicePlans, web.ServicePlansURL, q)
 	return plans, err
 }
 
+// GetVisibilityByID returns visibility registered in the Service Manager satisfying provided queries
+func (client *serviceManagerClient) GetVisibilityByID(ctx context.Context, id string, q *query.Parameters) (*types.Visibility, error) {
+	visibility := &types.Visibility{}
+	err := client.get(ctx, visibility, web.VisibilitiesURL+"/"+id, &query.Parameters{
+		GeneralParams: q.GeneralParams,
+	})
+
+	return visibility, err
+}
+
 // ListVisibilities returns visibilities registered in the Service Manager satisfying provided queries
-func (client *serviceManagerClient) ListVisibilities(q *query.Parameters) (*types.Visibilities, error) {
+func (client *serviceManagerClient) ListVisibilities(ctx context.Context, q *query.Parameters) (*types.Visibilities, error) {
 	visibilities := &types.Visibilities{}
-	err := client.list(&visibilities.Visibilities, web.VisibilitiesURL, q)
+	err := client.list(ctx, &visibilities.Visibilities, web.VisibilitiesURL, q)
 
 	return visibilities, err
 }
 
 // ListInstances returns service instances registered in the Service Manager satisfying provided queries
-func (client *serviceManagerClient) ListInstances(q *query.Parameters) (*types.ServiceInstances, error) {
+func (client *serviceManagerClient) ListInstances(ctx context.Context, q *query.Parameters) (*types.ServiceInstances, error) {
 	instances := &types.ServiceInstances{}
-	err := client.list(&instances.ServiceInstances, web.ServiceInstancesURL, q)
+	err := client.list(ctx, &instances.ServiceInstances, web.ServiceInstancesURL, q)
 
 	return instances, err
 }
 
+// ListInstancesPaged returns a pager which loads service instances satisfying provided queries one page at a time
+func (client *serviceManagerClient) ListInstancesPaged(ctx context.Context, q *query.Parameters, options *PageOptions) *InstancesPager {
+	return &InstancesPager{Pager: NewPager(ctx, client, web.ServiceInstancesURL, q, options)}
+}
+
 // GetInstanceParameters returns service instance configuration parameters
-func (client *serviceManagerClient) GetInstanceParameters(id string, q *query.Parameters) (map[string]interface{}, error) {
+func (client *serviceManagerClient) GetInstanceParameters(ctx context.Context, id string, q *query.Parameters) (map[string]interface{}, error) {
 	parameters := make(map[string]interface{})
-	err := client.get(&parameters, web.ServiceInstancesURL+"/"+id+web.ParametersURL, q)
+	err := client.get(ctx, &parameters, web.ServiceInstancesURL+"/"+id+web.ParametersURL, q)
 
 	return parameters, err
 }
 
 // GetPlanByID returns pla by id
-func (client *serviceManagerClient) GetPlanByID(id string, q *query.Parameters) (*types.ServicePlan, error) {
+func (client *serviceManagerClient) GetPlanByID(ctx context.Context, id string, q *query.Parameters) (*types.ServicePlan, error) {
 	plan := &types.ServicePlan{}
-	err := client.get(plan, web.ServicePlansURL+"/"+id, &query.Parameters{
+	err := client.get(ctx, plan, web.ServicePlansURL+"/"+id, &query.Parameters{
 		GeneralParams: q.GeneralParams,
 	})
 
@@ -301,9 +349,9 @@ func (client *serviceManagerClient) GetPlanByID(id string, q *query.Parameters)
 }
 
 // GetInstanceByID returns instance registered in the Service Manager satisfying provided queries
-func (client *serviceManagerClient) GetInstanceByID(id string, q *query.Parameters) (*types.ServiceInstance, error) {
+func (client *serviceManagerClient) GetInstanceByID(ctx context.Context, id string, q *query.Parameters) (*types.ServiceInstance, error) {
 	instance := &types.ServiceInstance{}
-	err := client.get(instance, web.ServiceInstancesURL+"/"+id, &query.Parameters{
+	err := client.get(ctx, instance, web.ServiceInstancesURL+"/"+id, &query.Parameters{
 		GeneralParams: q.GeneralParams,
 	})
 
@@ -311,25 +359,25 @@ func (client *serviceManagerClient) GetInstanceByID(id string, q *query.Paramete
 }
 
 // ListBindings returns service bindings registered in the Service Manager satisfying provided queries
-func (client *serviceManagerClient) ListBindings(q *query.Parameters) (*types.ServiceBindings, error) {
+func (client *serviceManagerClient) ListBindings(ctx context.Context, q *query.Parameters) (*types.ServiceBindings, error) {
 	bindings := &types.ServiceBindings{}
-	err := client.list(&bindings.ServiceBindings, web.ServiceBindingsURL, q)
+	err := client.list(ctx, &bindings.ServiceBindings, web.ServiceBindingsURL, q)
 
 	return bindings, err
 }
 
 // GetBindingParameters returns service binding configuration parameters
-func (client *serviceManagerClient) GetBindingParameters(id string, q *query.Parameters) (map[string]interface{}, error) {
+func (client *serviceManagerClient) GetBindingParameters(ctx context.Context, id string, q *query.Parameters) (map[string]interface{}, error) {
 	parameters := make(map[string]interface{})
-	err := client.get(&parameters, web.ServiceBindingsURL+"/"+id+web.ParametersURL, q)
+	err := client.get(ctx, &parameters, web.ServiceBindingsURL+"/"+id+web.ParametersURL, q)
 
 	return parameters, err
 }
 
 // GetBindingByID returns binding registered in the Service Manager satisfying provided queries
-func (client *serviceManagerClient) GetBindingByID(id string, q *query.Parameters) (*types.ServiceBinding, error) {
+func (client *serviceManagerClient) GetBindingByID(ctx context.Context, id string, q *query.Parameters) (*types.ServiceBinding, error) {
 	binding := &types.ServiceBinding{}
-	err := client.get(binding, web.ServiceBindingsURL+"/"+id, &query.Parameters{
+	err := client.get(ctx, binding, web.ServiceBindingsURL+"/"+id, &query.Parameters{
 		GeneralParams: q.GeneralParams,
 	})
 
@@ -337,15 +385,15 @@ func (client *serviceManagerClient) GetBindingByID(id string, q *query.Parameter
 }
 
 // Marketplace returns service offerings satisfying provided queries
-func (client *serviceManagerClient) Marketplace(q *query.Parameters) (*types.Marketplace, error) {
+func (client *serviceManagerClient) Marketplace(ctx context.Context, q *query.Parameters) (*types.Marketplace, error) {
 	marketplace := &types.Marketplace{}
-	err := client.list(&marketplace.ServiceOfferings, web.ServiceOfferingsURL, q)
+	err := client.list(ctx, &marketplace.ServiceOfferings, web.ServiceOfferingsURL, q)
 	if err != nil {
 		return nil, err
 	}
 	for i, so := range marketplace.ServiceOfferings {
 		plans := &types.ServicePlansForOffering{}
-		err := client.list(&plans.ServicePlans, web.ServicePlansURL, &query.Parameters{
+		err := client.list(ctx, &plans.ServicePlans, web.ServicePlansURL, &query.Parameters{
 			Environment:   q.Environment,
 			FieldQuery:    []string{fmt.Sprintf("service_offering_id eq '%s'", so.ID)},
 			GeneralParams: q.GeneralParams,
@@ -358,62 +406,100 @@ func (client *serviceManagerClient) Marketplace(q *query.Parameters) (*types.Mar
 	return marketplace, nil
 }
 
-func (client *serviceManagerClient) Status(url string, q *query.Parameters) (*types.Operation, error) {
+func (client *serviceManagerClient) Status(ctx context.Context, url string, q *query.Parameters) (*types.Operation, error) {
 	operation := &types.Operation{}
-	err := client.get(operation, url, &query.Parameters{
+	err := client.get(ctx, operation, url, &query.Parameters{
 		GeneralParams: q.GeneralParams,
 	})
 
 	return operation, err
 }
 
-func (client *serviceManagerClient) list(result interface{}, url string, q *query.Parameters) error {
-	fullURL := httputil.NormalizeURL(client.config.URL) + BuildURL(url, q)
-	return util.ListAll(client.ctx, client.httpClient.Do, fullURL, result)
+func (client *serviceManagerClient) list(ctx context.Context, result interface{}, url string, q *query.Parameters) error {
+	resultType := reflect.TypeOf(result)
+	if resultType.Kind() != reflect.Ptr || resultType.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("result should be a pointer to a slice, but got %v", resultType)
+	}
+
+	items := reflect.MakeSlice(resultType.Elem(), 0, 0)
+	pager := NewPager(ctx, client, url, q, nil)
+	for pager.HasNext() {
+		page := reflect.New(resultType.Elem())
+		if err := pager.Next(page.Interface()); err != nil {
+			return err
+		}
+		items = reflect.AppendSlice(items, page.Elem())
+	}
+	reflect.ValueOf(result).Elem().Set(items)
+	return nil
+}
+
+func (client *serviceManagerClient) ListPage(ctx context.Context, url string, q *query.Parameters, pageSize int, pageToken string, items interface{}) (string, error) {
+	pageQuery := &query.Parameters{}
+	if q != nil {
+		*pageQuery = *q
+	}
+	pageQuery.GeneralParams = append([]string{}, pageQuery.GeneralParams...)
+	if pageSize > 0 {
+		pageQuery.GeneralParams = append(pageQuery.GeneralParams, fmt.Sprintf("max_items=%d", pageSize))
+	}
+	if pageToken != "" {
+		pageQuery.GeneralParams = append(pageQuery.GeneralParams, "token="+pageToken)
+	}
+
+	page := struct {
+		Token string      `json:"token"`
+		Items interface{} `json:"items"`
+	}{Items: items}
+	if err := client.get(ctx, &page, url, pageQuery); err != nil {
+		return "", err
+	}
+
+	return page.Token, nil
 }
 
-func (client *serviceManagerClient) get(result interface{}, url string, q *query.Parameters) error {
-	resp, err := client.Call(http.MethodGet, url, nil, q)
+func (client *serviceManagerClient) get(ctx context.Context, result interface{}, url string, q *query.Parameters) error {
+	resp, err := client.Call(ctx, http.MethodGet, url, nil, q)
 	if err != nil {
 		return err
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return util.HandleResponseError(resp)
+		return NewAPIError(resp)
 	}
 
 	return httputil.UnmarshalResponse(resp, &result)
 }
 
-func (client *serviceManagerClient) DeleteBroker(id string, q *query.Parameters) (string, error) {
-	return client.delete(web.ServiceBrokersURL+"/"+id, q)
+func (client *serviceManagerClient) DeleteBroker(ctx context.Context, id string, q *query.Parameters) (string, error) {
+	return client.delete(ctx, web.ServiceBrokersURL+"/"+id, q)
 }
 
-func (client *serviceManagerClient) DeletePlatforms(q *query.Parameters) error {
-	_, err := client.delete(web.PlatformsURL, q)
+func (client *serviceManagerClient) DeletePlatforms(ctx context.Context, q *query.Parameters) error {
+	_, err := client.delete(ctx, web.PlatformsURL, q)
 	return err
 }
 
-func (client *serviceManagerClient) DeletePlatform(id string, q *query.Parameters) (string, error) {
-	location, err := client.delete(web.PlatformsURL+"/"+id, q)
+func (client *serviceManagerClient) DeletePlatform(ctx context.Context, id string, q *query.Parameters) (string, error) {
+	location, err := client.delete(ctx, web.PlatformsURL+"/"+id, q)
 	return location, err
 }
 
-func (client *serviceManagerClient) DeleteVisibilities(q *query.Parameters) error {
-	_, err := client.delete(web.VisibilitiesURL, q)
+func (client *serviceManagerClient) DeleteVisibilities(ctx context.Context, q *query.Parameters) error {
+	_, err := client.delete(ctx, web.VisibilitiesURL, q)
 	return err
 }
 
-func (client *serviceManagerClient) Deprovision(id string, q *query.Parameters) (string, error) {
-	return client.delete(web.ServiceInstancesURL+"/"+id, q)
+func (client *serviceManagerClient) Deprovision(ctx context.Context, id string, q *query.Parameters) (string, error) {
+	return client.delete(ctx, web.ServiceInstancesURL+"/"+id, q)
 }
 
-func (client *serviceManagerClient) Unbind(id string, q *query.Parameters) (string, error) {
-	return client.delete(web.ServiceBindingsURL+"/"+id, q)
+func (client *serviceManagerClient) Unbind(ctx context.Context, id string, q *query.Parameters) (string, error) {
+	return client.delete(ctx, web.ServiceBindingsURL+"/"+id, q)
 }
 
-func (client *serviceManagerClient) delete(url string, q *query.Parameters) (string, error) {
-	resp, err := client.Call(http.MethodDelete, url, nil, q)
+func (client *serviceManagerClient) delete(ctx context.Context, url string, q *query.Parameters) (string, error) {
+	resp, err := client.Call(ctx, http.MethodDelete, url, nil, q)
 	if err != nil {
 		return "", err
 	}
@@ -423,53 +509,53 @@ func (client *serviceManagerClient) delete(url string, q *query.Parameters) (str
 	case http.StatusAccepted:
 		return resp.Header.Get("Location"), nil
 	default:
-		return "", util.HandleResponseError(resp)
+		return "", NewAPIError(resp)
 	}
 }
 
-func (client *serviceManagerClient) UpdateBroker(id string, updatedBroker *types.Broker, q *query.Parameters) (*types.Broker, string, error) {
+func (client *serviceManagerClient) UpdateBroker(ctx context.Context, id string, updatedBroker *types.Broker, q *query.Parameters) (*types.Broker, string, error) {
 	var result *types.Broker
-	location, err := client.update(updatedBroker, web.ServiceBrokersURL, id, q, &result)
+	location, err := client.update(ctx, updatedBroker, web.ServiceBrokersURL, id, q, &result)
 	if err != nil {
 		return nil, "", err
 	}
 	return result, location, nil
 }
 
-func (client *serviceManagerClient) UpdateInstance(id string, updatedInstance *types.ServiceInstance, q *query.Parameters) (*types.ServiceInstance, string, error) {
+func (client *serviceManagerClient) UpdateInstance(ctx context.Context, id string, updatedInstance *types.ServiceInstance, q *query.Parameters) (*types.ServiceInstance, string, error) {
 	var result *types.ServiceInstance
-	location, err := client.update(updatedInstance, web.ServiceInstancesURL, id, q, &result)
+	location, err := client.update(ctx, updatedInstance, web.ServiceInstancesURL, id, q, &result)
 	if err != nil {
 		return nil, "", err
 	}
 	return result, location, nil
 }
 
-func (client *serviceManagerClient) UpdatePlatform(id string, updatedPlatform *types.Platform, q *query.Parameters) (*types.Platform, error) {
+func (client *serviceManagerClient) UpdatePlatform(ctx context.Context, id string, updatedPlatform *types.Platform, q *query.Parameters) (*types.Platform, error) {
 	result := &types.Platform{}
-	_, err := client.update(updatedPlatform, web.PlatformsURL, id, q, &result)
+	_, err := client.update(ctx, updatedPlatform, web.PlatformsURL, id, q, &result)
 	if err != nil {
 		return nil, err
 	}
 	return result, nil
 }
 
-func (client *serviceManagerClient) UpdateVisibility(id string, updatedVisibility *types.Visibility, q *query.Parameters) (*types.Visibility, error) {
+func (client *serviceManagerClient) UpdateVisibility(ctx context.Context, id string, updatedVisibility *types.Visibility, q *query.Parameters) (*types.Visibility, error) {
 	result := &types.Visibility{}
-	_, err := client.update(updatedVisibility, web.VisibilitiesURL, id, q, &result)
+	_, err := client.update(ctx, updatedVisibility, web.VisibilitiesURL, id, q, &result)
 	if err != nil {
 		return nil, err
 	}
 	return result, nil
 }
 
-func (client *serviceManagerClient) update(resource interface{}, url string, id string, q *query.Parameters, result interface{}) (string, error) {
+func (client *serviceManagerClient) update(ctx context.Context, resource interface{}, url string, id string, q *query.Parameters, result interface{}) (string, error) {
 	requestBody, err := json.Marshal(resource)
 	if err != nil {
 		return "", err
 	}
 	buffer := bytes.NewBuffer(requestBody)
-	resp, err := client.Call(http.MethodPatch, url+"/"+id, buffer, q)
+	resp, err := client.Call(ctx, http.MethodPatch, url+"/"+id, buffer, q)
 	if err != nil {
 		return "", err
 	}
@@ -480,45 +566,54 @@ func (client *serviceManagerClient) update(resource interface{}, url string, id
 	case http.StatusAccepted:
 		return resp.Header.Get("Location"), nil
 	default:
-		return "", util.HandleResponseError(resp)
+		return "", NewAPIError(resp)
 	}
 }
 
-func (client *serviceManagerClient) Label(url string, id string, change *types.LabelChanges, q *query.Parameters) error {
+func (client *serviceManagerClient) Label(ctx context.Context, url string, id string, change *types.LabelChanges, q *query.Parameters) error {
 	requestBody, err := json.Marshal(change)
 	if err != nil {
 		return err
 	}
 	buffer := bytes.NewBuffer(requestBody)
-	response, err := client.Call(http.MethodPatch, url+"/"+id, buffer, q)
+	// label changes are safe to retry, unlike other PATCH requests
+	response, err := client.call(ctx, http.MethodPatch, url+"/"+id, buffer, q, true)
 	if err != nil {
 		return err
 	}
 
 	if response.StatusCode != http.StatusOK {
-		return util.HandleResponseError(response)
+		return NewAPIError(response)
 	}
 
 	return nil
 }
 
-func (client *serviceManagerClient) Call(method string, smpath string, body io.Reader, q *query.Parameters) (*http.Response, error) {
+func (client *serviceManagerClient) Call(ctx context.Context, method string, smpath string, body io.Reader, q *query.Parameters) (*http.Response, error) {
+	return client.call(ctx, method, smpath, body, q, false)
+}
+
+// call sends the request. Idempotent requests and requests marked as retryable are retried on transient errors.
+func (client *serviceManagerClient) call(ctx context.Context, method string, smpath string, body io.Reader, q *query.Parameters, retryable bool) (*http.Response, error) {
 	fullURL := httputil.NormalizeURL(client.config.URL) + BuildURL(smpath, q)
 
-	req, err := http.NewRequest(method, fullURL, body)
+	req, err := http.NewRequestWithContext(ctx, method, fullURL, body)
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Add("Content-Type", "application/json")
+	if method == http.MethodPost && q != nil && q.IdempotencyKey != "" {
+		req.Header.Set(IdempotencyKeyHeader, q.IdempotencyKey)
+	}
 
-	log.C(client.ctx).Debugf("Sending request %s %s", req.Method, req.URL)
-	resp, err := client.httpClient.Do(req)
+	log.C(ctx).Debugf("Sending request %s %s", req.Method, req.URL)
+	resp, err := client.do(req, retryable || isIdempotent(req))
 	if err != nil {
 		return nil, err
 	}
 
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		return nil, util.HandleResponseError(resp)
+		return nil, NewAPIError(resp)
 	}
 
 	return resp, nil
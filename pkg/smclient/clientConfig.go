@@ -26,4 +26,6 @@ type ClientConfig struct {
 	ClientSecret string
 
 	SSLDisabled bool
+
+	Retry RetryPolicy
 }
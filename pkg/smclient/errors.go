@@ -0,0 +1,115 @@
+/*
+ * Copyright 2018 The Service Manager Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package smclient
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/Peripli/service-manager/pkg/log"
+	"github.com/Peripli/service-manager/pkg/util"
+)
+
+// APIError is returned when Service Manager responds to a request with an unexpected status code
+type APIError struct {
+	// StatusCode is the HTTP status code of the response
+	StatusCode int
+	// ErrorCode is the error code reported by Service Manager, e.g. NotFound or Conflict
+	ErrorCode string
+	// Description is the error description reported by Service Manager
+	Description string
+	// Method is the HTTP method of the failed request
+	Method string
+	// Path is the URL path of the failed request
+	Path string
+	// CorrelationID identifies the request in the Service Manager logs
+	CorrelationID string
+	// Body is the raw response body
+	Body []byte
+
+	url string
+}
+
+// Error formats the error the way the Service Manager libraries do, so that existing messages stay unchanged
+func (e *APIError) Error() string {
+	url := e.url
+	if url == "" {
+		url = e.Path
+	}
+	if e.Method == "" && url == "" {
+		return fmt.Sprintf("request failed: StatusCode: %d Body: %s", e.StatusCode, e.Body)
+	}
+	return fmt.Sprintf("request %s %s failed: StatusCode: %d Body: %s", e.Method, url, e.StatusCode, e.Body)
+}
+
+// NewAPIError builds an APIError from the response of a failed request and closes its body
+func NewAPIError(response *http.Response) *APIError {
+	apiErr := &APIError{StatusCode: response.StatusCode}
+
+	if response.Body != nil {
+		body, err := util.BodyToBytes(response.Body)
+		if err != nil {
+			body = []byte(fmt.Sprintf("error reading response body: %s", err))
+		}
+		apiErr.Body = body
+	}
+
+	httpErr := &util.HTTPError{}
+	if json.Unmarshal(apiErr.Body, httpErr) == nil {
+		apiErr.ErrorCode = httpErr.ErrorType
+		apiErr.Description = httpErr.Description
+	}
+
+	apiErr.CorrelationID = correlationID(response.Header)
+	if response.Request != nil {
+		apiErr.Method = response.Request.Method
+		apiErr.Path = response.Request.URL.Path
+		apiErr.url = response.Request.URL.String()
+		if apiErr.CorrelationID == "" {
+			apiErr.CorrelationID = correlationID(response.Request.Header)
+		}
+	}
+
+	return apiErr
+}
+
+func correlationID(header http.Header) string {
+	for _, name := range log.CorrelationIDHeaders {
+		if id := header.Get(name); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// HasStatus returns whether err is an APIError with the provided status code
+func HasStatus(err error, statusCode int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == statusCode
+}
+
+// IsNotFound returns whether err reports that the requested resource does not exist
+func IsNotFound(err error) bool {
+	return HasStatus(err, http.StatusNotFound)
+}
+
+// IsConflict returns whether err reports a conflict with the current state of a resource
+func IsConflict(err error) bool {
+	return HasStatus(err, http.StatusConflict)
+}
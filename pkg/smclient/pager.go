@@ -0,0 +1,112 @@
+/*
+ * Copyright 2018 The Service Manager Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package smclient
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/Peripli/service-manager-cli/pkg/query"
+	"github.com/Peripli/service-manager-cli/pkg/types"
+)
+
+// PageOptions controls how a list is loaded page by page
+type PageOptions struct {
+	// PageSize is the maximum number of items in a page. Zero means the server default.
+	PageSize int
+	// PageToken is the token of the page to start from. Empty means the first page.
+	PageToken string
+	// MaxItems is the maximum number of items to load across all pages. Zero means no limit.
+	MaxItems int
+}
+
+// Pager loads the items of a list one page at a time
+type Pager struct {
+	ctx     context.Context
+	client  Client
+	url     string
+	q       *query.Parameters
+	options PageOptions
+
+	token   string
+	fetched int
+	done    bool
+}
+
+// NewPager returns a pager over the items at url which satisfy the provided queries.
+// All pages are loaded with the provided context.
+func NewPager(ctx context.Context, client Client, url string, q *query.Parameters, options *PageOptions) *Pager {
+	pager := &Pager{ctx: ctx, client: client, url: url, q: q}
+	if options != nil {
+		pager.options = *options
+	}
+	pager.token = pager.options.PageToken
+	return pager
+}
+
+// HasNext returns whether there are more pages to load
+func (p *Pager) HasNext() bool {
+	return !p.done
+}
+
+// Next loads the next page into items, which should be a pointer to a slice
+func (p *Pager) Next(items interface{}) error {
+	itemsType := reflect.TypeOf(items)
+	if itemsType == nil || itemsType.Kind() != reflect.Ptr || itemsType.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("items should be a pointer to a slice, but got %v", itemsType)
+	}
+	if p.done {
+		return fmt.Errorf("there are no more pages to load")
+	}
+
+	pageSize := p.options.PageSize
+	if p.options.MaxItems > 0 {
+		remaining := p.options.MaxItems - p.fetched
+		if pageSize <= 0 || pageSize > remaining {
+			pageSize = remaining
+		}
+	}
+
+	token, err := p.client.ListPage(p.ctx, p.url, p.q, pageSize, p.token, items)
+	if err != nil {
+		return err
+	}
+
+	p.token = token
+	p.fetched += reflect.ValueOf(items).Elem().Len()
+	p.done = token == "" || (p.options.MaxItems > 0 && p.fetched >= p.options.MaxItems)
+	return nil
+}
+
+// Token returns the token of the next page, or empty string if all pages were loaded
+func (p *Pager) Token() string {
+	return p.token
+}
+
+// InstancesPager loads service instances one page at a time
+type InstancesPager struct {
+	*Pager
+}
+
+// Next loads the next page of service instances
+func (p *InstancesPager) Next() (*types.ServiceInstances, error) {
+	instances := &types.ServiceInstances{}
+	err := p.Pager.Next(&instances.ServiceInstances)
+
+	return instances, err
+}
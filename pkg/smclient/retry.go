@@ -0,0 +1,188 @@
+/*
+ * Copyright 2018 The Service Manager Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package smclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Peripli/service-manager/pkg/log"
+)
+
+const (
+	// DefaultMaxRetries is the number of retries used by smctl unless configured otherwise
+	DefaultMaxRetries = 3
+	// DefaultInitialBackoff is the delay before the first retry if the policy does not set one
+	DefaultInitialBackoff = 500 * time.Millisecond
+	// DefaultMaxBackoff is the maximum delay between retries if the policy does not set one
+	DefaultMaxBackoff = 10 * time.Second
+
+	// IdempotencyKeyHeader is the header which makes POST requests safe to retry
+	IdempotencyKeyHeader = "Idempotency-Key"
+)
+
+// RetryPolicy configures how requests failing with transient errors are retried.
+// Only idempotent requests are retried: GET, HEAD, DELETE, label changes and POST requests with an idempotency key.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of retries after the first attempt. Zero disables retries.
+	MaxRetries int
+	// InitialBackoff is the delay before the first retry. It doubles with every further retry and is randomized by up to a half.
+	InitialBackoff time.Duration
+	// MaxBackoff limits the computed delay between retries. A Retry-After header sent by the server takes precedence over it,
+	// unless waiting for it exceeds the deadline of the request, which ends the retries.
+	MaxBackoff time.Duration
+}
+
+func (p RetryPolicy) maxBackoff() time.Duration {
+	if p.MaxBackoff <= 0 {
+		return DefaultMaxBackoff
+	}
+	return p.MaxBackoff
+}
+
+// backoff returns the delay before the retry following the provided attempt, counted from zero
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	initial, max := p.InitialBackoff, p.maxBackoff()
+	if initial <= 0 {
+		initial = DefaultInitialBackoff
+	}
+
+	delay := initial
+	for i := 0; i < attempt && delay < max; i++ {
+		delay *= 2
+	}
+	if delay > max {
+		delay = max
+	}
+	// full delay minus a random jitter of up to a half, so that clients do not retry in lockstep
+	return delay - time.Duration(rand.Int63n(int64(delay)/2+1))
+}
+
+// do sends the request, retrying it according to the retry policy of the client if it is idempotent
+func (client *serviceManagerClient) do(req *http.Request, idempotent bool) (*http.Response, error) {
+	policy := client.config.Retry
+	for attempt := 0; ; attempt++ {
+		resp, err := client.httpClient.Do(req)
+		if attempt >= policy.MaxRetries || !idempotent || !isTransient(resp, err) {
+			return resp, err
+		}
+		if req.Body != nil && req.GetBody == nil {
+			return resp, err
+		}
+
+		delay := policy.backoff(attempt)
+		if resp != nil {
+			if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+				if exceedsDeadline(req.Context(), retryAfter) {
+					log.C(req.Context()).Debugf("Request %s %s failed with status %d, not retrying as the server asks to wait %s", req.Method, req.URL, resp.StatusCode, retryAfter)
+					return resp, err
+				}
+				delay = retryAfter
+			}
+			drainAndClose(resp.Body)
+			log.C(req.Context()).Debugf("Request %s %s failed with status %d, retrying in %s", req.Method, req.URL, resp.StatusCode, delay)
+		} else {
+			log.C(req.Context()).Debugf("Request %s %s failed: %s, retrying in %s", req.Method, req.URL, err, delay)
+		}
+
+		if err := sleep(req.Context(), delay); err != nil {
+			return nil, err
+		}
+		if req, err = rewind(req); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodDelete:
+		return true
+	case http.MethodPost:
+		return req.Header.Get(IdempotencyKeyHeader) != ""
+	}
+	return false
+}
+
+func isTransient(resp *http.Response, err error) bool {
+	if err != nil {
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as an HTTP date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+	return 0, false
+}
+
+// exceedsDeadline returns whether the context ends before the delay is over
+func exceedsDeadline(ctx context.Context, delay time.Duration) bool {
+	deadline, ok := ctx.Deadline()
+	return ok && time.Until(deadline) < delay
+}
+
+func sleep(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func rewind(req *http.Request) (*http.Request, error) {
+	retry := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		retry.Body = body
+	}
+	return retry, nil
+}
+
+func drainAndClose(body io.ReadCloser) {
+	_, _ = io.Copy(ioutil.Discard, body)
+	_ = body.Close()
+}
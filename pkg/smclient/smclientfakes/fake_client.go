@@ -2,6 +2,7 @@
 package smclientfakes
 
 import (
+	"context"
 	"io"
 	"net/http"
 	"sync"
@@ -12,11 +13,12 @@ import (
 )
 
 type FakeClient struct {
-	BindStub        func(*types.ServiceBinding, *query.Parameters) (*types.ServiceBinding, string, error)
+	BindStub        func(context.Context, *types.ServiceBinding, *query.Parameters) (*types.ServiceBinding, string, error)
 	bindMutex       sync.RWMutex
 	bindArgsForCall []struct {
-		arg1 *types.ServiceBinding
-		arg2 *query.Parameters
+		arg1 context.Context
+		arg2 *types.ServiceBinding
+		arg3 *query.Parameters
 	}
 	bindReturns struct {
 		result1 *types.ServiceBinding
@@ -28,13 +30,14 @@ type FakeClient struct {
 		result2 string
 		result3 error
 	}
-	CallStub        func(string, string, io.Reader, *query.Parameters) (*http.Response, error)
+	CallStub        func(context.Context, string, string, io.Reader, *query.Parameters) (*http.Response, error)
 	callMutex       sync.RWMutex
 	callArgsForCall []struct {
-		arg1 string
+		arg1 context.Context
 		arg2 string
-		arg3 io.Reader
-		arg4 *query.Parameters
+		arg3 string
+		arg4 io.Reader
+		arg5 *query.Parameters
 	}
 	callReturns struct {
 		result1 *http.Response
@@ -44,11 +47,12 @@ type FakeClient struct {
 		result1 *http.Response
 		result2 error
 	}
-	DeleteBrokerStub        func(string, *query.Parameters) (string, error)
+	DeleteBrokerStub        func(context.Context, string, *query.Parameters) (string, error)
 	deleteBrokerMutex       sync.RWMutex
 	deleteBrokerArgsForCall []struct {
-		arg1 string
-		arg2 *query.Parameters
+		arg1 context.Context
+		arg2 string
+		arg3 *query.Parameters
 	}
 	deleteBrokerReturns struct {
 		result1 string
@@ -58,11 +62,12 @@ type FakeClient struct {
 		result1 string
 		result2 error
 	}
-	DeletePlatformStub        func(string, *query.Parameters) (string, error)
+	DeletePlatformStub        func(context.Context, string, *query.Parameters) (string, error)
 	deletePlatformMutex       sync.RWMutex
 	deletePlatformArgsForCall []struct {
-		arg1 string
-		arg2 *query.Parameters
+		arg1 context.Context
+		arg2 string
+		arg3 *query.Parameters
 	}
 	deletePlatformReturns struct {
 		result1 string
@@ -72,10 +77,11 @@ type FakeClient struct {
 		result1 string
 		result2 error
 	}
-	DeletePlatformsStub        func(*query.Parameters) error
+	DeletePlatformsStub        func(context.Context, *query.Parameters) error
 	deletePlatformsMutex       sync.RWMutex
 	deletePlatformsArgsForCall []struct {
-		arg1 *query.Parameters
+		arg1 context.Context
+		arg2 *query.Parameters
 	}
 	deletePlatformsReturns struct {
 		result1 error
@@ -83,10 +89,11 @@ type FakeClient struct {
 	deletePlatformsReturnsOnCall map[int]struct {
 		result1 error
 	}
-	DeleteVisibilitiesStub        func(*query.Parameters) error
+	DeleteVisibilitiesStub        func(context.Context, *query.Parameters) error
 	deleteVisibilitiesMutex       sync.RWMutex
 	deleteVisibilitiesArgsForCall []struct {
-		arg1 *query.Parameters
+		arg1 context.Context
+		arg2 *query.Parameters
 	}
 	deleteVisibilitiesReturns struct {
 		result1 error
@@ -94,11 +101,12 @@ type FakeClient struct {
 	deleteVisibilitiesReturnsOnCall map[int]struct {
 		result1 error
 	}
-	DeprovisionStub        func(string, *query.Parameters) (string, error)
+	DeprovisionStub        func(context.Context, string, *query.Parameters) (string, error)
 	deprovisionMutex       sync.RWMutex
 	deprovisionArgsForCall []struct {
-		arg1 string
-		arg2 *query.Parameters
+		arg1 context.Context
+		arg2 string
+		arg3 *query.Parameters
 	}
 	deprovisionReturns struct {
 		result1 string
@@ -108,11 +116,12 @@ type FakeClient struct {
 		result1 string
 		result2 error
 	}
-	GetBindingByIDStub        func(string, *query.Parameters) (*types.ServiceBinding, error)
+	GetBindingByIDStub        func(context.Context, string, *query.Parameters) (*types.ServiceBinding, error)
 	getBindingByIDMutex       sync.RWMutex
 	getBindingByIDArgsForCall []struct {
-		arg1 string
-		arg2 *query.Parameters
+		arg1 context.Context
+		arg2 string
+		arg3 *query.Parameters
 	}
 	getBindingByIDReturns struct {
 		result1 *types.ServiceBinding
@@ -122,11 +131,12 @@ type FakeClient struct {
 		result1 *types.ServiceBinding
 		result2 error
 	}
-	GetBindingParametersStub        func(string, *query.Parameters) (map[string]interface{}, error)
+	GetBindingParametersStub        func(context.Context, string, *query.Parameters) (map[string]interface{}, error)
 	getBindingParametersMutex       sync.RWMutex
 	getBindingParametersArgsForCall []struct {
-		arg1 string
-		arg2 *query.Parameters
+		arg1 context.Context
+		arg2 string
+		arg3 *query.Parameters
 	}
 	getBindingParametersReturns struct {
 		result1 map[string]interface{}
@@ -136,11 +146,12 @@ type FakeClient struct {
 		result1 map[string]interface{}
 		result2 error
 	}
-	GetBrokerByIDStub        func(string, *query.Parameters) (*types.Broker, error)
+	GetBrokerByIDStub        func(context.Context, string, *query.Parameters) (*types.Broker, error)
 	getBrokerByIDMutex       sync.RWMutex
 	getBrokerByIDArgsForCall []struct {
-		arg1 string
-		arg2 *query.Parameters
+		arg1 context.Context
+		arg2 string
+		arg3 *query.Parameters
 	}
 	getBrokerByIDReturns struct {
 		result1 *types.Broker
@@ -150,10 +161,11 @@ type FakeClient struct {
 		result1 *types.Broker
 		result2 error
 	}
-	GetInfoStub        func(*query.Parameters) (*types.Info, error)
+	GetInfoStub        func(context.Context, *query.Parameters) (*types.Info, error)
 	getInfoMutex       sync.RWMutex
 	getInfoArgsForCall []struct {
-		arg1 *query.Parameters
+		arg1 context.Context
+		arg2 *query.Parameters
 	}
 	getInfoReturns struct {
 		result1 *types.Info
@@ -163,11 +175,12 @@ type FakeClient struct {
 		result1 *types.Info
 		result2 error
 	}
-	GetInstanceByIDStub        func(string, *query.Parameters) (*types.ServiceInstance, error)
+	GetInstanceByIDStub        func(context.Context, string, *query.Parameters) (*types.ServiceInstance, error)
 	getInstanceByIDMutex       sync.RWMutex
 	getInstanceByIDArgsForCall []struct {
-		arg1 string
-		arg2 *query.Parameters
+		arg1 context.Context
+		arg2 string
+		arg3 *query.Parameters
 	}
 	getInstanceByIDReturns struct {
 		result1 *types.ServiceInstance
@@ -177,11 +190,12 @@ type FakeClient struct {
 		result1 *types.ServiceInstance
 		result2 error
 	}
-	GetInstanceParametersStub        func(string, *query.Parameters) (map[string]interface{}, error)
+	GetInstanceParametersStub        func(context.Context, string, *query.Parameters) (map[string]interface{}, error)
 	getInstanceParametersMutex       sync.RWMutex
 	getInstanceParametersArgsForCall []struct {
-		arg1 string
-		arg2 *query.Parameters
+		arg1 context.Context
+		arg2 string
+		arg3 *query.Parameters
 	}
 	getInstanceParametersReturns struct {
 		result1 map[string]interface{}
@@ -191,13 +205,74 @@ type FakeClient struct {
 		result1 map[string]interface{}
 		result2 error
 	}
-	LabelStub        func(string, string, *types.LabelChanges, *query.Parameters) error
+	GetOfferingByIDStub        func(context.Context, string, *query.Parameters) (*types.ServiceOffering, error)
+	getOfferingByIDMutex       sync.RWMutex
+	getOfferingByIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *query.Parameters
+	}
+	getOfferingByIDReturns struct {
+		result1 *types.ServiceOffering
+		result2 error
+	}
+	getOfferingByIDReturnsOnCall map[int]struct {
+		result1 *types.ServiceOffering
+		result2 error
+	}
+	GetPlanByIDStub        func(context.Context, string, *query.Parameters) (*types.ServicePlan, error)
+	getPlanByIDMutex       sync.RWMutex
+	getPlanByIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *query.Parameters
+	}
+	getPlanByIDReturns struct {
+		result1 *types.ServicePlan
+		result2 error
+	}
+	getPlanByIDReturnsOnCall map[int]struct {
+		result1 *types.ServicePlan
+		result2 error
+	}
+	GetPlatformByIDStub        func(context.Context, string, *query.Parameters) (*types.Platform, error)
+	getPlatformByIDMutex       sync.RWMutex
+	getPlatformByIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *query.Parameters
+	}
+	getPlatformByIDReturns struct {
+		result1 *types.Platform
+		result2 error
+	}
+	getPlatformByIDReturnsOnCall map[int]struct {
+		result1 *types.Platform
+		result2 error
+	}
+	GetVisibilityByIDStub        func(context.Context, string, *query.Parameters) (*types.Visibility, error)
+	getVisibilityByIDMutex       sync.RWMutex
+	getVisibilityByIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *query.Parameters
+	}
+	getVisibilityByIDReturns struct {
+		result1 *types.Visibility
+		result2 error
+	}
+	getVisibilityByIDReturnsOnCall map[int]struct {
+		result1 *types.Visibility
+		result2 error
+	}
+	LabelStub        func(context.Context, string, string, *types.LabelChanges, *query.Parameters) error
 	labelMutex       sync.RWMutex
 	labelArgsForCall []struct {
-		arg1 string
+		arg1 context.Context
 		arg2 string
-		arg3 *types.LabelChanges
-		arg4 *query.Parameters
+		arg3 string
+		arg4 *types.LabelChanges
+		arg5 *query.Parameters
 	}
 	labelReturns struct {
 		result1 error
@@ -205,10 +280,11 @@ type FakeClient struct {
 	labelReturnsOnCall map[int]struct {
 		result1 error
 	}
-	ListBindingsStub        func(*query.Parameters) (*types.ServiceBindings, error)
+	ListBindingsStub        func(context.Context, *query.Parameters) (*types.ServiceBindings, error)
 	listBindingsMutex       sync.RWMutex
 	listBindingsArgsForCall []struct {
-		arg1 *query.Parameters
+		arg1 context.Context
+		arg2 *query.Parameters
 	}
 	listBindingsReturns struct {
 		result1 *types.ServiceBindings
@@ -218,10 +294,11 @@ type FakeClient struct {
 		result1 *types.ServiceBindings
 		result2 error
 	}
-	ListBrokersStub        func(*query.Parameters) (*types.Brokers, error)
+	ListBrokersStub        func(context.Context, *query.Parameters) (*types.Brokers, error)
 	listBrokersMutex       sync.RWMutex
 	listBrokersArgsForCall []struct {
-		arg1 *query.Parameters
+		arg1 context.Context
+		arg2 *query.Parameters
 	}
 	listBrokersReturns struct {
 		result1 *types.Brokers
@@ -231,10 +308,11 @@ type FakeClient struct {
 		result1 *types.Brokers
 		result2 error
 	}
-	ListInstancesStub        func(*query.Parameters) (*types.ServiceInstances, error)
+	ListInstancesStub        func(context.Context, *query.Parameters) (*types.ServiceInstances, error)
 	listInstancesMutex       sync.RWMutex
 	listInstancesArgsForCall []struct {
-		arg1 *query.Parameters
+		arg1 context.Context
+		arg2 *query.Parameters
 	}
 	listInstancesReturns struct {
 		result1 *types.ServiceInstances
@@ -244,10 +322,24 @@ type FakeClient struct {
 		result1 *types.ServiceInstances
 		result2 error
 	}
-	ListOfferingsStub        func(*query.Parameters) (*types.ServiceOfferings, error)
+	ListInstancesPagedStub        func(context.Context, *query.Parameters, *smclient.PageOptions) *smclient.InstancesPager
+	listInstancesPagedMutex       sync.RWMutex
+	listInstancesPagedArgsForCall []struct {
+		arg1 context.Context
+		arg2 *query.Parameters
+		arg3 *smclient.PageOptions
+	}
+	listInstancesPagedReturns struct {
+		result1 *smclient.InstancesPager
+	}
+	listInstancesPagedReturnsOnCall map[int]struct {
+		result1 *smclient.InstancesPager
+	}
+	ListOfferingsStub        func(context.Context, *query.Parameters) (*types.ServiceOfferings, error)
 	listOfferingsMutex       sync.RWMutex
 	listOfferingsArgsForCall []struct {
-		arg1 *query.Parameters
+		arg1 context.Context
+		arg2 *query.Parameters
 	}
 	listOfferingsReturns struct {
 		result1 *types.ServiceOfferings
@@ -257,10 +349,29 @@ type FakeClient struct {
 		result1 *types.ServiceOfferings
 		result2 error
 	}
-	ListPlansStub        func(*query.Parameters) (*types.ServicePlans, error)
+	ListPageStub        func(context.Context, string, *query.Parameters, int, string, interface{}) (string, error)
+	listPageMutex       sync.RWMutex
+	listPageArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *query.Parameters
+		arg4 int
+		arg5 string
+		arg6 interface{}
+	}
+	listPageReturns struct {
+		result1 string
+		result2 error
+	}
+	listPageReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	ListPlansStub        func(context.Context, *query.Parameters) (*types.ServicePlans, error)
 	listPlansMutex       sync.RWMutex
 	listPlansArgsForCall []struct {
-		arg1 *query.Parameters
+		arg1 context.Context
+		arg2 *query.Parameters
 	}
 	listPlansReturns struct {
 		result1 *types.ServicePlans
@@ -270,10 +381,11 @@ type FakeClient struct {
 		result1 *types.ServicePlans
 		result2 error
 	}
-	ListPlatformsStub        func(*query.Parameters) (*types.Platforms, error)
+	ListPlatformsStub        func(context.Context, *query.Parameters) (*types.Platforms, error)
 	listPlatformsMutex       sync.RWMutex
 	listPlatformsArgsForCall []struct {
-		arg1 *query.Parameters
+		arg1 context.Context
+		arg2 *query.Parameters
 	}
 	listPlatformsReturns struct {
 		result1 *types.Platforms
@@ -283,10 +395,11 @@ type FakeClient struct {
 		result1 *types.Platforms
 		result2 error
 	}
-	ListVisibilitiesStub        func(*query.Parameters) (*types.Visibilities, error)
+	ListVisibilitiesStub        func(context.Context, *query.Parameters) (*types.Visibilities, error)
 	listVisibilitiesMutex       sync.RWMutex
 	listVisibilitiesArgsForCall []struct {
-		arg1 *query.Parameters
+		arg1 context.Context
+		arg2 *query.Parameters
 	}
 	listVisibilitiesReturns struct {
 		result1 *types.Visibilities
@@ -296,10 +409,11 @@ type FakeClient struct {
 		result1 *types.Visibilities
 		result2 error
 	}
-	MarketplaceStub        func(*query.Parameters) (*types.Marketplace, error)
+	MarketplaceStub        func(context.Context, *query.Parameters) (*types.Marketplace, error)
 	marketplaceMutex       sync.RWMutex
 	marketplaceArgsForCall []struct {
-		arg1 *query.Parameters
+		arg1 context.Context
+		arg2 *query.Parameters
 	}
 	marketplaceReturns struct {
 		result1 *types.Marketplace
@@ -309,11 +423,12 @@ type FakeClient struct {
 		result1 *types.Marketplace
 		result2 error
 	}
-	ProvisionStub        func(*types.ServiceInstance, *query.Parameters) (*types.ServiceInstance, string, error)
+	ProvisionStub        func(context.Context, *types.ServiceInstance, *query.Parameters) (*types.ServiceInstance, string, error)
 	provisionMutex       sync.RWMutex
 	provisionArgsForCall []struct {
-		arg1 *types.ServiceInstance
-		arg2 *query.Parameters
+		arg1 context.Context
+		arg2 *types.ServiceInstance
+		arg3 *query.Parameters
 	}
 	provisionReturns struct {
 		result1 *types.ServiceInstance
@@ -325,11 +440,12 @@ type FakeClient struct {
 		result2 string
 		result3 error
 	}
-	RegisterBrokerStub        func(*types.Broker, *query.Parameters) (*types.Broker, string, error)
+	RegisterBrokerStub        func(context.Context, *types.Broker, *query.Parameters) (*types.Broker, string, error)
 	registerBrokerMutex       sync.RWMutex
 	registerBrokerArgsForCall []struct {
-		arg1 *types.Broker
-		arg2 *query.Parameters
+		arg1 context.Context
+		arg2 *types.Broker
+		arg3 *query.Parameters
 	}
 	registerBrokerReturns struct {
 		result1 *types.Broker
@@ -341,11 +457,12 @@ type FakeClient struct {
 		result2 string
 		result3 error
 	}
-	RegisterPlatformStub        func(*types.Platform, *query.Parameters) (*types.Platform, error)
+	RegisterPlatformStub        func(context.Context, *types.Platform, *query.Parameters) (*types.Platform, error)
 	registerPlatformMutex       sync.RWMutex
 	registerPlatformArgsForCall []struct {
-		arg1 *types.Platform
-		arg2 *query.Parameters
+		arg1 context.Context
+		arg2 *types.Platform
+		arg3 *query.Parameters
 	}
 	registerPlatformReturns struct {
 		result1 *types.Platform
@@ -355,11 +472,12 @@ type FakeClient struct {
 		result1 *types.Platform
 		result2 error
 	}
-	RegisterVisibilityStub        func(*types.Visibility, *query.Parameters) (*types.Visibility, error)
+	RegisterVisibilityStub        func(context.Context, *types.Visibility, *query.Parameters) (*types.Visibility, error)
 	registerVisibilityMutex       sync.RWMutex
 	registerVisibilityArgsForCall []struct {
-		arg1 *types.Visibility
-		arg2 *query.Parameters
+		arg1 context.Context
+		arg2 *types.Visibility
+		arg3 *query.Parameters
 	}
 	registerVisibilityReturns struct {
 		result1 *types.Visibility
@@ -369,11 +487,12 @@ type FakeClient struct {
 		result1 *types.Visibility
 		result2 error
 	}
-	StatusStub        func(string, *query.Parameters) (*types.Operation, error)
+	StatusStub        func(context.Context, string, *query.Parameters) (*types.Operation, error)
 	statusMutex       sync.RWMutex
 	statusArgsForCall []struct {
-		arg1 string
-		arg2 *query.Parameters
+		arg1 context.Context
+		arg2 string
+		arg3 *query.Parameters
 	}
 	statusReturns struct {
 		result1 *types.Operation
@@ -383,11 +502,12 @@ type FakeClient struct {
 		result1 *types.Operation
 		result2 error
 	}
-	UnbindStub        func(string, *query.Parameters) (string, error)
+	UnbindStub        func(context.Context, string, *query.Parameters) (string, error)
 	unbindMutex       sync.RWMutex
 	unbindArgsForCall []struct {
-		arg1 string
-		arg2 *query.Parameters
+		arg1 context.Context
+		arg2 string
+		arg3 *query.Parameters
 	}
 	unbindReturns struct {
 		result1 string
@@ -397,12 +517,13 @@ type FakeClient struct {
 		result1 string
 		result2 error
 	}
-	UpdateBrokerStub        func(string, *types.Broker, *query.Parameters) (*types.Broker, string, error)
+	UpdateBrokerStub        func(context.Context, string, *types.Broker, *query.Parameters) (*types.Broker, string, error)
 	updateBrokerMutex       sync.RWMutex
 	updateBrokerArgsForCall []struct {
-		arg1 string
-		arg2 *types.Broker
-		arg3 *query.Parameters
+		arg1 context.Context
+		arg2 string
+		arg3 *types.Broker
+		arg4 *query.Parameters
 	}
 	updateBrokerReturns struct {
 		result1 *types.Broker
@@ -414,12 +535,13 @@ type FakeClient struct {
 		result2 string
 		result3 error
 	}
-	UpdateInstanceStub        func(string, *types.ServiceInstance, *query.Parameters) (*types.ServiceInstance, string, error)
+	UpdateInstanceStub        func(context.Context, string, *types.ServiceInstance, *query.Parameters) (*types.ServiceInstance, string, error)
 	updateInstanceMutex       sync.RWMutex
 	updateInstanceArgsForCall []struct {
-		arg1 string
-		arg2 *types.ServiceInstance
-		arg3 *query.Parameters
+		arg1 context.Context
+		arg2 string
+		arg3 *types.ServiceInstance
+		arg4 *query.Parameters
 	}
 	updateInstanceReturns struct {
 		result1 *types.ServiceInstance
@@ -431,12 +553,13 @@ type FakeClient struct {
 		result2 string
 		result3 error
 	}
-	UpdatePlatformStub        func(string, *types.Platform, *query.Parameters) (*types.Platform, error)
+	UpdatePlatformStub        func(context.Context, string, *types.Platform, *query.Parameters) (*types.Platform, error)
 	updatePlatformMutex       sync.RWMutex
 	updatePlatformArgsForCall []struct {
-		arg1 string
-		arg2 *types.Platform
-		arg3 *query.Parameters
+		arg1 context.Context
+		arg2 string
+		arg3 *types.Platform
+		arg4 *query.Parameters
 	}
 	updatePlatformReturns struct {
 		result1 *types.Platform
@@ -446,12 +569,13 @@ type FakeClient struct {
 		result1 *types.Platform
 		result2 error
 	}
-	UpdateVisibilityStub        func(string, *types.Visibility, *query.Parameters) (*types.Visibility, error)
+	UpdateVisibilityStub        func(context.Context, string, *types.Visibility, *query.Parameters) (*types.Visibility, error)
 	updateVisibilityMutex       sync.RWMutex
 	updateVisibilityArgsForCall []struct {
-		arg1 string
-		arg2 *types.Visibility
-		arg3 *query.Parameters
+		arg1 context.Context
+		arg2 string
+		arg3 *types.Visibility
+		arg4 *query.Parameters
 	}
 	updateVisibilityReturns struct {
 		result1 *types.Visibility
@@ -463,41 +587,26 @@ type FakeClient struct {
 	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
-
-	GetPlanByIDStub        func(string, *query.Parameters) (*types.ServicePlan, error)
-	getPlanByIDMutex       sync.RWMutex
-	getPlanByIDArgsForCall []struct {
-		arg1 string
-		arg2 *query.Parameters
-	}
-	getPlanByIDReturns struct {
-		result1 *types.ServicePlan
-		result2 error
-	}
-	getPlanByIDReturnsOnCall map[int]struct {
-		result1 *types.ServicePlan
-		result2 error
-	}
-
-
 }
 
-func (fake *FakeClient) Bind(arg1 *types.ServiceBinding, arg2 *query.Parameters) (*types.ServiceBinding, string, error) {
+func (fake *FakeClient) Bind(arg1 context.Context, arg2 *types.ServiceBinding, arg3 *query.Parameters) (*types.ServiceBinding, string, error) {
 	fake.bindMutex.Lock()
 	ret, specificReturn := fake.bindReturnsOnCall[len(fake.bindArgsForCall)]
 	fake.bindArgsForCall = append(fake.bindArgsForCall, struct {
-		arg1 *types.ServiceBinding
-		arg2 *query.Parameters
-	}{arg1, arg2})
-	fake.recordInvocation("Bind", []interface{}{arg1, arg2})
+		arg1 context.Context
+		arg2 *types.ServiceBinding
+		arg3 *query.Parameters
+	}{arg1, arg2, arg3})
+	stub := fake.BindStub
+	fakeReturns := fake.bindReturns
+	fake.recordInvocation("Bind", []interface{}{arg1, arg2, arg3})
 	fake.bindMutex.Unlock()
-	if fake.BindStub != nil {
-		return fake.BindStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.bindReturns
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
@@ -507,17 +616,17 @@ func (fake *FakeClient) BindCallCount() int {
 	return len(fake.bindArgsForCall)
 }
 
-func (fake *FakeClient) BindCalls(stub func(*types.ServiceBinding, *query.Parameters) (*types.ServiceBinding, string, error)) {
+func (fake *FakeClient) BindCalls(stub func(context.Context, *types.ServiceBinding, *query.Parameters) (*types.ServiceBinding, string, error)) {
 	fake.bindMutex.Lock()
 	defer fake.bindMutex.Unlock()
 	fake.BindStub = stub
 }
 
-func (fake *FakeClient) BindArgsForCall(i int) (*types.ServiceBinding, *query.Parameters) {
+func (fake *FakeClient) BindArgsForCall(i int) (context.Context, *types.ServiceBinding, *query.Parameters) {
 	fake.bindMutex.RLock()
 	defer fake.bindMutex.RUnlock()
 	argsForCall := fake.bindArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeClient) BindReturns(result1 *types.ServiceBinding, result2 string, result3 error) {
@@ -549,24 +658,26 @@ func (fake *FakeClient) BindReturnsOnCall(i int, result1 *types.ServiceBinding,
 	}{result1, result2, result3}
 }
 
-func (fake *FakeClient) Call(arg1 string, arg2 string, arg3 io.Reader, arg4 *query.Parameters) (*http.Response, error) {
+func (fake *FakeClient) Call(arg1 context.Context, arg2 string, arg3 string, arg4 io.Reader, arg5 *query.Parameters) (*http.Response, error) {
 	fake.callMutex.Lock()
 	ret, specificReturn := fake.callReturnsOnCall[len(fake.callArgsForCall)]
 	fake.callArgsForCall = append(fake.callArgsForCall, struct {
-		arg1 string
+		arg1 context.Context
 		arg2 string
-		arg3 io.Reader
-		arg4 *query.Parameters
-	}{arg1, arg2, arg3, arg4})
-	fake.recordInvocation("Call", []interface{}{arg1, arg2, arg3, arg4})
+		arg3 string
+		arg4 io.Reader
+		arg5 *query.Parameters
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.CallStub
+	fakeReturns := fake.callReturns
+	fake.recordInvocation("Call", []interface{}{arg1, arg2, arg3, arg4, arg5})
 	fake.callMutex.Unlock()
-	if fake.CallStub != nil {
-		return fake.CallStub(arg1, arg2, arg3, arg4)
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.callReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -576,17 +687,17 @@ func (fake *FakeClient) CallCallCount() int {
 	return len(fake.callArgsForCall)
 }
 
-func (fake *FakeClient) CallCalls(stub func(string, string, io.Reader, *query.Parameters) (*http.Response, error)) {
+func (fake *FakeClient) CallCalls(stub func(context.Context, string, string, io.Reader, *query.Parameters) (*http.Response, error)) {
 	fake.callMutex.Lock()
 	defer fake.callMutex.Unlock()
 	fake.CallStub = stub
 }
 
-func (fake *FakeClient) CallArgsForCall(i int) (string, string, io.Reader, *query.Parameters) {
+func (fake *FakeClient) CallArgsForCall(i int) (context.Context, string, string, io.Reader, *query.Parameters) {
 	fake.callMutex.RLock()
 	defer fake.callMutex.RUnlock()
 	argsForCall := fake.callArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
 }
 
 func (fake *FakeClient) CallReturns(result1 *http.Response, result2 error) {
@@ -615,22 +726,24 @@ func (fake *FakeClient) CallReturnsOnCall(i int, result1 *http.Response, result2
 	}{result1, result2}
 }
 
-func (fake *FakeClient) DeleteBroker(arg1 string, arg2 *query.Parameters) (string, error) {
+func (fake *FakeClient) DeleteBroker(arg1 context.Context, arg2 string, arg3 *query.Parameters) (string, error) {
 	fake.deleteBrokerMutex.Lock()
 	ret, specificReturn := fake.deleteBrokerReturnsOnCall[len(fake.deleteBrokerArgsForCall)]
 	fake.deleteBrokerArgsForCall = append(fake.deleteBrokerArgsForCall, struct {
-		arg1 string
-		arg2 *query.Parameters
-	}{arg1, arg2})
-	fake.recordInvocation("DeleteBroker", []interface{}{arg1, arg2})
+		arg1 context.Context
+		arg2 string
+		arg3 *query.Parameters
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteBrokerStub
+	fakeReturns := fake.deleteBrokerReturns
+	fake.recordInvocation("DeleteBroker", []interface{}{arg1, arg2, arg3})
 	fake.deleteBrokerMutex.Unlock()
-	if fake.DeleteBrokerStub != nil {
-		return fake.DeleteBrokerStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteBrokerReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -640,17 +753,17 @@ func (fake *FakeClient) DeleteBrokerCallCount() int {
 	return len(fake.deleteBrokerArgsForCall)
 }
 
-func (fake *FakeClient) DeleteBrokerCalls(stub func(string, *query.Parameters) (string, error)) {
+func (fake *FakeClient) DeleteBrokerCalls(stub func(context.Context, string, *query.Parameters) (string, error)) {
 	fake.deleteBrokerMutex.Lock()
 	defer fake.deleteBrokerMutex.Unlock()
 	fake.DeleteBrokerStub = stub
 }
 
-func (fake *FakeClient) DeleteBrokerArgsForCall(i int) (string, *query.Parameters) {
+func (fake *FakeClient) DeleteBrokerArgsForCall(i int) (context.Context, string, *query.Parameters) {
 	fake.deleteBrokerMutex.RLock()
 	defer fake.deleteBrokerMutex.RUnlock()
 	argsForCall := fake.deleteBrokerArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeClient) DeleteBrokerReturns(result1 string, result2 error) {
@@ -679,22 +792,24 @@ func (fake *FakeClient) DeleteBrokerReturnsOnCall(i int, result1 string, result2
 	}{result1, result2}
 }
 
-func (fake *FakeClient) DeletePlatform(arg1 string, arg2 *query.Parameters) (string, error) {
+func (fake *FakeClient) DeletePlatform(arg1 context.Context, arg2 string, arg3 *query.Parameters) (string, error) {
 	fake.deletePlatformMutex.Lock()
 	ret, specificReturn := fake.deletePlatformReturnsOnCall[len(fake.deletePlatformArgsForCall)]
 	fake.deletePlatformArgsForCall = append(fake.deletePlatformArgsForCall, struct {
-		arg1 string
-		arg2 *query.Parameters
-	}{arg1, arg2})
-	fake.recordInvocation("DeletePlatform", []interface{}{arg1, arg2})
+		arg1 context.Context
+		arg2 string
+		arg3 *query.Parameters
+	}{arg1, arg2, arg3})
+	stub := fake.DeletePlatformStub
+	fakeReturns := fake.deletePlatformReturns
+	fake.recordInvocation("DeletePlatform", []interface{}{arg1, arg2, arg3})
 	fake.deletePlatformMutex.Unlock()
-	if fake.DeletePlatformStub != nil {
-		return fake.DeletePlatformStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deletePlatformReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -704,17 +819,17 @@ func (fake *FakeClient) DeletePlatformCallCount() int {
 	return len(fake.deletePlatformArgsForCall)
 }
 
-func (fake *FakeClient) DeletePlatformCalls(stub func(string, *query.Parameters) (string, error)) {
+func (fake *FakeClient) DeletePlatformCalls(stub func(context.Context, string, *query.Parameters) (string, error)) {
 	fake.deletePlatformMutex.Lock()
 	defer fake.deletePlatformMutex.Unlock()
 	fake.DeletePlatformStub = stub
 }
 
-func (fake *FakeClient) DeletePlatformArgsForCall(i int) (string, *query.Parameters) {
+func (fake *FakeClient) DeletePlatformArgsForCall(i int) (context.Context, string, *query.Parameters) {
 	fake.deletePlatformMutex.RLock()
 	defer fake.deletePlatformMutex.RUnlock()
 	argsForCall := fake.deletePlatformArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeClient) DeletePlatformReturns(result1 string, result2 error) {
@@ -743,21 +858,23 @@ func (fake *FakeClient) DeletePlatformReturnsOnCall(i int, result1 string, resul
 	}{result1, result2}
 }
 
-func (fake *FakeClient) DeletePlatforms(arg1 *query.Parameters) error {
+func (fake *FakeClient) DeletePlatforms(arg1 context.Context, arg2 *query.Parameters) error {
 	fake.deletePlatformsMutex.Lock()
 	ret, specificReturn := fake.deletePlatformsReturnsOnCall[len(fake.deletePlatformsArgsForCall)]
 	fake.deletePlatformsArgsForCall = append(fake.deletePlatformsArgsForCall, struct {
-		arg1 *query.Parameters
-	}{arg1})
-	fake.recordInvocation("DeletePlatforms", []interface{}{arg1})
+		arg1 context.Context
+		arg2 *query.Parameters
+	}{arg1, arg2})
+	stub := fake.DeletePlatformsStub
+	fakeReturns := fake.deletePlatformsReturns
+	fake.recordInvocation("DeletePlatforms", []interface{}{arg1, arg2})
 	fake.deletePlatformsMutex.Unlock()
-	if fake.DeletePlatformsStub != nil {
-		return fake.DeletePlatformsStub(arg1)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.deletePlatformsReturns
 	return fakeReturns.result1
 }
 
@@ -767,17 +884,17 @@ func (fake *FakeClient) DeletePlatformsCallCount() int {
 	return len(fake.deletePlatformsArgsForCall)
 }
 
-func (fake *FakeClient) DeletePlatformsCalls(stub func(*query.Parameters) error) {
+func (fake *FakeClient) DeletePlatformsCalls(stub func(context.Context, *query.Parameters) error) {
 	fake.deletePlatformsMutex.Lock()
 	defer fake.deletePlatformsMutex.Unlock()
 	fake.DeletePlatformsStub = stub
 }
 
-func (fake *FakeClient) DeletePlatformsArgsForCall(i int) *query.Parameters {
+func (fake *FakeClient) DeletePlatformsArgsForCall(i int) (context.Context, *query.Parameters) {
 	fake.deletePlatformsMutex.RLock()
 	defer fake.deletePlatformsMutex.RUnlock()
 	argsForCall := fake.deletePlatformsArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeClient) DeletePlatformsReturns(result1 error) {
@@ -803,21 +920,23 @@ func (fake *FakeClient) DeletePlatformsReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeClient) DeleteVisibilities(arg1 *query.Parameters) error {
+func (fake *FakeClient) DeleteVisibilities(arg1 context.Context, arg2 *query.Parameters) error {
 	fake.deleteVisibilitiesMutex.Lock()
 	ret, specificReturn := fake.deleteVisibilitiesReturnsOnCall[len(fake.deleteVisibilitiesArgsForCall)]
 	fake.deleteVisibilitiesArgsForCall = append(fake.deleteVisibilitiesArgsForCall, struct {
-		arg1 *query.Parameters
-	}{arg1})
-	fake.recordInvocation("DeleteVisibilities", []interface{}{arg1})
+		arg1 context.Context
+		arg2 *query.Parameters
+	}{arg1, arg2})
+	stub := fake.DeleteVisibilitiesStub
+	fakeReturns := fake.deleteVisibilitiesReturns
+	fake.recordInvocation("DeleteVisibilities", []interface{}{arg1, arg2})
 	fake.deleteVisibilitiesMutex.Unlock()
-	if fake.DeleteVisibilitiesStub != nil {
-		return fake.DeleteVisibilitiesStub(arg1)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.deleteVisibilitiesReturns
 	return fakeReturns.result1
 }
 
@@ -827,17 +946,17 @@ func (fake *FakeClient) DeleteVisibilitiesCallCount() int {
 	return len(fake.deleteVisibilitiesArgsForCall)
 }
 
-func (fake *FakeClient) DeleteVisibilitiesCalls(stub func(*query.Parameters) error) {
+func (fake *FakeClient) DeleteVisibilitiesCalls(stub func(context.Context, *query.Parameters) error) {
 	fake.deleteVisibilitiesMutex.Lock()
 	defer fake.deleteVisibilitiesMutex.Unlock()
 	fake.DeleteVisibilitiesStub = stub
 }
 
-func (fake *FakeClient) DeleteVisibilitiesArgsForCall(i int) *query.Parameters {
+func (fake *FakeClient) DeleteVisibilitiesArgsForCall(i int) (context.Context, *query.Parameters) {
 	fake.deleteVisibilitiesMutex.RLock()
 	defer fake.deleteVisibilitiesMutex.RUnlock()
 	argsForCall := fake.deleteVisibilitiesArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeClient) DeleteVisibilitiesReturns(result1 error) {
@@ -863,22 +982,24 @@ func (fake *FakeClient) DeleteVisibilitiesReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeClient) Deprovision(arg1 string, arg2 *query.Parameters) (string, error) {
+func (fake *FakeClient) Deprovision(arg1 context.Context, arg2 string, arg3 *query.Parameters) (string, error) {
 	fake.deprovisionMutex.Lock()
 	ret, specificReturn := fake.deprovisionReturnsOnCall[len(fake.deprovisionArgsForCall)]
 	fake.deprovisionArgsForCall = append(fake.deprovisionArgsForCall, struct {
-		arg1 string
-		arg2 *query.Parameters
-	}{arg1, arg2})
-	fake.recordInvocation("Deprovision", []interface{}{arg1, arg2})
+		arg1 context.Context
+		arg2 string
+		arg3 *query.Parameters
+	}{arg1, arg2, arg3})
+	stub := fake.DeprovisionStub
+	fakeReturns := fake.deprovisionReturns
+	fake.recordInvocation("Deprovision", []interface{}{arg1, arg2, arg3})
 	fake.deprovisionMutex.Unlock()
-	if fake.DeprovisionStub != nil {
-		return fake.DeprovisionStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deprovisionReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -888,17 +1009,17 @@ func (fake *FakeClient) DeprovisionCallCount() int {
 	return len(fake.deprovisionArgsForCall)
 }
 
-func (fake *FakeClient) DeprovisionCalls(stub func(string, *query.Parameters) (string, error)) {
+func (fake *FakeClient) DeprovisionCalls(stub func(context.Context, string, *query.Parameters) (string, error)) {
 	fake.deprovisionMutex.Lock()
 	defer fake.deprovisionMutex.Unlock()
 	fake.DeprovisionStub = stub
 }
 
-func (fake *FakeClient) DeprovisionArgsForCall(i int) (string, *query.Parameters) {
+func (fake *FakeClient) DeprovisionArgsForCall(i int) (context.Context, string, *query.Parameters) {
 	fake.deprovisionMutex.RLock()
 	defer fake.deprovisionMutex.RUnlock()
 	argsForCall := fake.deprovisionArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeClient) DeprovisionReturns(result1 string, result2 error) {
@@ -927,22 +1048,24 @@ func (fake *FakeClient) DeprovisionReturnsOnCall(i int, result1 string, result2
 	}{result1, result2}
 }
 
-func (fake *FakeClient) GetBindingByID(arg1 string, arg2 *query.Parameters) (*types.ServiceBinding, error) {
+func (fake *FakeClient) GetBindingByID(arg1 context.Context, arg2 string, arg3 *query.Parameters) (*types.ServiceBinding, error) {
 	fake.getBindingByIDMutex.Lock()
 	ret, specificReturn := fake.getBindingByIDReturnsOnCall[len(fake.getBindingByIDArgsForCall)]
 	fake.getBindingByIDArgsForCall = append(fake.getBindingByIDArgsForCall, struct {
-		arg1 string
-		arg2 *query.Parameters
-	}{arg1, arg2})
-	fake.recordInvocation("GetBindingByID", []interface{}{arg1, arg2})
+		arg1 context.Context
+		arg2 string
+		arg3 *query.Parameters
+	}{arg1, arg2, arg3})
+	stub := fake.GetBindingByIDStub
+	fakeReturns := fake.getBindingByIDReturns
+	fake.recordInvocation("GetBindingByID", []interface{}{arg1, arg2, arg3})
 	fake.getBindingByIDMutex.Unlock()
-	if fake.GetBindingByIDStub != nil {
-		return fake.GetBindingByIDStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBindingByIDReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -952,17 +1075,17 @@ func (fake *FakeClient) GetBindingByIDCallCount() int {
 	return len(fake.getBindingByIDArgsForCall)
 }
 
-func (fake *FakeClient) GetBindingByIDCalls(stub func(string, *query.Parameters) (*types.ServiceBinding, error)) {
+func (fake *FakeClient) GetBindingByIDCalls(stub func(context.Context, string, *query.Parameters) (*types.ServiceBinding, error)) {
 	fake.getBindingByIDMutex.Lock()
 	defer fake.getBindingByIDMutex.Unlock()
 	fake.GetBindingByIDStub = stub
 }
 
-func (fake *FakeClient) GetBindingByIDArgsForCall(i int) (string, *query.Parameters) {
+func (fake *FakeClient) GetBindingByIDArgsForCall(i int) (context.Context, string, *query.Parameters) {
 	fake.getBindingByIDMutex.RLock()
 	defer fake.getBindingByIDMutex.RUnlock()
 	argsForCall := fake.getBindingByIDArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeClient) GetBindingByIDReturns(result1 *types.ServiceBinding, result2 error) {
@@ -991,22 +1114,24 @@ func (fake *FakeClient) GetBindingByIDReturnsOnCall(i int, result1 *types.Servic
 	}{result1, result2}
 }
 
-func (fake *FakeClient) GetBindingParameters(arg1 string, arg2 *query.Parameters) (map[string]interface{}, error) {
+func (fake *FakeClient) GetBindingParameters(arg1 context.Context, arg2 string, arg3 *query.Parameters) (map[string]interface{}, error) {
 	fake.getBindingParametersMutex.Lock()
 	ret, specificReturn := fake.getBindingParametersReturnsOnCall[len(fake.getBindingParametersArgsForCall)]
 	fake.getBindingParametersArgsForCall = append(fake.getBindingParametersArgsForCall, struct {
-		arg1 string
-		arg2 *query.Parameters
-	}{arg1, arg2})
-	fake.recordInvocation("GetBindingParameters", []interface{}{arg1, arg2})
+		arg1 context.Context
+		arg2 string
+		arg3 *query.Parameters
+	}{arg1, arg2, arg3})
+	stub := fake.GetBindingParametersStub
+	fakeReturns := fake.getBindingParametersReturns
+	fake.recordInvocation("GetBindingParameters", []interface{}{arg1, arg2, arg3})
 	fake.getBindingParametersMutex.Unlock()
-	if fake.GetBindingParametersStub != nil {
-		return fake.GetBindingParametersStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBindingParametersReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -1016,17 +1141,17 @@ func (fake *FakeClient) GetBindingParametersCallCount() int {
 	return len(fake.getBindingParametersArgsForCall)
 }
 
-func (fake *FakeClient) GetBindingParametersCalls(stub func(string, *query.Parameters) (map[string]interface{}, error)) {
+func (fake *FakeClient) GetBindingParametersCalls(stub func(context.Context, string, *query.Parameters) (map[string]interface{}, error)) {
 	fake.getBindingParametersMutex.Lock()
 	defer fake.getBindingParametersMutex.Unlock()
 	fake.GetBindingParametersStub = stub
 }
 
-func (fake *FakeClient) GetBindingParametersArgsForCall(i int) (string, *query.Parameters) {
+func (fake *FakeClient) GetBindingParametersArgsForCall(i int) (context.Context, string, *query.Parameters) {
 	fake.getBindingParametersMutex.RLock()
 	defer fake.getBindingParametersMutex.RUnlock()
 	argsForCall := fake.getBindingParametersArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeClient) GetBindingParametersReturns(result1 map[string]interface{}, result2 error) {
@@ -1055,22 +1180,24 @@ func (fake *FakeClient) GetBindingParametersReturnsOnCall(i int, result1 map[str
 	}{result1, result2}
 }
 
-func (fake *FakeClient) GetBrokerByID(arg1 string, arg2 *query.Parameters) (*types.Broker, error) {
+func (fake *FakeClient) GetBrokerByID(arg1 context.Context, arg2 string, arg3 *query.Parameters) (*types.Broker, error) {
 	fake.getBrokerByIDMutex.Lock()
 	ret, specificReturn := fake.getBrokerByIDReturnsOnCall[len(fake.getBrokerByIDArgsForCall)]
 	fake.getBrokerByIDArgsForCall = append(fake.getBrokerByIDArgsForCall, struct {
-		arg1 string
-		arg2 *query.Parameters
-	}{arg1, arg2})
-	fake.recordInvocation("GetBrokerByID", []interface{}{arg1, arg2})
+		arg1 context.Context
+		arg2 string
+		arg3 *query.Parameters
+	}{arg1, arg2, arg3})
+	stub := fake.GetBrokerByIDStub
+	fakeReturns := fake.getBrokerByIDReturns
+	fake.recordInvocation("GetBrokerByID", []interface{}{arg1, arg2, arg3})
 	fake.getBrokerByIDMutex.Unlock()
-	if fake.GetBrokerByIDStub != nil {
-		return fake.GetBrokerByIDStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBrokerByIDReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -1080,17 +1207,17 @@ func (fake *FakeClient) GetBrokerByIDCallCount() int {
 	return len(fake.getBrokerByIDArgsForCall)
 }
 
-func (fake *FakeClient) GetBrokerByIDCalls(stub func(string, *query.Parameters) (*types.Broker, error)) {
+func (fake *FakeClient) GetBrokerByIDCalls(stub func(context.Context, string, *query.Parameters) (*types.Broker, error)) {
 	fake.getBrokerByIDMutex.Lock()
 	defer fake.getBrokerByIDMutex.Unlock()
 	fake.GetBrokerByIDStub = stub
 }
 
-func (fake *FakeClient) GetBrokerByIDArgsForCall(i int) (string, *query.Parameters) {
+func (fake *FakeClient) GetBrokerByIDArgsForCall(i int) (context.Context, string, *query.Parameters) {
 	fake.getBrokerByIDMutex.RLock()
 	defer fake.getBrokerByIDMutex.RUnlock()
 	argsForCall := fake.getBrokerByIDArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeClient) GetBrokerByIDReturns(result1 *types.Broker, result2 error) {
@@ -1119,21 +1246,23 @@ func (fake *FakeClient) GetBrokerByIDReturnsOnCall(i int, result1 *types.Broker,
 	}{result1, result2}
 }
 
-func (fake *FakeClient) GetInfo(arg1 *query.Parameters) (*types.Info, error) {
+func (fake *FakeClient) GetInfo(arg1 context.Context, arg2 *query.Parameters) (*types.Info, error) {
 	fake.getInfoMutex.Lock()
 	ret, specificReturn := fake.getInfoReturnsOnCall[len(fake.getInfoArgsForCall)]
 	fake.getInfoArgsForCall = append(fake.getInfoArgsForCall, struct {
-		arg1 *query.Parameters
-	}{arg1})
-	fake.recordInvocation("GetInfo", []interface{}{arg1})
+		arg1 context.Context
+		arg2 *query.Parameters
+	}{arg1, arg2})
+	stub := fake.GetInfoStub
+	fakeReturns := fake.getInfoReturns
+	fake.recordInvocation("GetInfo", []interface{}{arg1, arg2})
 	fake.getInfoMutex.Unlock()
-	if fake.GetInfoStub != nil {
-		return fake.GetInfoStub(arg1)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getInfoReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -1143,17 +1272,17 @@ func (fake *FakeClient) GetInfoCallCount() int {
 	return len(fake.getInfoArgsForCall)
 }
 
-func (fake *FakeClient) GetInfoCalls(stub func(*query.Parameters) (*types.Info, error)) {
+func (fake *FakeClient) GetInfoCalls(stub func(context.Context, *query.Parameters) (*types.Info, error)) {
 	fake.getInfoMutex.Lock()
 	defer fake.getInfoMutex.Unlock()
 	fake.GetInfoStub = stub
 }
 
-func (fake *FakeClient) GetInfoArgsForCall(i int) *query.Parameters {
+func (fake *FakeClient) GetInfoArgsForCall(i int) (context.Context, *query.Parameters) {
 	fake.getInfoMutex.RLock()
 	defer fake.getInfoMutex.RUnlock()
 	argsForCall := fake.getInfoArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeClient) GetInfoReturns(result1 *types.Info, result2 error) {
@@ -1182,22 +1311,24 @@ func (fake *FakeClient) GetInfoReturnsOnCall(i int, result1 *types.Info, result2
 	}{result1, result2}
 }
 
-func (fake *FakeClient) GetInstanceByID(arg1 string, arg2 *query.Parameters) (*types.ServiceInstance, error) {
+func (fake *FakeClient) GetInstanceByID(arg1 context.Context, arg2 string, arg3 *query.Parameters) (*types.ServiceInstance, error) {
 	fake.getInstanceByIDMutex.Lock()
 	ret, specificReturn := fake.getInstanceByIDReturnsOnCall[len(fake.getInstanceByIDArgsForCall)]
 	fake.getInstanceByIDArgsForCall = append(fake.getInstanceByIDArgsForCall, struct {
-		arg1 string
-		arg2 *query.Parameters
-	}{arg1, arg2})
-	fake.recordInvocation("GetInstanceByID", []interface{}{arg1, arg2})
+		arg1 context.Context
+		arg2 string
+		arg3 *query.Parameters
+	}{arg1, arg2, arg3})
+	stub := fake.GetInstanceByIDStub
+	fakeReturns := fake.getInstanceByIDReturns
+	fake.recordInvocation("GetInstanceByID", []interface{}{arg1, arg2, arg3})
 	fake.getInstanceByIDMutex.Unlock()
-	if fake.GetInstanceByIDStub != nil {
-		return fake.GetInstanceByIDStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getInstanceByIDReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -1207,17 +1338,17 @@ func (fake *FakeClient) GetInstanceByIDCallCount() int {
 	return len(fake.getInstanceByIDArgsForCall)
 }
 
-func (fake *FakeClient) GetInstanceByIDCalls(stub func(string, *query.Parameters) (*types.ServiceInstance, error)) {
+func (fake *FakeClient) GetInstanceByIDCalls(stub func(context.Context, string, *query.Parameters) (*types.ServiceInstance, error)) {
 	fake.getInstanceByIDMutex.Lock()
 	defer fake.getInstanceByIDMutex.Unlock()
 	fake.GetInstanceByIDStub = stub
 }
 
-func (fake *FakeClient) GetInstanceByIDArgsForCall(i int) (string, *query.Parameters) {
+func (fake *FakeClient) GetInstanceByIDArgsForCall(i int) (context.Context, string, *query.Parameters) {
 	fake.getInstanceByIDMutex.RLock()
 	defer fake.getInstanceByIDMutex.RUnlock()
 	argsForCall := fake.getInstanceByIDArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeClient) GetInstanceByIDReturns(result1 *types.ServiceInstance, result2 error) {
@@ -1246,22 +1377,24 @@ func (fake *FakeClient) GetInstanceByIDReturnsOnCall(i int, result1 *types.Servi
 	}{result1, result2}
 }
 
-func (fake *FakeClient) GetInstanceParameters(arg1 string, arg2 *query.Parameters) (map[string]interface{}, error) {
+func (fake *FakeClient) GetInstanceParameters(arg1 context.Context, arg2 string, arg3 *query.Parameters) (map[string]interface{}, error) {
 	fake.getInstanceParametersMutex.Lock()
 	ret, specificReturn := fake.getInstanceParametersReturnsOnCall[len(fake.getInstanceParametersArgsForCall)]
 	fake.getInstanceParametersArgsForCall = append(fake.getInstanceParametersArgsForCall, struct {
-		arg1 string
-		arg2 *query.Parameters
-	}{arg1, arg2})
-	fake.recordInvocation("GetInstanceParameters", []interface{}{arg1, arg2})
+		arg1 context.Context
+		arg2 string
+		arg3 *query.Parameters
+	}{arg1, arg2, arg3})
+	stub := fake.GetInstanceParametersStub
+	fakeReturns := fake.getInstanceParametersReturns
+	fake.recordInvocation("GetInstanceParameters", []interface{}{arg1, arg2, arg3})
 	fake.getInstanceParametersMutex.Unlock()
-	if fake.GetInstanceParametersStub != nil {
-		return fake.GetInstanceParametersStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getInstanceParametersReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -1271,127 +1404,329 @@ func (fake *FakeClient) GetInstanceParametersCallCount() int {
 	return len(fake.getInstanceParametersArgsForCall)
 }
 
-func (fake *FakeClient) GetInstanceParametersCalls(stub func(string, *query.Parameters) (map[string]interface{}, error)) {
+func (fake *FakeClient) GetInstanceParametersCalls(stub func(context.Context, string, *query.Parameters) (map[string]interface{}, error)) {
 	fake.getInstanceParametersMutex.Lock()
 	defer fake.getInstanceParametersMutex.Unlock()
 	fake.GetInstanceParametersStub = stub
 }
 
-func (fake *FakeClient) GetInstanceParametersArgsForCall(i int) (string, *query.Parameters) {
+func (fake *FakeClient) GetInstanceParametersArgsForCall(i int) (context.Context, string, *query.Parameters) {
 	fake.getInstanceParametersMutex.RLock()
 	defer fake.getInstanceParametersMutex.RUnlock()
 	argsForCall := fake.getInstanceParametersArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) GetInstanceParametersReturns(result1 map[string]interface{}, result2 error) {
+	fake.getInstanceParametersMutex.Lock()
+	defer fake.getInstanceParametersMutex.Unlock()
+	fake.GetInstanceParametersStub = nil
+	fake.getInstanceParametersReturns = struct {
+		result1 map[string]interface{}
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetInstanceParametersReturnsOnCall(i int, result1 map[string]interface{}, result2 error) {
+	fake.getInstanceParametersMutex.Lock()
+	defer fake.getInstanceParametersMutex.Unlock()
+	fake.GetInstanceParametersStub = nil
+	if fake.getInstanceParametersReturnsOnCall == nil {
+		fake.getInstanceParametersReturnsOnCall = make(map[int]struct {
+			result1 map[string]interface{}
+			result2 error
+		})
+	}
+	fake.getInstanceParametersReturnsOnCall[i] = struct {
+		result1 map[string]interface{}
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetOfferingByID(arg1 context.Context, arg2 string, arg3 *query.Parameters) (*types.ServiceOffering, error) {
+	fake.getOfferingByIDMutex.Lock()
+	ret, specificReturn := fake.getOfferingByIDReturnsOnCall[len(fake.getOfferingByIDArgsForCall)]
+	fake.getOfferingByIDArgsForCall = append(fake.getOfferingByIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *query.Parameters
+	}{arg1, arg2, arg3})
+	stub := fake.GetOfferingByIDStub
+	fakeReturns := fake.getOfferingByIDReturns
+	fake.recordInvocation("GetOfferingByID", []interface{}{arg1, arg2, arg3})
+	fake.getOfferingByIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) GetOfferingByIDCallCount() int {
+	fake.getOfferingByIDMutex.RLock()
+	defer fake.getOfferingByIDMutex.RUnlock()
+	return len(fake.getOfferingByIDArgsForCall)
+}
+
+func (fake *FakeClient) GetOfferingByIDCalls(stub func(context.Context, string, *query.Parameters) (*types.ServiceOffering, error)) {
+	fake.getOfferingByIDMutex.Lock()
+	defer fake.getOfferingByIDMutex.Unlock()
+	fake.GetOfferingByIDStub = stub
+}
+
+func (fake *FakeClient) GetOfferingByIDArgsForCall(i int) (context.Context, string, *query.Parameters) {
+	fake.getOfferingByIDMutex.RLock()
+	defer fake.getOfferingByIDMutex.RUnlock()
+	argsForCall := fake.getOfferingByIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) GetOfferingByIDReturns(result1 *types.ServiceOffering, result2 error) {
+	fake.getOfferingByIDMutex.Lock()
+	defer fake.getOfferingByIDMutex.Unlock()
+	fake.GetOfferingByIDStub = nil
+	fake.getOfferingByIDReturns = struct {
+		result1 *types.ServiceOffering
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetOfferingByIDReturnsOnCall(i int, result1 *types.ServiceOffering, result2 error) {
+	fake.getOfferingByIDMutex.Lock()
+	defer fake.getOfferingByIDMutex.Unlock()
+	fake.GetOfferingByIDStub = nil
+	if fake.getOfferingByIDReturnsOnCall == nil {
+		fake.getOfferingByIDReturnsOnCall = make(map[int]struct {
+			result1 *types.ServiceOffering
+			result2 error
+		})
+	}
+	fake.getOfferingByIDReturnsOnCall[i] = struct {
+		result1 *types.ServiceOffering
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetPlanByID(arg1 context.Context, arg2 string, arg3 *query.Parameters) (*types.ServicePlan, error) {
+	fake.getPlanByIDMutex.Lock()
+	ret, specificReturn := fake.getPlanByIDReturnsOnCall[len(fake.getPlanByIDArgsForCall)]
+	fake.getPlanByIDArgsForCall = append(fake.getPlanByIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *query.Parameters
+	}{arg1, arg2, arg3})
+	stub := fake.GetPlanByIDStub
+	fakeReturns := fake.getPlanByIDReturns
+	fake.recordInvocation("GetPlanByID", []interface{}{arg1, arg2, arg3})
+	fake.getPlanByIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) GetPlanByIDCallCount() int {
+	fake.getPlanByIDMutex.RLock()
+	defer fake.getPlanByIDMutex.RUnlock()
+	return len(fake.getPlanByIDArgsForCall)
+}
+
+func (fake *FakeClient) GetPlanByIDCalls(stub func(context.Context, string, *query.Parameters) (*types.ServicePlan, error)) {
+	fake.getPlanByIDMutex.Lock()
+	defer fake.getPlanByIDMutex.Unlock()
+	fake.GetPlanByIDStub = stub
+}
+
+func (fake *FakeClient) GetPlanByIDArgsForCall(i int) (context.Context, string, *query.Parameters) {
+	fake.getPlanByIDMutex.RLock()
+	defer fake.getPlanByIDMutex.RUnlock()
+	argsForCall := fake.getPlanByIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) GetPlanByIDReturns(result1 *types.ServicePlan, result2 error) {
+	fake.getPlanByIDMutex.Lock()
+	defer fake.getPlanByIDMutex.Unlock()
+	fake.GetPlanByIDStub = nil
+	fake.getPlanByIDReturns = struct {
+		result1 *types.ServicePlan
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetPlanByIDReturnsOnCall(i int, result1 *types.ServicePlan, result2 error) {
+	fake.getPlanByIDMutex.Lock()
+	defer fake.getPlanByIDMutex.Unlock()
+	fake.GetPlanByIDStub = nil
+	if fake.getPlanByIDReturnsOnCall == nil {
+		fake.getPlanByIDReturnsOnCall = make(map[int]struct {
+			result1 *types.ServicePlan
+			result2 error
+		})
+	}
+	fake.getPlanByIDReturnsOnCall[i] = struct {
+		result1 *types.ServicePlan
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetPlatformByID(arg1 context.Context, arg2 string, arg3 *query.Parameters) (*types.Platform, error) {
+	fake.getPlatformByIDMutex.Lock()
+	ret, specificReturn := fake.getPlatformByIDReturnsOnCall[len(fake.getPlatformByIDArgsForCall)]
+	fake.getPlatformByIDArgsForCall = append(fake.getPlatformByIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *query.Parameters
+	}{arg1, arg2, arg3})
+	stub := fake.GetPlatformByIDStub
+	fakeReturns := fake.getPlatformByIDReturns
+	fake.recordInvocation("GetPlatformByID", []interface{}{arg1, arg2, arg3})
+	fake.getPlatformByIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) GetPlatformByIDCallCount() int {
+	fake.getPlatformByIDMutex.RLock()
+	defer fake.getPlatformByIDMutex.RUnlock()
+	return len(fake.getPlatformByIDArgsForCall)
+}
+
+func (fake *FakeClient) GetPlatformByIDCalls(stub func(context.Context, string, *query.Parameters) (*types.Platform, error)) {
+	fake.getPlatformByIDMutex.Lock()
+	defer fake.getPlatformByIDMutex.Unlock()
+	fake.GetPlatformByIDStub = stub
 }
 
-func (fake *FakeClient) GetInstanceParametersReturns(result1 map[string]interface{}, result2 error) {
-	fake.getInstanceParametersMutex.Lock()
-	defer fake.getInstanceParametersMutex.Unlock()
-	fake.GetInstanceParametersStub = nil
-	fake.getInstanceParametersReturns = struct {
-		result1 map[string]interface{}
+func (fake *FakeClient) GetPlatformByIDArgsForCall(i int) (context.Context, string, *query.Parameters) {
+	fake.getPlatformByIDMutex.RLock()
+	defer fake.getPlatformByIDMutex.RUnlock()
+	argsForCall := fake.getPlatformByIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) GetPlatformByIDReturns(result1 *types.Platform, result2 error) {
+	fake.getPlatformByIDMutex.Lock()
+	defer fake.getPlatformByIDMutex.Unlock()
+	fake.GetPlatformByIDStub = nil
+	fake.getPlatformByIDReturns = struct {
+		result1 *types.Platform
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeClient) GetInstanceParametersReturnsOnCall(i int, result1 map[string]interface{}, result2 error) {
-	fake.getInstanceParametersMutex.Lock()
-	defer fake.getInstanceParametersMutex.Unlock()
-	fake.GetInstanceParametersStub = nil
-	if fake.getInstanceParametersReturnsOnCall == nil {
-		fake.getInstanceParametersReturnsOnCall = make(map[int]struct {
-			result1 map[string]interface{}
+func (fake *FakeClient) GetPlatformByIDReturnsOnCall(i int, result1 *types.Platform, result2 error) {
+	fake.getPlatformByIDMutex.Lock()
+	defer fake.getPlatformByIDMutex.Unlock()
+	fake.GetPlatformByIDStub = nil
+	if fake.getPlatformByIDReturnsOnCall == nil {
+		fake.getPlatformByIDReturnsOnCall = make(map[int]struct {
+			result1 *types.Platform
 			result2 error
 		})
 	}
-	fake.getInstanceParametersReturnsOnCall[i] = struct {
-		result1 map[string]interface{}
+	fake.getPlatformByIDReturnsOnCall[i] = struct {
+		result1 *types.Platform
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeClient) GetPlanByID(arg1 string, arg2 *query.Parameters) (*types.ServicePlan, error) {
-	fake.getPlanByIDMutex.Lock()
-	ret, specificReturn := fake.getPlanByIDReturnsOnCall[len(fake.getPlanByIDArgsForCall)]
-	fake.getPlanByIDArgsForCall = append(fake.getPlanByIDArgsForCall, struct {
-		arg1 string
-		arg2 *query.Parameters
-	}{arg1, arg2})
-	fake.recordInvocation("GetPlanByID", []interface{}{arg1, arg2})
-	fake.getPlanByIDMutex.Unlock()
-	if fake.GetPlanByIDStub != nil {
-		return fake.GetPlanByIDStub(arg1, arg2)
+func (fake *FakeClient) GetVisibilityByID(arg1 context.Context, arg2 string, arg3 *query.Parameters) (*types.Visibility, error) {
+	fake.getVisibilityByIDMutex.Lock()
+	ret, specificReturn := fake.getVisibilityByIDReturnsOnCall[len(fake.getVisibilityByIDArgsForCall)]
+	fake.getVisibilityByIDArgsForCall = append(fake.getVisibilityByIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *query.Parameters
+	}{arg1, arg2, arg3})
+	stub := fake.GetVisibilityByIDStub
+	fakeReturns := fake.getVisibilityByIDReturns
+	fake.recordInvocation("GetVisibilityByID", []interface{}{arg1, arg2, arg3})
+	fake.getVisibilityByIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getPlanByIDReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeClient) GetPlanByIDCallCount() int {
-	fake.getPlanByIDMutex.RLock()
-	defer fake.getPlanByIDMutex.RUnlock()
-	return len(fake.getPlanByIDArgsForCall)
+func (fake *FakeClient) GetVisibilityByIDCallCount() int {
+	fake.getVisibilityByIDMutex.RLock()
+	defer fake.getVisibilityByIDMutex.RUnlock()
+	return len(fake.getVisibilityByIDArgsForCall)
 }
 
-func (fake *FakeClient) GetPlanByIDCalls(stub func(string, *query.Parameters) (*types.ServicePlan, error)) {
-	fake.getPlanByIDMutex.Lock()
-	defer fake.getPlanByIDMutex.Unlock()
-	fake.GetPlanByIDStub = stub
+func (fake *FakeClient) GetVisibilityByIDCalls(stub func(context.Context, string, *query.Parameters) (*types.Visibility, error)) {
+	fake.getVisibilityByIDMutex.Lock()
+	defer fake.getVisibilityByIDMutex.Unlock()
+	fake.GetVisibilityByIDStub = stub
 }
 
-func (fake *FakeClient) GetPlanByIDArgsForCall(i int) (string, *query.Parameters) {
-	fake.getPlanByIDMutex.RLock()
-	defer fake.getPlanByIDMutex.RUnlock()
-	argsForCall := fake.getPlanByIDArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeClient) GetVisibilityByIDArgsForCall(i int) (context.Context, string, *query.Parameters) {
+	fake.getVisibilityByIDMutex.RLock()
+	defer fake.getVisibilityByIDMutex.RUnlock()
+	argsForCall := fake.getVisibilityByIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeClient) GetPlanByIDReturns(result1 *types.ServicePlan, result2 error) {
-	fake.getPlanByIDMutex.Lock()
-	defer fake.getPlanByIDMutex.Unlock()
-	fake.GetPlanByIDStub = nil
-	fake.getPlanByIDReturns = struct {
-		result1 *types.ServicePlan
+func (fake *FakeClient) GetVisibilityByIDReturns(result1 *types.Visibility, result2 error) {
+	fake.getVisibilityByIDMutex.Lock()
+	defer fake.getVisibilityByIDMutex.Unlock()
+	fake.GetVisibilityByIDStub = nil
+	fake.getVisibilityByIDReturns = struct {
+		result1 *types.Visibility
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeClient) GetPlanByIDReturnsOnCall(i int, result1 *types.ServicePlan, result2 error) {
-	fake.getPlanByIDMutex.Lock()
-	defer fake.getPlanByIDMutex.Unlock()
-	fake.GetPlanByIDStub = nil
-	if fake.getPlanByIDReturnsOnCall == nil {
-		fake.getPlanByIDReturnsOnCall = make(map[int]struct {
-			result1 *types.ServicePlan
+func (fake *FakeClient) GetVisibilityByIDReturnsOnCall(i int, result1 *types.Visibility, result2 error) {
+	fake.getVisibilityByIDMutex.Lock()
+	defer fake.getVisibilityByIDMutex.Unlock()
+	fake.GetVisibilityByIDStub = nil
+	if fake.getVisibilityByIDReturnsOnCall == nil {
+		fake.getVisibilityByIDReturnsOnCall = make(map[int]struct {
+			result1 *types.Visibility
 			result2 error
 		})
 	}
-	fake.getPlanByIDReturnsOnCall[i] = struct {
-		result1 *types.ServicePlan
+	fake.getVisibilityByIDReturnsOnCall[i] = struct {
+		result1 *types.Visibility
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeClient) Label(arg1 string, arg2 string, arg3 *types.LabelChanges, arg4 *query.Parameters) error {
+func (fake *FakeClient) Label(arg1 context.Context, arg2 string, arg3 string, arg4 *types.LabelChanges, arg5 *query.Parameters) error {
 	fake.labelMutex.Lock()
 	ret, specificReturn := fake.labelReturnsOnCall[len(fake.labelArgsForCall)]
 	fake.labelArgsForCall = append(fake.labelArgsForCall, struct {
-		arg1 string
+		arg1 context.Context
 		arg2 string
-		arg3 *types.LabelChanges
-		arg4 *query.Parameters
-	}{arg1, arg2, arg3, arg4})
-	fake.recordInvocation("Label", []interface{}{arg1, arg2, arg3, arg4})
+		arg3 string
+		arg4 *types.LabelChanges
+		arg5 *query.Parameters
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.LabelStub
+	fakeReturns := fake.labelReturns
+	fake.recordInvocation("Label", []interface{}{arg1, arg2, arg3, arg4, arg5})
 	fake.labelMutex.Unlock()
-	if fake.LabelStub != nil {
-		return fake.LabelStub(arg1, arg2, arg3, arg4)
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.labelReturns
 	return fakeReturns.result1
 }
 
@@ -1401,17 +1736,17 @@ func (fake *FakeClient) LabelCallCount() int {
 	return len(fake.labelArgsForCall)
 }
 
-func (fake *FakeClient) LabelCalls(stub func(string, string, *types.LabelChanges, *query.Parameters) error) {
+func (fake *FakeClient) LabelCalls(stub func(context.Context, string, string, *types.LabelChanges, *query.Parameters) error) {
 	fake.labelMutex.Lock()
 	defer fake.labelMutex.Unlock()
 	fake.LabelStub = stub
 }
 
-func (fake *FakeClient) LabelArgsForCall(i int) (string, string, *types.LabelChanges, *query.Parameters) {
+func (fake *FakeClient) LabelArgsForCall(i int) (context.Context, string, string, *types.LabelChanges, *query.Parameters) {
 	fake.labelMutex.RLock()
 	defer fake.labelMutex.RUnlock()
 	argsForCall := fake.labelArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
 }
 
 func (fake *FakeClient) LabelReturns(result1 error) {
@@ -1437,21 +1772,23 @@ func (fake *FakeClient) LabelReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeClient) ListBindings(arg1 *query.Parameters) (*types.ServiceBindings, error) {
+func (fake *FakeClient) ListBindings(arg1 context.Context, arg2 *query.Parameters) (*types.ServiceBindings, error) {
 	fake.listBindingsMutex.Lock()
 	ret, specificReturn := fake.listBindingsReturnsOnCall[len(fake.listBindingsArgsForCall)]
 	fake.listBindingsArgsForCall = append(fake.listBindingsArgsForCall, struct {
-		arg1 *query.Parameters
-	}{arg1})
-	fake.recordInvocation("ListBindings", []interface{}{arg1})
+		arg1 context.Context
+		arg2 *query.Parameters
+	}{arg1, arg2})
+	stub := fake.ListBindingsStub
+	fakeReturns := fake.listBindingsReturns
+	fake.recordInvocation("ListBindings", []interface{}{arg1, arg2})
 	fake.listBindingsMutex.Unlock()
-	if fake.ListBindingsStub != nil {
-		return fake.ListBindingsStub(arg1)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.listBindingsReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -1461,17 +1798,17 @@ func (fake *FakeClient) ListBindingsCallCount() int {
 	return len(fake.listBindingsArgsForCall)
 }
 
-func (fake *FakeClient) ListBindingsCalls(stub func(*query.Parameters) (*types.ServiceBindings, error)) {
+func (fake *FakeClient) ListBindingsCalls(stub func(context.Context, *query.Parameters) (*types.ServiceBindings, error)) {
 	fake.listBindingsMutex.Lock()
 	defer fake.listBindingsMutex.Unlock()
 	fake.ListBindingsStub = stub
 }
 
-func (fake *FakeClient) ListBindingsArgsForCall(i int) *query.Parameters {
+func (fake *FakeClient) ListBindingsArgsForCall(i int) (context.Context, *query.Parameters) {
 	fake.listBindingsMutex.RLock()
 	defer fake.listBindingsMutex.RUnlock()
 	argsForCall := fake.listBindingsArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeClient) ListBindingsReturns(result1 *types.ServiceBindings, result2 error) {
@@ -1500,21 +1837,23 @@ func (fake *FakeClient) ListBindingsReturnsOnCall(i int, result1 *types.ServiceB
 	}{result1, result2}
 }
 
-func (fake *FakeClient) ListBrokers(arg1 *query.Parameters) (*types.Brokers, error) {
+func (fake *FakeClient) ListBrokers(arg1 context.Context, arg2 *query.Parameters) (*types.Brokers, error) {
 	fake.listBrokersMutex.Lock()
 	ret, specificReturn := fake.listBrokersReturnsOnCall[len(fake.listBrokersArgsForCall)]
 	fake.listBrokersArgsForCall = append(fake.listBrokersArgsForCall, struct {
-		arg1 *query.Parameters
-	}{arg1})
-	fake.recordInvocation("ListBrokers", []interface{}{arg1})
+		arg1 context.Context
+		arg2 *query.Parameters
+	}{arg1, arg2})
+	stub := fake.ListBrokersStub
+	fakeReturns := fake.listBrokersReturns
+	fake.recordInvocation("ListBrokers", []interface{}{arg1, arg2})
 	fake.listBrokersMutex.Unlock()
-	if fake.ListBrokersStub != nil {
-		return fake.ListBrokersStub(arg1)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.listBrokersReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -1524,17 +1863,17 @@ func (fake *FakeClient) ListBrokersCallCount() int {
 	return len(fake.listBrokersArgsForCall)
 }
 
-func (fake *FakeClient) ListBrokersCalls(stub func(*query.Parameters) (*types.Brokers, error)) {
+func (fake *FakeClient) ListBrokersCalls(stub func(context.Context, *query.Parameters) (*types.Brokers, error)) {
 	fake.listBrokersMutex.Lock()
 	defer fake.listBrokersMutex.Unlock()
 	fake.ListBrokersStub = stub
 }
 
-func (fake *FakeClient) ListBrokersArgsForCall(i int) *query.Parameters {
+func (fake *FakeClient) ListBrokersArgsForCall(i int) (context.Context, *query.Parameters) {
 	fake.listBrokersMutex.RLock()
 	defer fake.listBrokersMutex.RUnlock()
 	argsForCall := fake.listBrokersArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeClient) ListBrokersReturns(result1 *types.Brokers, result2 error) {
@@ -1563,21 +1902,23 @@ func (fake *FakeClient) ListBrokersReturnsOnCall(i int, result1 *types.Brokers,
 	}{result1, result2}
 }
 
-func (fake *FakeClient) ListInstances(arg1 *query.Parameters) (*types.ServiceInstances, error) {
+func (fake *FakeClient) ListInstances(arg1 context.Context, arg2 *query.Parameters) (*types.ServiceInstances, error) {
 	fake.listInstancesMutex.Lock()
 	ret, specificReturn := fake.listInstancesReturnsOnCall[len(fake.listInstancesArgsForCall)]
 	fake.listInstancesArgsForCall = append(fake.listInstancesArgsForCall, struct {
-		arg1 *query.Parameters
-	}{arg1})
-	fake.recordInvocation("ListInstances", []interface{}{arg1})
+		arg1 context.Context
+		arg2 *query.Parameters
+	}{arg1, arg2})
+	stub := fake.ListInstancesStub
+	fakeReturns := fake.listInstancesReturns
+	fake.recordInvocation("ListInstances", []interface{}{arg1, arg2})
 	fake.listInstancesMutex.Unlock()
-	if fake.ListInstancesStub != nil {
-		return fake.ListInstancesStub(arg1)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.listInstancesReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -1587,17 +1928,17 @@ func (fake *FakeClient) ListInstancesCallCount() int {
 	return len(fake.listInstancesArgsForCall)
 }
 
-func (fake *FakeClient) ListInstancesCalls(stub func(*query.Parameters) (*types.ServiceInstances, error)) {
+func (fake *FakeClient) ListInstancesCalls(stub func(context.Context, *query.Parameters) (*types.ServiceInstances, error)) {
 	fake.listInstancesMutex.Lock()
 	defer fake.listInstancesMutex.Unlock()
 	fake.ListInstancesStub = stub
 }
 
-func (fake *FakeClient) ListInstancesArgsForCall(i int) *query.Parameters {
+func (fake *FakeClient) ListInstancesArgsForCall(i int) (context.Context, *query.Parameters) {
 	fake.listInstancesMutex.RLock()
 	defer fake.listInstancesMutex.RUnlock()
 	argsForCall := fake.listInstancesArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeClient) ListInstancesReturns(result1 *types.ServiceInstances, result2 error) {
@@ -1626,21 +1967,86 @@ func (fake *FakeClient) ListInstancesReturnsOnCall(i int, result1 *types.Service
 	}{result1, result2}
 }
 
-func (fake *FakeClient) ListOfferings(arg1 *query.Parameters) (*types.ServiceOfferings, error) {
+func (fake *FakeClient) ListInstancesPaged(arg1 context.Context, arg2 *query.Parameters, arg3 *smclient.PageOptions) *smclient.InstancesPager {
+	fake.listInstancesPagedMutex.Lock()
+	ret, specificReturn := fake.listInstancesPagedReturnsOnCall[len(fake.listInstancesPagedArgsForCall)]
+	fake.listInstancesPagedArgsForCall = append(fake.listInstancesPagedArgsForCall, struct {
+		arg1 context.Context
+		arg2 *query.Parameters
+		arg3 *smclient.PageOptions
+	}{arg1, arg2, arg3})
+	stub := fake.ListInstancesPagedStub
+	fakeReturns := fake.listInstancesPagedReturns
+	fake.recordInvocation("ListInstancesPaged", []interface{}{arg1, arg2, arg3})
+	fake.listInstancesPagedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) ListInstancesPagedCallCount() int {
+	fake.listInstancesPagedMutex.RLock()
+	defer fake.listInstancesPagedMutex.RUnlock()
+	return len(fake.listInstancesPagedArgsForCall)
+}
+
+func (fake *FakeClient) ListInstancesPagedCalls(stub func(context.Context, *query.Parameters, *smclient.PageOptions) *smclient.InstancesPager) {
+	fake.listInstancesPagedMutex.Lock()
+	defer fake.listInstancesPagedMutex.Unlock()
+	fake.ListInstancesPagedStub = stub
+}
+
+func (fake *FakeClient) ListInstancesPagedArgsForCall(i int) (context.Context, *query.Parameters, *smclient.PageOptions) {
+	fake.listInstancesPagedMutex.RLock()
+	defer fake.listInstancesPagedMutex.RUnlock()
+	argsForCall := fake.listInstancesPagedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) ListInstancesPagedReturns(result1 *smclient.InstancesPager) {
+	fake.listInstancesPagedMutex.Lock()
+	defer fake.listInstancesPagedMutex.Unlock()
+	fake.ListInstancesPagedStub = nil
+	fake.listInstancesPagedReturns = struct {
+		result1 *smclient.InstancesPager
+	}{result1}
+}
+
+func (fake *FakeClient) ListInstancesPagedReturnsOnCall(i int, result1 *smclient.InstancesPager) {
+	fake.listInstancesPagedMutex.Lock()
+	defer fake.listInstancesPagedMutex.Unlock()
+	fake.ListInstancesPagedStub = nil
+	if fake.listInstancesPagedReturnsOnCall == nil {
+		fake.listInstancesPagedReturnsOnCall = make(map[int]struct {
+			result1 *smclient.InstancesPager
+		})
+	}
+	fake.listInstancesPagedReturnsOnCall[i] = struct {
+		result1 *smclient.InstancesPager
+	}{result1}
+}
+
+func (fake *FakeClient) ListOfferings(arg1 context.Context, arg2 *query.Parameters) (*types.ServiceOfferings, error) {
 	fake.listOfferingsMutex.Lock()
 	ret, specificReturn := fake.listOfferingsReturnsOnCall[len(fake.listOfferingsArgsForCall)]
 	fake.listOfferingsArgsForCall = append(fake.listOfferingsArgsForCall, struct {
-		arg1 *query.Parameters
-	}{arg1})
-	fake.recordInvocation("ListOfferings", []interface{}{arg1})
+		arg1 context.Context
+		arg2 *query.Parameters
+	}{arg1, arg2})
+	stub := fake.ListOfferingsStub
+	fakeReturns := fake.listOfferingsReturns
+	fake.recordInvocation("ListOfferings", []interface{}{arg1, arg2})
 	fake.listOfferingsMutex.Unlock()
-	if fake.ListOfferingsStub != nil {
-		return fake.ListOfferingsStub(arg1)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.listOfferingsReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -1650,17 +2056,17 @@ func (fake *FakeClient) ListOfferingsCallCount() int {
 	return len(fake.listOfferingsArgsForCall)
 }
 
-func (fake *FakeClient) ListOfferingsCalls(stub func(*query.Parameters) (*types.ServiceOfferings, error)) {
+func (fake *FakeClient) ListOfferingsCalls(stub func(context.Context, *query.Parameters) (*types.ServiceOfferings, error)) {
 	fake.listOfferingsMutex.Lock()
 	defer fake.listOfferingsMutex.Unlock()
 	fake.ListOfferingsStub = stub
 }
 
-func (fake *FakeClient) ListOfferingsArgsForCall(i int) *query.Parameters {
+func (fake *FakeClient) ListOfferingsArgsForCall(i int) (context.Context, *query.Parameters) {
 	fake.listOfferingsMutex.RLock()
 	defer fake.listOfferingsMutex.RUnlock()
 	argsForCall := fake.listOfferingsArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeClient) ListOfferingsReturns(result1 *types.ServiceOfferings, result2 error) {
@@ -1689,21 +2095,92 @@ func (fake *FakeClient) ListOfferingsReturnsOnCall(i int, result1 *types.Service
 	}{result1, result2}
 }
 
-func (fake *FakeClient) ListPlans(arg1 *query.Parameters) (*types.ServicePlans, error) {
+func (fake *FakeClient) ListPage(arg1 context.Context, arg2 string, arg3 *query.Parameters, arg4 int, arg5 string, arg6 interface{}) (string, error) {
+	fake.listPageMutex.Lock()
+	ret, specificReturn := fake.listPageReturnsOnCall[len(fake.listPageArgsForCall)]
+	fake.listPageArgsForCall = append(fake.listPageArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *query.Parameters
+		arg4 int
+		arg5 string
+		arg6 interface{}
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.ListPageStub
+	fakeReturns := fake.listPageReturns
+	fake.recordInvocation("ListPage", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.listPageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ListPageCallCount() int {
+	fake.listPageMutex.RLock()
+	defer fake.listPageMutex.RUnlock()
+	return len(fake.listPageArgsForCall)
+}
+
+func (fake *FakeClient) ListPageCalls(stub func(context.Context, string, *query.Parameters, int, string, interface{}) (string, error)) {
+	fake.listPageMutex.Lock()
+	defer fake.listPageMutex.Unlock()
+	fake.ListPageStub = stub
+}
+
+func (fake *FakeClient) ListPageArgsForCall(i int) (context.Context, string, *query.Parameters, int, string, interface{}) {
+	fake.listPageMutex.RLock()
+	defer fake.listPageMutex.RUnlock()
+	argsForCall := fake.listPageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeClient) ListPageReturns(result1 string, result2 error) {
+	fake.listPageMutex.Lock()
+	defer fake.listPageMutex.Unlock()
+	fake.ListPageStub = nil
+	fake.listPageReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ListPageReturnsOnCall(i int, result1 string, result2 error) {
+	fake.listPageMutex.Lock()
+	defer fake.listPageMutex.Unlock()
+	fake.ListPageStub = nil
+	if fake.listPageReturnsOnCall == nil {
+		fake.listPageReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.listPageReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ListPlans(arg1 context.Context, arg2 *query.Parameters) (*types.ServicePlans, error) {
 	fake.listPlansMutex.Lock()
 	ret, specificReturn := fake.listPlansReturnsOnCall[len(fake.listPlansArgsForCall)]
 	fake.listPlansArgsForCall = append(fake.listPlansArgsForCall, struct {
-		arg1 *query.Parameters
-	}{arg1})
-	fake.recordInvocation("ListPlans", []interface{}{arg1})
+		arg1 context.Context
+		arg2 *query.Parameters
+	}{arg1, arg2})
+	stub := fake.ListPlansStub
+	fakeReturns := fake.listPlansReturns
+	fake.recordInvocation("ListPlans", []interface{}{arg1, arg2})
 	fake.listPlansMutex.Unlock()
-	if fake.ListPlansStub != nil {
-		return fake.ListPlansStub(arg1)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.listPlansReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -1713,17 +2190,17 @@ func (fake *FakeClient) ListPlansCallCount() int {
 	return len(fake.listPlansArgsForCall)
 }
 
-func (fake *FakeClient) ListPlansCalls(stub func(*query.Parameters) (*types.ServicePlans, error)) {
+func (fake *FakeClient) ListPlansCalls(stub func(context.Context, *query.Parameters) (*types.ServicePlans, error)) {
 	fake.listPlansMutex.Lock()
 	defer fake.listPlansMutex.Unlock()
 	fake.ListPlansStub = stub
 }
 
-func (fake *FakeClient) ListPlansArgsForCall(i int) *query.Parameters {
+func (fake *FakeClient) ListPlansArgsForCall(i int) (context.Context, *query.Parameters) {
 	fake.listPlansMutex.RLock()
 	defer fake.listPlansMutex.RUnlock()
 	argsForCall := fake.listPlansArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeClient) ListPlansReturns(result1 *types.ServicePlans, result2 error) {
@@ -1752,21 +2229,23 @@ func (fake *FakeClient) ListPlansReturnsOnCall(i int, result1 *types.ServicePlan
 	}{result1, result2}
 }
 
-func (fake *FakeClient) ListPlatforms(arg1 *query.Parameters) (*types.Platforms, error) {
+func (fake *FakeClient) ListPlatforms(arg1 context.Context, arg2 *query.Parameters) (*types.Platforms, error) {
 	fake.listPlatformsMutex.Lock()
 	ret, specificReturn := fake.listPlatformsReturnsOnCall[len(fake.listPlatformsArgsForCall)]
 	fake.listPlatformsArgsForCall = append(fake.listPlatformsArgsForCall, struct {
-		arg1 *query.Parameters
-	}{arg1})
-	fake.recordInvocation("ListPlatforms", []interface{}{arg1})
+		arg1 context.Context
+		arg2 *query.Parameters
+	}{arg1, arg2})
+	stub := fake.ListPlatformsStub
+	fakeReturns := fake.listPlatformsReturns
+	fake.recordInvocation("ListPlatforms", []interface{}{arg1, arg2})
 	fake.listPlatformsMutex.Unlock()
-	if fake.ListPlatformsStub != nil {
-		return fake.ListPlatformsStub(arg1)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.listPlatformsReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -1776,17 +2255,17 @@ func (fake *FakeClient) ListPlatformsCallCount() int {
 	return len(fake.listPlatformsArgsForCall)
 }
 
-func (fake *FakeClient) ListPlatformsCalls(stub func(*query.Parameters) (*types.Platforms, error)) {
+func (fake *FakeClient) ListPlatformsCalls(stub func(context.Context, *query.Parameters) (*types.Platforms, error)) {
 	fake.listPlatformsMutex.Lock()
 	defer fake.listPlatformsMutex.Unlock()
 	fake.ListPlatformsStub = stub
 }
 
-func (fake *FakeClient) ListPlatformsArgsForCall(i int) *query.Parameters {
+func (fake *FakeClient) ListPlatformsArgsForCall(i int) (context.Context, *query.Parameters) {
 	fake.listPlatformsMutex.RLock()
 	defer fake.listPlatformsMutex.RUnlock()
 	argsForCall := fake.listPlatformsArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeClient) ListPlatformsReturns(result1 *types.Platforms, result2 error) {
@@ -1815,21 +2294,23 @@ func (fake *FakeClient) ListPlatformsReturnsOnCall(i int, result1 *types.Platfor
 	}{result1, result2}
 }
 
-func (fake *FakeClient) ListVisibilities(arg1 *query.Parameters) (*types.Visibilities, error) {
+func (fake *FakeClient) ListVisibilities(arg1 context.Context, arg2 *query.Parameters) (*types.Visibilities, error) {
 	fake.listVisibilitiesMutex.Lock()
 	ret, specificReturn := fake.listVisibilitiesReturnsOnCall[len(fake.listVisibilitiesArgsForCall)]
 	fake.listVisibilitiesArgsForCall = append(fake.listVisibilitiesArgsForCall, struct {
-		arg1 *query.Parameters
-	}{arg1})
-	fake.recordInvocation("ListVisibilities", []interface{}{arg1})
+		arg1 context.Context
+		arg2 *query.Parameters
+	}{arg1, arg2})
+	stub := fake.ListVisibilitiesStub
+	fakeReturns := fake.listVisibilitiesReturns
+	fake.recordInvocation("ListVisibilities", []interface{}{arg1, arg2})
 	fake.listVisibilitiesMutex.Unlock()
-	if fake.ListVisibilitiesStub != nil {
-		return fake.ListVisibilitiesStub(arg1)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.listVisibilitiesReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -1839,17 +2320,17 @@ func (fake *FakeClient) ListVisibilitiesCallCount() int {
 	return len(fake.listVisibilitiesArgsForCall)
 }
 
-func (fake *FakeClient) ListVisibilitiesCalls(stub func(*query.Parameters) (*types.Visibilities, error)) {
+func (fake *FakeClient) ListVisibilitiesCalls(stub func(context.Context, *query.Parameters) (*types.Visibilities, error)) {
 	fake.listVisibilitiesMutex.Lock()
 	defer fake.listVisibilitiesMutex.Unlock()
 	fake.ListVisibilitiesStub = stub
 }
 
-func (fake *FakeClient) ListVisibilitiesArgsForCall(i int) *query.Parameters {
+func (fake *FakeClient) ListVisibilitiesArgsForCall(i int) (context.Context, *query.Parameters) {
 	fake.listVisibilitiesMutex.RLock()
 	defer fake.listVisibilitiesMutex.RUnlock()
 	argsForCall := fake.listVisibilitiesArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeClient) ListVisibilitiesReturns(result1 *types.Visibilities, result2 error) {
@@ -1878,21 +2359,23 @@ func (fake *FakeClient) ListVisibilitiesReturnsOnCall(i int, result1 *types.Visi
 	}{result1, result2}
 }
 
-func (fake *FakeClient) Marketplace(arg1 *query.Parameters) (*types.Marketplace, error) {
+func (fake *FakeClient) Marketplace(arg1 context.Context, arg2 *query.Parameters) (*types.Marketplace, error) {
 	fake.marketplaceMutex.Lock()
 	ret, specificReturn := fake.marketplaceReturnsOnCall[len(fake.marketplaceArgsForCall)]
 	fake.marketplaceArgsForCall = append(fake.marketplaceArgsForCall, struct {
-		arg1 *query.Parameters
-	}{arg1})
-	fake.recordInvocation("Marketplace", []interface{}{arg1})
+		arg1 context.Context
+		arg2 *query.Parameters
+	}{arg1, arg2})
+	stub := fake.MarketplaceStub
+	fakeReturns := fake.marketplaceReturns
+	fake.recordInvocation("Marketplace", []interface{}{arg1, arg2})
 	fake.marketplaceMutex.Unlock()
-	if fake.MarketplaceStub != nil {
-		return fake.MarketplaceStub(arg1)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.marketplaceReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -1902,17 +2385,17 @@ func (fake *FakeClient) MarketplaceCallCount() int {
 	return len(fake.marketplaceArgsForCall)
 }
 
-func (fake *FakeClient) MarketplaceCalls(stub func(*query.Parameters) (*types.Marketplace, error)) {
+func (fake *FakeClient) MarketplaceCalls(stub func(context.Context, *query.Parameters) (*types.Marketplace, error)) {
 	fake.marketplaceMutex.Lock()
 	defer fake.marketplaceMutex.Unlock()
 	fake.MarketplaceStub = stub
 }
 
-func (fake *FakeClient) MarketplaceArgsForCall(i int) *query.Parameters {
+func (fake *FakeClient) MarketplaceArgsForCall(i int) (context.Context, *query.Parameters) {
 	fake.marketplaceMutex.RLock()
 	defer fake.marketplaceMutex.RUnlock()
 	argsForCall := fake.marketplaceArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeClient) MarketplaceReturns(result1 *types.Marketplace, result2 error) {
@@ -1941,22 +2424,24 @@ func (fake *FakeClient) MarketplaceReturnsOnCall(i int, result1 *types.Marketpla
 	}{result1, result2}
 }
 
-func (fake *FakeClient) Provision(arg1 *types.ServiceInstance, arg2 *query.Parameters) (*types.ServiceInstance, string, error) {
+func (fake *FakeClient) Provision(arg1 context.Context, arg2 *types.ServiceInstance, arg3 *query.Parameters) (*types.ServiceInstance, string, error) {
 	fake.provisionMutex.Lock()
 	ret, specificReturn := fake.provisionReturnsOnCall[len(fake.provisionArgsForCall)]
 	fake.provisionArgsForCall = append(fake.provisionArgsForCall, struct {
-		arg1 *types.ServiceInstance
-		arg2 *query.Parameters
-	}{arg1, arg2})
-	fake.recordInvocation("Provision", []interface{}{arg1, arg2})
+		arg1 context.Context
+		arg2 *types.ServiceInstance
+		arg3 *query.Parameters
+	}{arg1, arg2, arg3})
+	stub := fake.ProvisionStub
+	fakeReturns := fake.provisionReturns
+	fake.recordInvocation("Provision", []interface{}{arg1, arg2, arg3})
 	fake.provisionMutex.Unlock()
-	if fake.ProvisionStub != nil {
-		return fake.ProvisionStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.provisionReturns
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
@@ -1966,17 +2451,17 @@ func (fake *FakeClient) ProvisionCallCount() int {
 	return len(fake.provisionArgsForCall)
 }
 
-func (fake *FakeClient) ProvisionCalls(stub func(*types.ServiceInstance, *query.Parameters) (*types.ServiceInstance, string, error)) {
+func (fake *FakeClient) ProvisionCalls(stub func(context.Context, *types.ServiceInstance, *query.Parameters) (*types.ServiceInstance, string, error)) {
 	fake.provisionMutex.Lock()
 	defer fake.provisionMutex.Unlock()
 	fake.ProvisionStub = stub
 }
 
-func (fake *FakeClient) ProvisionArgsForCall(i int) (*types.ServiceInstance, *query.Parameters) {
+func (fake *FakeClient) ProvisionArgsForCall(i int) (context.Context, *types.ServiceInstance, *query.Parameters) {
 	fake.provisionMutex.RLock()
 	defer fake.provisionMutex.RUnlock()
 	argsForCall := fake.provisionArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeClient) ProvisionReturns(result1 *types.ServiceInstance, result2 string, result3 error) {
@@ -2008,22 +2493,24 @@ func (fake *FakeClient) ProvisionReturnsOnCall(i int, result1 *types.ServiceInst
 	}{result1, result2, result3}
 }
 
-func (fake *FakeClient) RegisterBroker(arg1 *types.Broker, arg2 *query.Parameters) (*types.Broker, string, error) {
+func (fake *FakeClient) RegisterBroker(arg1 context.Context, arg2 *types.Broker, arg3 *query.Parameters) (*types.Broker, string, error) {
 	fake.registerBrokerMutex.Lock()
 	ret, specificReturn := fake.registerBrokerReturnsOnCall[len(fake.registerBrokerArgsForCall)]
 	fake.registerBrokerArgsForCall = append(fake.registerBrokerArgsForCall, struct {
-		arg1 *types.Broker
-		arg2 *query.Parameters
-	}{arg1, arg2})
-	fake.recordInvocation("RegisterBroker", []interface{}{arg1, arg2})
+		arg1 context.Context
+		arg2 *types.Broker
+		arg3 *query.Parameters
+	}{arg1, arg2, arg3})
+	stub := fake.RegisterBrokerStub
+	fakeReturns := fake.registerBrokerReturns
+	fake.recordInvocation("RegisterBroker", []interface{}{arg1, arg2, arg3})
 	fake.registerBrokerMutex.Unlock()
-	if fake.RegisterBrokerStub != nil {
-		return fake.RegisterBrokerStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.registerBrokerReturns
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
@@ -2033,17 +2520,17 @@ func (fake *FakeClient) RegisterBrokerCallCount() int {
 	return len(fake.registerBrokerArgsForCall)
 }
 
-func (fake *FakeClient) RegisterBrokerCalls(stub func(*types.Broker, *query.Parameters) (*types.Broker, string, error)) {
+func (fake *FakeClient) RegisterBrokerCalls(stub func(context.Context, *types.Broker, *query.Parameters) (*types.Broker, string, error)) {
 	fake.registerBrokerMutex.Lock()
 	defer fake.registerBrokerMutex.Unlock()
 	fake.RegisterBrokerStub = stub
 }
 
-func (fake *FakeClient) RegisterBrokerArgsForCall(i int) (*types.Broker, *query.Parameters) {
+func (fake *FakeClient) RegisterBrokerArgsForCall(i int) (context.Context, *types.Broker, *query.Parameters) {
 	fake.registerBrokerMutex.RLock()
 	defer fake.registerBrokerMutex.RUnlock()
 	argsForCall := fake.registerBrokerArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeClient) RegisterBrokerReturns(result1 *types.Broker, result2 string, result3 error) {
@@ -2075,22 +2562,24 @@ func (fake *FakeClient) RegisterBrokerReturnsOnCall(i int, result1 *types.Broker
 	}{result1, result2, result3}
 }
 
-func (fake *FakeClient) RegisterPlatform(arg1 *types.Platform, arg2 *query.Parameters) (*types.Platform, error) {
+func (fake *FakeClient) RegisterPlatform(arg1 context.Context, arg2 *types.Platform, arg3 *query.Parameters) (*types.Platform, error) {
 	fake.registerPlatformMutex.Lock()
 	ret, specificReturn := fake.registerPlatformReturnsOnCall[len(fake.registerPlatformArgsForCall)]
 	fake.registerPlatformArgsForCall = append(fake.registerPlatformArgsForCall, struct {
-		arg1 *types.Platform
-		arg2 *query.Parameters
-	}{arg1, arg2})
-	fake.recordInvocation("RegisterPlatform", []interface{}{arg1, arg2})
+		arg1 context.Context
+		arg2 *types.Platform
+		arg3 *query.Parameters
+	}{arg1, arg2, arg3})
+	stub := fake.RegisterPlatformStub
+	fakeReturns := fake.registerPlatformReturns
+	fake.recordInvocation("RegisterPlatform", []interface{}{arg1, arg2, arg3})
 	fake.registerPlatformMutex.Unlock()
-	if fake.RegisterPlatformStub != nil {
-		return fake.RegisterPlatformStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.registerPlatformReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -2100,17 +2589,17 @@ func (fake *FakeClient) RegisterPlatformCallCount() int {
 	return len(fake.registerPlatformArgsForCall)
 }
 
-func (fake *FakeClient) RegisterPlatformCalls(stub func(*types.Platform, *query.Parameters) (*types.Platform, error)) {
+func (fake *FakeClient) RegisterPlatformCalls(stub func(context.Context, *types.Platform, *query.Parameters) (*types.Platform, error)) {
 	fake.registerPlatformMutex.Lock()
 	defer fake.registerPlatformMutex.Unlock()
 	fake.RegisterPlatformStub = stub
 }
 
-func (fake *FakeClient) RegisterPlatformArgsForCall(i int) (*types.Platform, *query.Parameters) {
+func (fake *FakeClient) RegisterPlatformArgsForCall(i int) (context.Context, *types.Platform, *query.Parameters) {
 	fake.registerPlatformMutex.RLock()
 	defer fake.registerPlatformMutex.RUnlock()
 	argsForCall := fake.registerPlatformArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeClient) RegisterPlatformReturns(result1 *types.Platform, result2 error) {
@@ -2139,22 +2628,24 @@ func (fake *FakeClient) RegisterPlatformReturnsOnCall(i int, result1 *types.Plat
 	}{result1, result2}
 }
 
-func (fake *FakeClient) RegisterVisibility(arg1 *types.Visibility, arg2 *query.Parameters) (*types.Visibility, error) {
+func (fake *FakeClient) RegisterVisibility(arg1 context.Context, arg2 *types.Visibility, arg3 *query.Parameters) (*types.Visibility, error) {
 	fake.registerVisibilityMutex.Lock()
 	ret, specificReturn := fake.registerVisibilityReturnsOnCall[len(fake.registerVisibilityArgsForCall)]
 	fake.registerVisibilityArgsForCall = append(fake.registerVisibilityArgsForCall, struct {
-		arg1 *types.Visibility
-		arg2 *query.Parameters
-	}{arg1, arg2})
-	fake.recordInvocation("RegisterVisibility", []interface{}{arg1, arg2})
+		arg1 context.Context
+		arg2 *types.Visibility
+		arg3 *query.Parameters
+	}{arg1, arg2, arg3})
+	stub := fake.RegisterVisibilityStub
+	fakeReturns := fake.registerVisibilityReturns
+	fake.recordInvocation("RegisterVisibility", []interface{}{arg1, arg2, arg3})
 	fake.registerVisibilityMutex.Unlock()
-	if fake.RegisterVisibilityStub != nil {
-		return fake.RegisterVisibilityStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.registerVisibilityReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -2164,17 +2655,17 @@ func (fake *FakeClient) RegisterVisibilityCallCount() int {
 	return len(fake.registerVisibilityArgsForCall)
 }
 
-func (fake *FakeClient) RegisterVisibilityCalls(stub func(*types.Visibility, *query.Parameters) (*types.Visibility, error)) {
+func (fake *FakeClient) RegisterVisibilityCalls(stub func(context.Context, *types.Visibility, *query.Parameters) (*types.Visibility, error)) {
 	fake.registerVisibilityMutex.Lock()
 	defer fake.registerVisibilityMutex.Unlock()
 	fake.RegisterVisibilityStub = stub
 }
 
-func (fake *FakeClient) RegisterVisibilityArgsForCall(i int) (*types.Visibility, *query.Parameters) {
+func (fake *FakeClient) RegisterVisibilityArgsForCall(i int) (context.Context, *types.Visibility, *query.Parameters) {
 	fake.registerVisibilityMutex.RLock()
 	defer fake.registerVisibilityMutex.RUnlock()
 	argsForCall := fake.registerVisibilityArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeClient) RegisterVisibilityReturns(result1 *types.Visibility, result2 error) {
@@ -2203,22 +2694,24 @@ func (fake *FakeClient) RegisterVisibilityReturnsOnCall(i int, result1 *types.Vi
 	}{result1, result2}
 }
 
-func (fake *FakeClient) Status(arg1 string, arg2 *query.Parameters) (*types.Operation, error) {
+func (fake *FakeClient) Status(arg1 context.Context, arg2 string, arg3 *query.Parameters) (*types.Operation, error) {
 	fake.statusMutex.Lock()
 	ret, specificReturn := fake.statusReturnsOnCall[len(fake.statusArgsForCall)]
 	fake.statusArgsForCall = append(fake.statusArgsForCall, struct {
-		arg1 string
-		arg2 *query.Parameters
-	}{arg1, arg2})
-	fake.recordInvocation("Status", []interface{}{arg1, arg2})
+		arg1 context.Context
+		arg2 string
+		arg3 *query.Parameters
+	}{arg1, arg2, arg3})
+	stub := fake.StatusStub
+	fakeReturns := fake.statusReturns
+	fake.recordInvocation("Status", []interface{}{arg1, arg2, arg3})
 	fake.statusMutex.Unlock()
-	if fake.StatusStub != nil {
-		return fake.StatusStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.statusReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -2228,17 +2721,17 @@ func (fake *FakeClient) StatusCallCount() int {
 	return len(fake.statusArgsForCall)
 }
 
-func (fake *FakeClient) StatusCalls(stub func(string, *query.Parameters) (*types.Operation, error)) {
+func (fake *FakeClient) StatusCalls(stub func(context.Context, string, *query.Parameters) (*types.Operation, error)) {
 	fake.statusMutex.Lock()
 	defer fake.statusMutex.Unlock()
 	fake.StatusStub = stub
 }
 
-func (fake *FakeClient) StatusArgsForCall(i int) (string, *query.Parameters) {
+func (fake *FakeClient) StatusArgsForCall(i int) (context.Context, string, *query.Parameters) {
 	fake.statusMutex.RLock()
 	defer fake.statusMutex.RUnlock()
 	argsForCall := fake.statusArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeClient) StatusReturns(result1 *types.Operation, result2 error) {
@@ -2267,22 +2760,24 @@ func (fake *FakeClient) StatusReturnsOnCall(i int, result1 *types.Operation, res
 	}{result1, result2}
 }
 
-func (fake *FakeClient) Unbind(arg1 string, arg2 *query.Parameters) (string, error) {
+func (fake *FakeClient) Unbind(arg1 context.Context, arg2 string, arg3 *query.Parameters) (string, error) {
 	fake.unbindMutex.Lock()
 	ret, specificReturn := fake.unbindReturnsOnCall[len(fake.unbindArgsForCall)]
 	fake.unbindArgsForCall = append(fake.unbindArgsForCall, struct {
-		arg1 string
-		arg2 *query.Parameters
-	}{arg1, arg2})
-	fake.recordInvocation("Unbind", []interface{}{arg1, arg2})
+		arg1 context.Context
+		arg2 string
+		arg3 *query.Parameters
+	}{arg1, arg2, arg3})
+	stub := fake.UnbindStub
+	fakeReturns := fake.unbindReturns
+	fake.recordInvocation("Unbind", []interface{}{arg1, arg2, arg3})
 	fake.unbindMutex.Unlock()
-	if fake.UnbindStub != nil {
-		return fake.UnbindStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.unbindReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -2292,17 +2787,17 @@ func (fake *FakeClient) UnbindCallCount() int {
 	return len(fake.unbindArgsForCall)
 }
 
-func (fake *FakeClient) UnbindCalls(stub func(string, *query.Parameters) (string, error)) {
+func (fake *FakeClient) UnbindCalls(stub func(context.Context, string, *query.Parameters) (string, error)) {
 	fake.unbindMutex.Lock()
 	defer fake.unbindMutex.Unlock()
 	fake.UnbindStub = stub
 }
 
-func (fake *FakeClient) UnbindArgsForCall(i int) (string, *query.Parameters) {
+func (fake *FakeClient) UnbindArgsForCall(i int) (context.Context, string, *query.Parameters) {
 	fake.unbindMutex.RLock()
 	defer fake.unbindMutex.RUnlock()
 	argsForCall := fake.unbindArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeClient) UnbindReturns(result1 string, result2 error) {
@@ -2331,23 +2826,25 @@ func (fake *FakeClient) UnbindReturnsOnCall(i int, result1 string, result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeClient) UpdateBroker(arg1 string, arg2 *types.Broker, arg3 *query.Parameters) (*types.Broker, string, error) {
+func (fake *FakeClient) UpdateBroker(arg1 context.Context, arg2 string, arg3 *types.Broker, arg4 *query.Parameters) (*types.Broker, string, error) {
 	fake.updateBrokerMutex.Lock()
 	ret, specificReturn := fake.updateBrokerReturnsOnCall[len(fake.updateBrokerArgsForCall)]
 	fake.updateBrokerArgsForCall = append(fake.updateBrokerArgsForCall, struct {
-		arg1 string
-		arg2 *types.Broker
-		arg3 *query.Parameters
-	}{arg1, arg2, arg3})
-	fake.recordInvocation("UpdateBroker", []interface{}{arg1, arg2, arg3})
+		arg1 context.Context
+		arg2 string
+		arg3 *types.Broker
+		arg4 *query.Parameters
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UpdateBrokerStub
+	fakeReturns := fake.updateBrokerReturns
+	fake.recordInvocation("UpdateBroker", []interface{}{arg1, arg2, arg3, arg4})
 	fake.updateBrokerMutex.Unlock()
-	if fake.UpdateBrokerStub != nil {
-		return fake.UpdateBrokerStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.updateBrokerReturns
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
@@ -2357,17 +2854,17 @@ func (fake *FakeClient) UpdateBrokerCallCount() int {
 	return len(fake.updateBrokerArgsForCall)
 }
 
-func (fake *FakeClient) UpdateBrokerCalls(stub func(string, *types.Broker, *query.Parameters) (*types.Broker, string, error)) {
+func (fake *FakeClient) UpdateBrokerCalls(stub func(context.Context, string, *types.Broker, *query.Parameters) (*types.Broker, string, error)) {
 	fake.updateBrokerMutex.Lock()
 	defer fake.updateBrokerMutex.Unlock()
 	fake.UpdateBrokerStub = stub
 }
 
-func (fake *FakeClient) UpdateBrokerArgsForCall(i int) (string, *types.Broker, *query.Parameters) {
+func (fake *FakeClient) UpdateBrokerArgsForCall(i int) (context.Context, string, *types.Broker, *query.Parameters) {
 	fake.updateBrokerMutex.RLock()
 	defer fake.updateBrokerMutex.RUnlock()
 	argsForCall := fake.updateBrokerArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
 func (fake *FakeClient) UpdateBrokerReturns(result1 *types.Broker, result2 string, result3 error) {
@@ -2399,23 +2896,25 @@ func (fake *FakeClient) UpdateBrokerReturnsOnCall(i int, result1 *types.Broker,
 	}{result1, result2, result3}
 }
 
-func (fake *FakeClient) UpdateInstance(arg1 string, arg2 *types.ServiceInstance, arg3 *query.Parameters) (*types.ServiceInstance, string, error) {
+func (fake *FakeClient) UpdateInstance(arg1 context.Context, arg2 string, arg3 *types.ServiceInstance, arg4 *query.Parameters) (*types.ServiceInstance, string, error) {
 	fake.updateInstanceMutex.Lock()
 	ret, specificReturn := fake.updateInstanceReturnsOnCall[len(fake.updateInstanceArgsForCall)]
 	fake.updateInstanceArgsForCall = append(fake.updateInstanceArgsForCall, struct {
-		arg1 string
-		arg2 *types.ServiceInstance
-		arg3 *query.Parameters
-	}{arg1, arg2, arg3})
-	fake.recordInvocation("UpdateInstance", []interface{}{arg1, arg2, arg3})
+		arg1 context.Context
+		arg2 string
+		arg3 *types.ServiceInstance
+		arg4 *query.Parameters
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UpdateInstanceStub
+	fakeReturns := fake.updateInstanceReturns
+	fake.recordInvocation("UpdateInstance", []interface{}{arg1, arg2, arg3, arg4})
 	fake.updateInstanceMutex.Unlock()
-	if fake.UpdateInstanceStub != nil {
-		return fake.UpdateInstanceStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.updateInstanceReturns
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
@@ -2425,17 +2924,17 @@ func (fake *FakeClient) UpdateInstanceCallCount() int {
 	return len(fake.updateInstanceArgsForCall)
 }
 
-func (fake *FakeClient) UpdateInstanceCalls(stub func(string, *types.ServiceInstance, *query.Parameters) (*types.ServiceInstance, string, error)) {
+func (fake *FakeClient) UpdateInstanceCalls(stub func(context.Context, string, *types.ServiceInstance, *query.Parameters) (*types.ServiceInstance, string, error)) {
 	fake.updateInstanceMutex.Lock()
 	defer fake.updateInstanceMutex.Unlock()
 	fake.UpdateInstanceStub = stub
 }
 
-func (fake *FakeClient) UpdateInstanceArgsForCall(i int) (string, *types.ServiceInstance, *query.Parameters) {
+func (fake *FakeClient) UpdateInstanceArgsForCall(i int) (context.Context, string, *types.ServiceInstance, *query.Parameters) {
 	fake.updateInstanceMutex.RLock()
 	defer fake.updateInstanceMutex.RUnlock()
 	argsForCall := fake.updateInstanceArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
 func (fake *FakeClient) UpdateInstanceReturns(result1 *types.ServiceInstance, result2 string, result3 error) {
@@ -2467,23 +2966,25 @@ func (fake *FakeClient) UpdateInstanceReturnsOnCall(i int, result1 *types.Servic
 	}{result1, result2, result3}
 }
 
-func (fake *FakeClient) UpdatePlatform(arg1 string, arg2 *types.Platform, arg3 *query.Parameters) (*types.Platform, error) {
+func (fake *FakeClient) UpdatePlatform(arg1 context.Context, arg2 string, arg3 *types.Platform, arg4 *query.Parameters) (*types.Platform, error) {
 	fake.updatePlatformMutex.Lock()
 	ret, specificReturn := fake.updatePlatformReturnsOnCall[len(fake.updatePlatformArgsForCall)]
 	fake.updatePlatformArgsForCall = append(fake.updatePlatformArgsForCall, struct {
-		arg1 string
-		arg2 *types.Platform
-		arg3 *query.Parameters
-	}{arg1, arg2, arg3})
-	fake.recordInvocation("UpdatePlatform", []interface{}{arg1, arg2, arg3})
+		arg1 context.Context
+		arg2 string
+		arg3 *types.Platform
+		arg4 *query.Parameters
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UpdatePlatformStub
+	fakeReturns := fake.updatePlatformReturns
+	fake.recordInvocation("UpdatePlatform", []interface{}{arg1, arg2, arg3, arg4})
 	fake.updatePlatformMutex.Unlock()
-	if fake.UpdatePlatformStub != nil {
-		return fake.UpdatePlatformStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.updatePlatformReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -2493,17 +2994,17 @@ func (fake *FakeClient) UpdatePlatformCallCount() int {
 	return len(fake.updatePlatformArgsForCall)
 }
 
-func (fake *FakeClient) UpdatePlatformCalls(stub func(string, *types.Platform, *query.Parameters) (*types.Platform, error)) {
+func (fake *FakeClient) UpdatePlatformCalls(stub func(context.Context, string, *types.Platform, *query.Parameters) (*types.Platform, error)) {
 	fake.updatePlatformMutex.Lock()
 	defer fake.updatePlatformMutex.Unlock()
 	fake.UpdatePlatformStub = stub
 }
 
-func (fake *FakeClient) UpdatePlatformArgsForCall(i int) (string, *types.Platform, *query.Parameters) {
+func (fake *FakeClient) UpdatePlatformArgsForCall(i int) (context.Context, string, *types.Platform, *query.Parameters) {
 	fake.updatePlatformMutex.RLock()
 	defer fake.updatePlatformMutex.RUnlock()
 	argsForCall := fake.updatePlatformArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
 func (fake *FakeClient) UpdatePlatformReturns(result1 *types.Platform, result2 error) {
@@ -2532,23 +3033,25 @@ func (fake *FakeClient) UpdatePlatformReturnsOnCall(i int, result1 *types.Platfo
 	}{result1, result2}
 }
 
-func (fake *FakeClient) UpdateVisibility(arg1 string, arg2 *types.Visibility, arg3 *query.Parameters) (*types.Visibility, error) {
+func (fake *FakeClient) UpdateVisibility(arg1 context.Context, arg2 string, arg3 *types.Visibility, arg4 *query.Parameters) (*types.Visibility, error) {
 	fake.updateVisibilityMutex.Lock()
 	ret, specificReturn := fake.updateVisibilityReturnsOnCall[len(fake.updateVisibilityArgsForCall)]
 	fake.updateVisibilityArgsForCall = append(fake.updateVisibilityArgsForCall, struct {
-		arg1 string
-		arg2 *types.Visibility
-		arg3 *query.Parameters
-	}{arg1, arg2, arg3})
-	fake.recordInvocation("UpdateVisibility", []interface{}{arg1, arg2, arg3})
+		arg1 context.Context
+		arg2 string
+		arg3 *types.Visibility
+		arg4 *query.Parameters
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UpdateVisibilityStub
+	fakeReturns := fake.updateVisibilityReturns
+	fake.recordInvocation("UpdateVisibility", []interface{}{arg1, arg2, arg3, arg4})
 	fake.updateVisibilityMutex.Unlock()
-	if fake.UpdateVisibilityStub != nil {
-		return fake.UpdateVisibilityStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.updateVisibilityReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -2558,17 +3061,17 @@ func (fake *FakeClient) UpdateVisibilityCallCount() int {
 	return len(fake.updateVisibilityArgsForCall)
 }
 
-func (fake *FakeClient) UpdateVisibilityCalls(stub func(string, *types.Visibility, *query.Parameters) (*types.Visibility, error)) {
+func (fake *FakeClient) UpdateVisibilityCalls(stub func(context.Context, string, *types.Visibility, *query.Parameters) (*types.Visibility, error)) {
 	fake.updateVisibilityMutex.Lock()
 	defer fake.updateVisibilityMutex.Unlock()
 	fake.UpdateVisibilityStub = stub
 }
 
-func (fake *FakeClient) UpdateVisibilityArgsForCall(i int) (string, *types.Visibility, *query.Parameters) {
+func (fake *FakeClient) UpdateVisibilityArgsForCall(i int) (context.Context, string, *types.Visibility, *query.Parameters) {
 	fake.updateVisibilityMutex.RLock()
 	defer fake.updateVisibilityMutex.RUnlock()
 	argsForCall := fake.updateVisibilityArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
 func (fake *FakeClient) UpdateVisibilityReturns(result1 *types.Visibility, result2 error) {
@@ -2626,6 +3129,14 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.getInstanceByIDMutex.RUnlock()
 	fake.getInstanceParametersMutex.RLock()
 	defer fake.getInstanceParametersMutex.RUnlock()
+	fake.getOfferingByIDMutex.RLock()
+	defer fake.getOfferingByIDMutex.RUnlock()
+	fake.getPlanByIDMutex.RLock()
+	defer fake.getPlanByIDMutex.RUnlock()
+	fake.getPlatformByIDMutex.RLock()
+	defer fake.getPlatformByIDMutex.RUnlock()
+	fake.getVisibilityByIDMutex.RLock()
+	defer fake.getVisibilityByIDMutex.RUnlock()
 	fake.labelMutex.RLock()
 	defer fake.labelMutex.RUnlock()
 	fake.listBindingsMutex.RLock()
@@ -2634,8 +3145,12 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.listBrokersMutex.RUnlock()
 	fake.listInstancesMutex.RLock()
 	defer fake.listInstancesMutex.RUnlock()
+	fake.listInstancesPagedMutex.RLock()
+	defer fake.listInstancesPagedMutex.RUnlock()
 	fake.listOfferingsMutex.RLock()
 	defer fake.listOfferingsMutex.RUnlock()
+	fake.listPageMutex.RLock()
+	defer fake.listPageMutex.RUnlock()
 	fake.listPlansMutex.RLock()
 	defer fake.listPlansMutex.RUnlock()
 	fake.listPlatformsMutex.RLock()
@@ -26,7 +26,7 @@ var _ = Describe("Binding test", func() {
 				}
 			})
 			It("should return all", func() {
-				result, err := client.ListBindings(params)
+				result, err := client.ListBindings(context.TODO(), params)
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(result.ServiceBindings).To(HaveLen(1))
 				Expect(result.ServiceBindings[0]).To(Equal(*binding))
@@ -44,7 +44,7 @@ var _ = Describe("Binding test", func() {
 				}
 			})
 			It("should return an empty array", func() {
-				result, err := client.ListBindings(params)
+				result, err := client.ListBindings(context.TODO(), params)
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(result.ServiceBindings).To(HaveLen(0))
 			})
@@ -57,7 +57,7 @@ var _ = Describe("Binding test", func() {
 				}
 			})
 			It("should handle status code != 200", func() {
-				_, err := client.ListBindings(params)
+				_, err := client.ListBindings(context.TODO(), params)
 				Expect(err).Should(HaveOccurred())
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
 			})
@@ -70,7 +70,7 @@ var _ = Describe("Binding test", func() {
 				}
 			})
 			It("should handle status code > 299", func() {
-				_, err := client.ListBindings(params)
+				_, err := client.ListBindings(context.TODO(), params)
 				Expect(err).Should(HaveOccurred())
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
 			})
@@ -86,7 +86,7 @@ var _ = Describe("Binding test", func() {
 				}
 			})
 			It("should return it", func() {
-				result, err := client.GetBindingByID(binding.ID, params)
+				result, err := client.GetBindingByID(context.TODO(), binding.ID, params)
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(result).To(Equal(binding))
 			})
@@ -99,7 +99,7 @@ var _ = Describe("Binding test", func() {
 				}
 			})
 			It("should return 404", func() {
-				_, err := client.GetBindingByID(binding.ID, params)
+				_, err := client.GetBindingByID(context.TODO(), binding.ID, params)
 				Expect(err).Should(HaveOccurred())
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path+binding.ID, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
 			})
@@ -112,7 +112,7 @@ var _ = Describe("Binding test", func() {
 				}
 			})
 			It("should handle status code != 200", func() {
-				_, err := client.GetBindingByID(binding.ID, params)
+				_, err := client.GetBindingByID(context.TODO(), binding.ID, params)
 				Expect(err).Should(HaveOccurred())
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path+binding.ID, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
 			})
@@ -125,7 +125,7 @@ var _ = Describe("Binding test", func() {
 				}
 			})
 			It("should handle status code > 299", func() {
-				_, err := client.GetBindingByID(binding.ID, params)
+				_, err := client.GetBindingByID(context.TODO(), binding.ID, params)
 				Expect(err).Should(HaveOccurred())
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path+binding.ID, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
 
@@ -144,7 +144,7 @@ var _ = Describe("Binding test", func() {
 				}
 			})
 			It("should return parameters", func() {
-				result, err := client.GetBindingParameters(binding.ID, params)
+				result, err := client.GetBindingParameters(context.TODO(), binding.ID, params)
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(result).To(Equal(bindingParameters))
 			})
@@ -161,7 +161,7 @@ var _ = Describe("Binding test", func() {
 				}
 			})
 			It("should return no parameters", func() {
-				result, err := client.GetBindingParameters(binding.ID, params)
+				result, err := client.GetBindingParameters(context.TODO(), binding.ID, params)
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(result).To(Equal(bindingParameters))
 			})
@@ -176,7 +176,7 @@ var _ = Describe("Binding test", func() {
 				}
 			})
 			It("should return 404", func() {
-				_, err := client.GetBindingParameters(binding.ID, params)
+				_, err := client.GetBindingParameters(context.TODO(), binding.ID, params)
 				Expect(err).Should(HaveOccurred())
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
 			})
@@ -191,7 +191,7 @@ var _ = Describe("Binding test", func() {
 				}
 			})
 			It("should return an error with status code 502", func() {
-				_, err := client.GetBindingParameters(binding.ID, params)
+				_, err := client.GetBindingParameters(context.TODO(), binding.ID, params)
 				Expect(err).Should(HaveOccurred())
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
 			})
@@ -206,7 +206,7 @@ var _ = Describe("Binding test", func() {
 				}
 			})
 			It("should return an error with status code 400", func() {
-				_, err := client.GetBindingParameters(binding.ID, params)
+				_, err := client.GetBindingParameters(context.TODO(), binding.ID, params)
 				Expect(err).Should(HaveOccurred())
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
 
@@ -223,7 +223,7 @@ var _ = Describe("Binding test", func() {
 				}
 			})
 			It("should provision successfully", func() {
-				responseBinding, location, err := client.Bind(binding, params)
+				responseBinding, location, err := client.Bind(context.TODO(), binding, params)
 
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(location).Should(HaveLen(0))
@@ -240,7 +240,7 @@ var _ = Describe("Binding test", func() {
 				}
 			})
 			It("should receive operation location", func() {
-				responseBinding, location, err := client.Bind(binding, params)
+				responseBinding, location, err := client.Bind(context.TODO(), binding, params)
 
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(location).Should(Equal(locationHeader))
@@ -260,7 +260,7 @@ var _ = Describe("Binding test", func() {
 				}
 			})
 			It("should return error", func() {
-				responseBinding, location, err := client.Bind(binding, params)
+				responseBinding, location, err := client.Bind(context.TODO(), binding, params)
 
 				Expect(err).Should(HaveOccurred())
 				Expect(location).Should(BeEmpty())
@@ -277,7 +277,7 @@ var _ = Describe("Binding test", func() {
 					}
 				})
 				It("should return error with status code", func() {
-					responseBinding, location, err := client.Bind(binding, params)
+					responseBinding, location, err := client.Bind(context.TODO(), binding, params)
 
 					Expect(err).Should(HaveOccurred())
 					Expect(location).Should(BeEmpty())
@@ -294,7 +294,7 @@ var _ = Describe("Binding test", func() {
 					}
 				})
 				It("should return error with url and description", func() {
-					responseBinding, location, err := client.Bind(binding, params)
+					responseBinding, location, err := client.Bind(context.TODO(), binding, params)
 
 					Expect(err).Should(HaveOccurred())
 					Expect(location).Should(BeEmpty())
@@ -311,7 +311,7 @@ var _ = Describe("Binding test", func() {
 					}
 				})
 				It("should return error without url and description if invalid response body", func() {
-					responseBinding, location, err := client.Bind(binding, params)
+					responseBinding, location, err := client.Bind(context.TODO(), binding, params)
 
 					Expect(err).Should(HaveOccurred())
 					Expect(location).Should(BeEmpty())
@@ -325,8 +325,8 @@ var _ = Describe("Binding test", func() {
 
 		Context("When invalid config is set", func() {
 			It("should return error", func() {
-				client = smclient.NewClient(context.TODO(), fakeAuthClient, "invalidURL")
-				_, location, err := client.Bind(binding, params)
+				client = smclient.NewClient(fakeAuthClient, "invalidURL")
+				_, location, err := client.Bind(context.TODO(), binding, params)
 
 				Expect(err).Should(HaveOccurred())
 				Expect(location).Should(BeEmpty())
@@ -343,7 +343,7 @@ var _ = Describe("Binding test", func() {
 				}
 			})
 			It("should be successfully removed", func() {
-				location, err := client.Unbind(binding.ID, params)
+				location, err := client.Unbind(context.TODO(), binding.ID, params)
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(location).Should(BeEmpty())
 			})
@@ -358,7 +358,7 @@ var _ = Describe("Binding test", func() {
 				}
 			})
 			It("should be successfully removed", func() {
-				location, err := client.Unbind(binding.ID, params)
+				location, err := client.Unbind(context.TODO(), binding.ID, params)
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(location).Should(Equal(locationHeader))
 			})
@@ -373,7 +373,7 @@ var _ = Describe("Binding test", func() {
 				}
 			})
 			It("should handle error", func() {
-				location, err := client.Unbind(binding.ID, params)
+				location, err := client.Unbind(context.TODO(), binding.ID, params)
 				Expect(err).Should(HaveOccurred())
 				Expect(location).Should(BeEmpty())
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path+binding.ID, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
@@ -389,7 +389,7 @@ var _ = Describe("Binding test", func() {
 				}
 			})
 			It("should handle error", func() {
-				location, err := client.Unbind(binding.ID, params)
+				location, err := client.Unbind(context.TODO(), binding.ID, params)
 				Expect(err).Should(HaveOccurred())
 				Expect(location).Should(BeEmpty())
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path+binding.ID, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
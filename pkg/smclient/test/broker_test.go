@@ -26,7 +26,7 @@ var _ = Describe("Broker test", func() {
 				}
 			})
 			It("should return all", func() {
-				result, err := client.ListBrokers(params)
+				result, err := client.ListBrokers(context.TODO(), params)
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(result.Brokers).To(HaveLen(1))
 				Expect(result.Brokers[0]).To(Equal(*broker))
@@ -43,7 +43,7 @@ var _ = Describe("Broker test", func() {
 				}
 			})
 			It("should return an empty array", func() {
-				result, err := client.ListBrokers(params)
+				result, err := client.ListBrokers(context.TODO(), params)
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(result.Brokers).To(HaveLen(0))
 			})
@@ -56,7 +56,7 @@ var _ = Describe("Broker test", func() {
 				}
 			})
 			It("should handle status code != 200", func() {
-				_, err := client.ListBrokers(params)
+				_, err := client.ListBrokers(context.TODO(), params)
 				Expect(err).Should(HaveOccurred())
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
 			})
@@ -69,7 +69,7 @@ var _ = Describe("Broker test", func() {
 				}
 			})
 			It("should handle status code > 299", func() {
-				_, err := client.ListBrokers(params)
+				_, err := client.ListBrokers(context.TODO(), params)
 				Expect(err).Should(HaveOccurred())
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
 
@@ -86,7 +86,7 @@ var _ = Describe("Broker test", func() {
 				}
 			})
 			It("should return it", func() {
-				result, err := client.GetBrokerByID(broker.ID, params)
+				result, err := client.GetBrokerByID(context.TODO(), broker.ID, params)
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(result).To(Equal(broker))
 			})
@@ -99,7 +99,7 @@ var _ = Describe("Broker test", func() {
 				}
 			})
 			It("should return 404", func() {
-				_, err := client.GetBrokerByID(broker.ID, params)
+				_, err := client.GetBrokerByID(context.TODO(), broker.ID, params)
 				Expect(err).Should(HaveOccurred())
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path+broker.ID, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
 			})
@@ -112,7 +112,7 @@ var _ = Describe("Broker test", func() {
 				}
 			})
 			It("should handle status code != 200", func() {
-				_, err := client.GetBrokerByID(broker.ID, params)
+				_, err := client.GetBrokerByID(context.TODO(), broker.ID, params)
 				Expect(err).Should(HaveOccurred())
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path+broker.ID, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
 			})
@@ -125,7 +125,7 @@ var _ = Describe("Broker test", func() {
 				}
 			})
 			It("should handle status code > 299", func() {
-				_, err := client.GetBrokerByID(broker.ID, params)
+				_, err := client.GetBrokerByID(context.TODO(), broker.ID, params)
 				Expect(err).Should(HaveOccurred())
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path+broker.ID, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
 
@@ -142,7 +142,7 @@ var _ = Describe("Broker test", func() {
 				}
 			})
 			It("should register successfully", func() {
-				responseBroker, location, err := client.RegisterBroker(broker, params)
+				responseBroker, location, err := client.RegisterBroker(context.TODO(), broker, params)
 
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(location).Should(HaveLen(0))
@@ -159,7 +159,7 @@ var _ = Describe("Broker test", func() {
 				}
 			})
 			It("should receive operation location", func() {
-				responseBroker, location, err := client.RegisterBroker(broker, params)
+				responseBroker, location, err := client.RegisterBroker(context.TODO(), broker, params)
 
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(location).Should(Equal(locationHeader))
@@ -179,7 +179,7 @@ var _ = Describe("Broker test", func() {
 				}
 			})
 			It("should return error", func() {
-				responseBroker, location, err := client.RegisterBroker(broker, params)
+				responseBroker, location, err := client.RegisterBroker(context.TODO(), broker, params)
 
 				Expect(err).Should(HaveOccurred())
 				Expect(location).Should(BeEmpty())
@@ -196,7 +196,7 @@ var _ = Describe("Broker test", func() {
 					}
 				})
 				It("should return error with status code", func() {
-					responseBroker, location, err := client.RegisterBroker(broker, params)
+					responseBroker, location, err := client.RegisterBroker(context.TODO(), broker, params)
 
 					Expect(err).Should(HaveOccurred())
 					Expect(location).Should(BeEmpty())
@@ -213,7 +213,7 @@ var _ = Describe("Broker test", func() {
 					}
 				})
 				It("should return error with url and description", func() {
-					responseBroker, location, err := client.RegisterBroker(broker, params)
+					responseBroker, location, err := client.RegisterBroker(context.TODO(), broker, params)
 
 					Expect(err).Should(HaveOccurred())
 					Expect(location).Should(BeEmpty())
@@ -230,7 +230,7 @@ var _ = Describe("Broker test", func() {
 					}
 				})
 				It("should return error without url and description if invalid response body", func() {
-					responseBroker, location, err := client.RegisterBroker(broker, params)
+					responseBroker, location, err := client.RegisterBroker(context.TODO(), broker, params)
 
 					Expect(err).Should(HaveOccurred())
 					Expect(location).Should(BeEmpty())
@@ -244,8 +244,8 @@ var _ = Describe("Broker test", func() {
 
 		Context("When invalid config is set", func() {
 			It("should return error", func() {
-				client = smclient.NewClient(context.TODO(),fakeAuthClient, "invalidURL")
-				_, location, err := client.RegisterBroker(broker, params)
+				client = smclient.NewClient(fakeAuthClient, "invalidURL")
+				_, location, err := client.RegisterBroker(context.TODO(), broker, params)
 
 				Expect(err).Should(HaveOccurred())
 				Expect(location).Should(BeEmpty())
@@ -263,7 +263,7 @@ var _ = Describe("Broker test", func() {
 				}
 			})
 			It("should be successfully updated", func() {
-				updatedBroker, location, err := client.UpdateBroker("id", broker, params)
+				updatedBroker, location, err := client.UpdateBroker(context.TODO(), "id", broker, params)
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(location).Should(HaveLen(0))
 				Expect(updatedBroker).To(Equal(broker))
@@ -279,7 +279,7 @@ var _ = Describe("Broker test", func() {
 				}
 			})
 			It("should be successfully updated", func() {
-				updatedBroker, location, err := client.UpdateBroker("id", broker, params)
+				updatedBroker, location, err := client.UpdateBroker(context.TODO(), "id", broker, params)
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(location).Should(Equal(locationHeader))
 				Expect(updatedBroker).To(BeNil())
@@ -295,7 +295,7 @@ var _ = Describe("Broker test", func() {
 				}
 			})
 			It("should handle error", func() {
-				_, location, err := client.UpdateBroker("id", broker, params)
+				_, location, err := client.UpdateBroker(context.TODO(), "id", broker, params)
 				Expect(err).Should(HaveOccurred())
 				Expect(location).Should(HaveLen(0))
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path+"id", handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
@@ -311,7 +311,7 @@ var _ = Describe("Broker test", func() {
 				}
 			})
 			It("should handle error", func() {
-				_, location, err := client.UpdateBroker("id", broker, params)
+				_, location, err := client.UpdateBroker(context.TODO(), "id", broker, params)
 				Expect(err).Should(HaveOccurred())
 				Expect(location).Should(HaveLen(0))
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path+"id", handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
@@ -329,7 +329,7 @@ var _ = Describe("Broker test", func() {
 				}
 			})
 			It("should be successfully removed", func() {
-				location, err := client.DeleteBroker(broker.ID, params)
+				location, err := client.DeleteBroker(context.TODO(), broker.ID, params)
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(location).Should(BeEmpty())
 			})
@@ -344,7 +344,7 @@ var _ = Describe("Broker test", func() {
 				}
 			})
 			It("should be successfully removed", func() {
-				location, err := client.DeleteBroker(broker.ID, params)
+				location, err := client.DeleteBroker(context.TODO(), broker.ID, params)
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(location).Should(Equal(locationHeader))
 			})
@@ -359,7 +359,7 @@ var _ = Describe("Broker test", func() {
 				}
 			})
 			It("should handle error", func() {
-				location, err := client.DeleteBroker(broker.ID, params)
+				location, err := client.DeleteBroker(context.TODO(), broker.ID, params)
 				Expect(err).Should(HaveOccurred())
 				Expect(location).Should(BeEmpty())
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path+broker.ID, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
@@ -375,7 +375,7 @@ var _ = Describe("Broker test", func() {
 				}
 			})
 			It("should handle error", func() {
-				location, err := client.DeleteBroker(broker.ID, params)
+				location, err := client.DeleteBroker(context.TODO(), broker.ID, params)
 				Expect(err).Should(HaveOccurred())
 				Expect(location).Should(BeEmpty())
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path+broker.ID, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
@@ -2,6 +2,7 @@ package test
 
 import (
 	"context"
+	"errors"
 	"github.com/Peripli/service-manager-cli/pkg/smclient"
 	"github.com/Peripli/service-manager/pkg/web"
 	"net/http"
@@ -21,12 +22,58 @@ var _ = Describe("SM Client test", func() {
 			})
 			It("should fail to authentication", func() {
 				fakeAuthClient.AccessToken = invalidToken
-				client = smclient.NewClient(context.TODO(), fakeAuthClient, smServer.URL)
-				_, err := client.ListBrokers(params)
+				client = smclient.NewClient(fakeAuthClient, smServer.URL)
+				_, err := client.ListBrokers(context.TODO(), params)
 
 				Expect(err).Should(HaveOccurred())
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path, []byte{}, http.StatusUnauthorized)
 			})
 		})
 	})
+
+	Describe("Test cancelled context", func() {
+		BeforeEach(func() {
+			handlerDetails = []HandlerDetails{
+				{Method: http.MethodGet, Path: web.ServiceBrokersURL, ResponseStatusCode: http.StatusOK},
+			}
+		})
+
+		It("should not send the request", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			_, err := client.ListBrokers(ctx, params)
+
+			Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+		})
+	})
+
+	Describe("Test API errors", func() {
+		BeforeEach(func() {
+			handlerDetails = []HandlerDetails{
+				{
+					Method:             http.MethodGet,
+					Path:               web.ServiceBrokersURL + "/",
+					ResponseBody:       []byte(`{"error":"NotFound","description":"could not find such broker"}`),
+					ResponseStatusCode: http.StatusNotFound,
+					Headers:            map[string]string{"X-Correlation-ID": "correlation-id"},
+				},
+			}
+		})
+
+		It("should return the details of the failed request", func() {
+			_, err := client.GetBrokerByID(context.TODO(), "broker-id", params)
+
+			var apiErr *smclient.APIError
+			Expect(errors.As(err, &apiErr)).To(BeTrue())
+			Expect(apiErr.StatusCode).To(Equal(http.StatusNotFound))
+			Expect(apiErr.ErrorCode).To(Equal("NotFound"))
+			Expect(apiErr.Description).To(Equal("could not find such broker"))
+			Expect(apiErr.Method).To(Equal(http.MethodGet))
+			Expect(apiErr.Path).To(Equal(web.ServiceBrokersURL + "/broker-id"))
+			Expect(apiErr.CorrelationID).To(Equal("correlation-id"))
+			Expect(smclient.IsNotFound(err)).To(BeTrue())
+			Expect(smclient.IsConflict(err)).To(BeFalse())
+			verifyErrorMsg(err.Error(), handlerDetails[0].Path+"broker-id", handlerDetails[0].ResponseBody, http.StatusNotFound)
+		})
+	})
 })
@@ -1,6 +1,7 @@
 package test
 
 import (
+	"context"
 	"github.com/Peripli/service-manager/pkg/web"
 	"net/http"
 
@@ -18,7 +19,7 @@ var _ = Describe("Info test", func() {
 			}
 		})
 		It("should get the right issuer and default token basic auth", func() {
-			info, _ := client.GetInfo(params)
+			info, _ := client.GetInfo(context.TODO(), params)
 			Expect(info.TokenIssuerURL).To(Equal("http://uaa.com"))
 			Expect(info.TokenBasicAuth).To(BeTrue()) // default value
 		})
@@ -33,7 +34,7 @@ var _ = Describe("Info test", func() {
 			}
 		})
 		It("should get the right value", func() {
-			info, _ := client.GetInfo(params)
+			info, _ := client.GetInfo(context.TODO(), params)
 			Expect(info.TokenIssuerURL).To(Equal("http://uaa.com"))
 			Expect(info.TokenBasicAuth).To(BeFalse())
 		})
@@ -48,7 +49,7 @@ var _ = Describe("Info test", func() {
 			}
 		})
 		It("should get an error", func() {
-			_, err := client.GetInfo(params)
+			_, err := client.GetInfo(context.TODO(), params)
 			Expect(err).Should(HaveOccurred())
 			verifyErrorMsg(err.Error(), handlerDetails[0].Path, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
 
@@ -64,7 +65,7 @@ var _ = Describe("Info test", func() {
 			}
 		})
 		It("should get an error", func() {
-			_, err := client.GetInfo(params)
+			_, err := client.GetInfo(context.TODO(), params)
 			Expect(err).Should(HaveOccurred())
 		})
 	})
@@ -78,7 +79,7 @@ var _ = Describe("Info test", func() {
 		})
 
 		It("should make request with these parameters", func() {
-			info, err := client.GetInfo(params)
+			info, err := client.GetInfo(context.TODO(), params)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(info).ToNot(BeNil())
 		})
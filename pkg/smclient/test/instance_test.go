@@ -28,7 +28,7 @@ var _ = Describe("Instance test", func() {
 				}
 			})
 			It("should return all", func() {
-				result, err := client.ListInstances(params)
+				result, err := client.ListInstances(context.TODO(), params)
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(result.ServiceInstances).To(HaveLen(1))
 				Expect(result.ServiceInstances[0]).To(Equal(*instance))
@@ -46,7 +46,7 @@ var _ = Describe("Instance test", func() {
 				}
 			})
 			It("should return an empty array", func() {
-				result, err := client.ListInstances(params)
+				result, err := client.ListInstances(context.TODO(), params)
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(result.ServiceInstances).To(HaveLen(0))
 			})
@@ -59,7 +59,7 @@ var _ = Describe("Instance test", func() {
 				}
 			})
 			It("should handle status code != 200", func() {
-				_, err := client.ListInstances(params)
+				_, err := client.ListInstances(context.TODO(), params)
 				Expect(err).Should(HaveOccurred())
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
 			})
@@ -72,13 +72,47 @@ var _ = Describe("Instance test", func() {
 				}
 			})
 			It("should handle status code > 299", func() {
-				_, err := client.ListInstances(params)
+				_, err := client.ListInstances(context.TODO(), params)
 				Expect(err).Should(HaveOccurred())
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
 			})
 		})
 	})
 
+	Describe("List service instances paged", func() {
+		BeforeEach(func() {
+			responseBody, _ := json.Marshal(map[string]interface{}{
+				"token": "next-page",
+				"items": []types.ServiceInstance{*instance},
+			})
+			handlerDetails = []HandlerDetails{
+				{Method: http.MethodGet, Path: web.ServiceInstancesURL, ResponseBody: responseBody, ResponseStatusCode: http.StatusOK},
+			}
+		})
+
+		It("should load one page at a time", func() {
+			pager := client.ListInstancesPaged(context.TODO(), params, &smclient.PageOptions{PageSize: 1, PageToken: "first-page"})
+			Expect(pager.HasNext()).To(BeTrue())
+
+			result, err := pager.Next()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result.ServiceInstances).To(ConsistOf(*instance))
+			Expect(fakeAuthClient.requestURI).To(ContainSubstring("max_items=1"))
+			Expect(fakeAuthClient.requestURI).To(ContainSubstring("token=first-page"))
+			Expect(pager.HasNext()).To(BeTrue())
+			Expect(pager.Token()).To(Equal("next-page"))
+		})
+
+		It("should stop after max items", func() {
+			pager := client.ListInstancesPaged(context.TODO(), params, &smclient.PageOptions{MaxItems: 1})
+
+			_, err := pager.Next()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(pager.HasNext()).To(BeFalse())
+			Expect(pager.Token()).To(Equal("next-page"))
+		})
+	})
+
 	Describe("Get service instance", func() {
 		Context("when there is instance with this id", func() {
 			BeforeEach(func() {
@@ -88,7 +122,7 @@ var _ = Describe("Instance test", func() {
 				}
 			})
 			It("should return it", func() {
-				result, err := client.GetInstanceByID(instance.ID, params)
+				result, err := client.GetInstanceByID(context.TODO(), instance.ID, params)
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(result).To(Equal(instance))
 			})
@@ -101,7 +135,7 @@ var _ = Describe("Instance test", func() {
 				}
 			})
 			It("should return 404", func() {
-				_, err := client.GetInstanceByID(instance.ID, params)
+				_, err := client.GetInstanceByID(context.TODO(), instance.ID, params)
 				Expect(err).Should(HaveOccurred())
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path+instance.ID, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
 			})
@@ -114,7 +148,7 @@ var _ = Describe("Instance test", func() {
 				}
 			})
 			It("should handle status code != 200", func() {
-				_, err := client.GetInstanceByID(instance.ID, params)
+				_, err := client.GetInstanceByID(context.TODO(), instance.ID, params)
 				Expect(err).Should(HaveOccurred())
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path+instance.ID, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
 			})
@@ -127,7 +161,7 @@ var _ = Describe("Instance test", func() {
 				}
 			})
 			It("should handle status code > 299", func() {
-				_, err := client.GetInstanceByID(instance.ID, params)
+				_, err := client.GetInstanceByID(context.TODO(), instance.ID, params)
 				Expect(err).Should(HaveOccurred())
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path+instance.ID, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
 
@@ -146,7 +180,7 @@ var _ = Describe("Instance test", func() {
 				}
 			})
 			It("should return parameters", func() {
-				result, err := client.GetInstanceParameters(instance.ID, params)
+				result, err := client.GetInstanceParameters(context.TODO(), instance.ID, params)
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(result).To(Equal(instanceParameters))
 			})
@@ -163,7 +197,7 @@ var _ = Describe("Instance test", func() {
 				}
 			})
 			It("should return empty parameters", func() {
-				result, err := client.GetInstanceParameters(instance.ID, params)
+				result, err := client.GetInstanceParameters(context.TODO(), instance.ID, params)
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(result).To(Equal(instanceParameters))
 			})
@@ -178,7 +212,7 @@ var _ = Describe("Instance test", func() {
 				}
 			})
 			It("should return 404", func() {
-				_, err := client.GetInstanceParameters(instance.ID, params)
+				_, err := client.GetInstanceParameters(context.TODO(), instance.ID, params)
 				Expect(err).Should(HaveOccurred())
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
 			})
@@ -193,7 +227,7 @@ var _ = Describe("Instance test", func() {
 				}
 			})
 			It("should return an error with status code 502", func() {
-				_, err := client.GetInstanceParameters(instance.ID, params)
+				_, err := client.GetInstanceParameters(context.TODO(), instance.ID, params)
 				Expect(err).Should(HaveOccurred())
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
 			})
@@ -208,7 +242,7 @@ var _ = Describe("Instance test", func() {
 				}
 			})
 			It("should return an error with status code 400", func() {
-				_, err := client.GetInstanceParameters(instance.ID, params)
+				_, err := client.GetInstanceParameters(context.TODO(), instance.ID, params)
 				Expect(err).Should(HaveOccurred())
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
 
@@ -225,7 +259,7 @@ var _ = Describe("Instance test", func() {
 				}
 			})
 			It("should provision successfully", func() {
-				responseInstance, location, err := client.Provision(instance, params)
+				responseInstance, location, err := client.Provision(context.TODO(), instance, params)
 
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(location).Should(HaveLen(0))
@@ -242,7 +276,7 @@ var _ = Describe("Instance test", func() {
 				}
 			})
 			It("should receive operation location", func() {
-				responseInstance, location, err := client.Provision(instance, params)
+				responseInstance, location, err := client.Provision(context.TODO(), instance, params)
 
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(location).Should(Equal(locationHeader))
@@ -262,7 +296,7 @@ var _ = Describe("Instance test", func() {
 				}
 			})
 			It("should return error", func() {
-				responseInstance, location, err := client.Provision(instance, params)
+				responseInstance, location, err := client.Provision(context.TODO(), instance, params)
 
 				Expect(err).Should(HaveOccurred())
 				Expect(location).Should(BeEmpty())
@@ -279,7 +313,7 @@ var _ = Describe("Instance test", func() {
 					}
 				})
 				It("should return error with status code", func() {
-					responseInstance, location, err := client.Provision(instance, params)
+					responseInstance, location, err := client.Provision(context.TODO(), instance, params)
 
 					Expect(err).Should(HaveOccurred())
 					Expect(location).Should(BeEmpty())
@@ -296,7 +330,7 @@ var _ = Describe("Instance test", func() {
 					}
 				})
 				It("should return error with url and description", func() {
-					responseInstance, location, err := client.Provision(instance, params)
+					responseInstance, location, err := client.Provision(context.TODO(), instance, params)
 
 					Expect(err).Should(HaveOccurred())
 					Expect(location).Should(BeEmpty())
@@ -313,7 +347,7 @@ var _ = Describe("Instance test", func() {
 					}
 				})
 				It("should return error without url and description if invalid response body", func() {
-					responseInstance, location, err := client.Provision(instance, params)
+					responseInstance, location, err := client.Provision(context.TODO(), instance, params)
 
 					Expect(err).Should(HaveOccurred())
 					Expect(location).Should(BeEmpty())
@@ -327,8 +361,8 @@ var _ = Describe("Instance test", func() {
 
 		Context("When invalid config is set", func() {
 			It("should return error", func() {
-				client = smclient.NewClient(context.TODO(), fakeAuthClient, "invalidURL")
-				_, location, err := client.Provision(instance, params)
+				client = smclient.NewClient(fakeAuthClient, "invalidURL")
+				_, location, err := client.Provision(context.TODO(), instance, params)
 
 				Expect(err).Should(HaveOccurred())
 				Expect(location).Should(BeEmpty())
@@ -345,7 +379,7 @@ var _ = Describe("Instance test", func() {
 				}
 			})
 			It("should be successfully removed", func() {
-				location, err := client.Deprovision(instance.ID, params)
+				location, err := client.Deprovision(context.TODO(), instance.ID, params)
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(location).Should(BeEmpty())
 			})
@@ -360,7 +394,7 @@ var _ = Describe("Instance test", func() {
 				}
 			})
 			It("should be successfully removed", func() {
-				location, err := client.Deprovision(instance.ID, params)
+				location, err := client.Deprovision(context.TODO(), instance.ID, params)
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(location).Should(Equal(locationHeader))
 			})
@@ -375,7 +409,7 @@ var _ = Describe("Instance test", func() {
 				}
 			})
 			It("should handle error", func() {
-				location, err := client.Deprovision(instance.ID, params)
+				location, err := client.Deprovision(context.TODO(), instance.ID, params)
 				Expect(err).Should(HaveOccurred())
 				Expect(location).Should(BeEmpty())
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path+instance.ID, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
@@ -391,7 +425,7 @@ var _ = Describe("Instance test", func() {
 				}
 			})
 			It("should handle error", func() {
-				location, err := client.Deprovision(instance.ID, params)
+				location, err := client.Deprovision(context.TODO(), instance.ID, params)
 				Expect(err).Should(HaveOccurred())
 				Expect(location).Should(BeEmpty())
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path+instance.ID, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
@@ -408,7 +442,7 @@ var _ = Describe("Instance test", func() {
 				}
 			})
 			It("should update successfully", func() {
-				responseInstance, location, err := client.UpdateInstance(instance.ID, instance, params)
+				responseInstance, location, err := client.UpdateInstance(context.TODO(), instance.ID, instance, params)
 
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(location).Should(HaveLen(0))
@@ -425,7 +459,7 @@ var _ = Describe("Instance test", func() {
 				}
 			})
 			It("should receive operation location", func() {
-				responseInstance, location, err := client.UpdateInstance(instance.ID, instance, params)
+				responseInstance, location, err := client.UpdateInstance(context.TODO(), instance.ID, instance, params)
 
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(location).Should(Equal(locationHeader))
@@ -445,7 +479,7 @@ var _ = Describe("Instance test", func() {
 				}
 			})
 			It("should return error", func() {
-				responseInstance, location, err := client.UpdateInstance(instance.ID, instance, params)
+				responseInstance, location, err := client.UpdateInstance(context.TODO(), instance.ID, instance, params)
 
 				Expect(err).Should(HaveOccurred())
 				Expect(location).Should(BeEmpty())
@@ -462,7 +496,7 @@ var _ = Describe("Instance test", func() {
 					}
 				})
 				It("should return error with status code", func() {
-					responseInstance, location, err := client.UpdateInstance(instance.ID, instance, params)
+					responseInstance, location, err := client.UpdateInstance(context.TODO(), instance.ID, instance, params)
 
 					Expect(err).Should(HaveOccurred())
 					Expect(location).Should(BeEmpty())
@@ -479,7 +513,7 @@ var _ = Describe("Instance test", func() {
 					}
 				})
 				It("should return error with url and description", func() {
-					responseInstance, location, err := client.UpdateInstance(instance.ID, instance, params)
+					responseInstance, location, err := client.UpdateInstance(context.TODO(), instance.ID, instance, params)
 
 					Expect(err).Should(HaveOccurred())
 					Expect(location).Should(BeEmpty())
@@ -496,7 +530,7 @@ var _ = Describe("Instance test", func() {
 					}
 				})
 				It("should return error without url and description if invalid response body", func() {
-					responseInstance, location, err := client.UpdateInstance(instance.ID, instance, params)
+					responseInstance, location, err := client.UpdateInstance(context.TODO(), instance.ID, instance, params)
 
 					Expect(err).Should(HaveOccurred())
 					Expect(location).Should(BeEmpty())
@@ -510,8 +544,8 @@ var _ = Describe("Instance test", func() {
 
 		Context("When invalid config is set", func() {
 			It("should return error", func() {
-				client = smclient.NewClient(context.TODO(), fakeAuthClient, "invalidURL")
-				_, location, err := client.UpdateInstance(instance.ID, instance, params)
+				client = smclient.NewClient(fakeAuthClient, "invalidURL")
+				_, location, err := client.UpdateInstance(context.TODO(), instance.ID, instance, params)
 
 				Expect(err).Should(HaveOccurred())
 				Expect(location).Should(BeEmpty())
@@ -18,7 +18,7 @@ var _ = Describe("Label test", func() {
 		})
 		It("should label resource sucessfully", func() {
 
-			err := client.Label(web.ServiceBrokersURL, "id", labelChanges, params)
+			err := client.Label(context.TODO(), web.ServiceBrokersURL, "id", labelChanges, params)
 			Expect(err).ShouldNot(HaveOccurred())
 		})
 	})
@@ -28,7 +28,7 @@ var _ = Describe("Label test", func() {
 			handlerDetails = []HandlerDetails{{Method: http.MethodPatch, Path: web.ServiceBrokersURL + "/", ResponseStatusCode: http.StatusNotFound}}
 		})
 		It("should return error", func() {
-			err := client.Label(web.ServiceBrokersURL, "id", labelChanges, params)
+			err := client.Label(context.TODO(), web.ServiceBrokersURL, "id", labelChanges, params)
 			Expect(err).Should(HaveOccurred())
 			verifyErrorMsg(err.Error(), handlerDetails[0].Path+"id", handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
 		})
@@ -36,8 +36,8 @@ var _ = Describe("Label test", func() {
 
 	Context("When invalid config is set", func() {
 		It("should return error", func() {
-			client = smclient.NewClient(context.TODO(),fakeAuthClient, "invalidURL")
-			err := client.Label(web.ServiceBrokersURL, "id", labelChanges, params)
+			client = smclient.NewClient(fakeAuthClient, "invalidURL")
+			err := client.Label(context.TODO(), web.ServiceBrokersURL, "id", labelChanges, params)
 			Expect(err).Should(HaveOccurred())
 		})
 	})
@@ -1,6 +1,7 @@
 package test
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/Peripli/service-manager/pkg/web"
 	"net/http"
@@ -29,7 +30,7 @@ var _ = Describe("Marketplace test", func() {
 			}
 		})
 		It("should return all with plans and broker name populated", func() {
-			result, err := client.Marketplace(params)
+			result, err := client.Marketplace(context.TODO(), params)
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(result.ServiceOfferings).To(HaveLen(1))
 			Expect(result.ServiceOfferings[0]).To(Equal(*resultOffering))
@@ -46,7 +47,7 @@ var _ = Describe("Marketplace test", func() {
 			}
 		})
 		It("should return an empty array", func() {
-			result, err := client.Marketplace(params)
+			result, err := client.Marketplace(context.TODO(), params)
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(result.ServiceOfferings).To(HaveLen(0))
 		})
@@ -59,7 +60,7 @@ var _ = Describe("Marketplace test", func() {
 			}
 		})
 		It("should handle status code != 200", func() {
-			_, err := client.Marketplace(params)
+			_, err := client.Marketplace(context.TODO(), params)
 			Expect(err).Should(HaveOccurred())
 			verifyErrorMsg(err.Error(), handlerDetails[0].Path, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
 		})
@@ -72,7 +73,7 @@ var _ = Describe("Marketplace test", func() {
 			}
 		})
 		It("should handle status code > 299", func() {
-			_, err := client.Marketplace(params)
+			_, err := client.Marketplace(context.TODO(), params)
 			Expect(err).Should(HaveOccurred())
 			verifyErrorMsg(err.Error(), handlerDetails[0].Path, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
 		})
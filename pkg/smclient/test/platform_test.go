@@ -34,7 +34,7 @@ var _ = Describe("Platform test", func() {
 				}
 			})
 			It("should return all", func() {
-				result, err := client.ListPlatforms(params)
+				result, err := client.ListPlatforms(context.TODO(), params)
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(result.Platforms).To(HaveLen(1))
 				Expect(result.Platforms[0]).To(Equal(*platform))
@@ -52,7 +52,7 @@ var _ = Describe("Platform test", func() {
 				}
 			})
 			It("should return an empty array", func() {
-				result, err := client.ListPlatforms(params)
+				result, err := client.ListPlatforms(context.TODO(), params)
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(result.Platforms).To(HaveLen(0))
 			})
@@ -65,7 +65,7 @@ var _ = Describe("Platform test", func() {
 				}
 			})
 			It("should handle status code != 200", func() {
-				_, err := client.ListPlatforms(params)
+				_, err := client.ListPlatforms(context.TODO(), params)
 				Expect(err).Should(HaveOccurred())
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
 			})
@@ -77,7 +77,7 @@ var _ = Describe("Platform test", func() {
 				}
 			})
 			It("should handle status code > 299", func() {
-				_, err := client.ListPlatforms(params)
+				_, err := client.ListPlatforms(context.TODO(), params)
 				Expect(err).Should(HaveOccurred())
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
 			})
@@ -93,7 +93,7 @@ var _ = Describe("Platform test", func() {
 				}
 			})
 			It("should register successfully", func() {
-				responsePlatform, err := client.RegisterPlatform(platform, params)
+				responsePlatform, err := client.RegisterPlatform(context.TODO(), platform, params)
 
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(responsePlatform).To(Equal(platform))
@@ -112,7 +112,7 @@ var _ = Describe("Platform test", func() {
 				}
 			})
 			It("should return error", func() {
-				responsePlatform, err := client.RegisterPlatform(platform, params)
+				responsePlatform, err := client.RegisterPlatform(context.TODO(), platform, params)
 
 				Expect(err).Should(HaveOccurred())
 				Expect(responsePlatform).To(BeNil())
@@ -128,7 +128,7 @@ var _ = Describe("Platform test", func() {
 					}
 				})
 				It("should return error with status code", func() {
-					responsePlatform, err := client.RegisterPlatform(platform, params)
+					responsePlatform, err := client.RegisterPlatform(context.TODO(), platform, params)
 
 					Expect(err).Should(HaveOccurred())
 					verifyErrorMsg(err.Error(), handlerDetails[0].Path, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
@@ -144,7 +144,7 @@ var _ = Describe("Platform test", func() {
 					}
 				})
 				It("should return error with url and description", func() {
-					responsePlatform, err := client.RegisterPlatform(platform, params)
+					responsePlatform, err := client.RegisterPlatform(context.TODO(), platform, params)
 
 					Expect(err).Should(HaveOccurred())
 					verifyErrorMsg(err.Error(), handlerDetails[0].Path, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
@@ -160,7 +160,7 @@ var _ = Describe("Platform test", func() {
 					}
 				})
 				It("should return error without url and description if invalid response body", func() {
-					responsePlatform, err := client.RegisterPlatform(platform, params)
+					responsePlatform, err := client.RegisterPlatform(context.TODO(), platform, params)
 
 					Expect(err).Should(HaveOccurred())
 					verifyErrorMsg(err.Error(), handlerDetails[0].Path, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
@@ -171,8 +171,8 @@ var _ = Describe("Platform test", func() {
 
 		Context("When invalid config is set", func() {
 			It("should return error", func() {
-				client = smclient.NewClient(context.TODO(), fakeAuthClient, "invalidURL")
-				_, err := client.RegisterPlatform(platform, params)
+				client = smclient.NewClient(fakeAuthClient, "invalidURL")
+				_, err := client.RegisterPlatform(context.TODO(), platform, params)
 
 				Expect(err).Should(HaveOccurred())
 			})
@@ -189,7 +189,7 @@ var _ = Describe("Platform test", func() {
 				}
 			})
 			It("should be successfully removed", func() {
-				updatedPlatform, err := client.UpdatePlatform("id", platform, params)
+				updatedPlatform, err := client.UpdatePlatform(context.TODO(), "id", platform, params)
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(updatedPlatform).To(Equal(platform))
 			})
@@ -204,7 +204,7 @@ var _ = Describe("Platform test", func() {
 				}
 			})
 			It("should handle error", func() {
-				_, err := client.UpdatePlatform("id", platform, params)
+				_, err := client.UpdatePlatform(context.TODO(), "id", platform, params)
 				Expect(err).Should(HaveOccurred())
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path+"id", handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
 			})
@@ -219,7 +219,7 @@ var _ = Describe("Platform test", func() {
 				}
 			})
 			It("should handle error", func() {
-				_, err := client.UpdatePlatform("id", platform, params)
+				_, err := client.UpdatePlatform(context.TODO(), "id", platform, params)
 				Expect(err).Should(HaveOccurred())
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path+"id", handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
 			})
@@ -237,7 +237,7 @@ var _ = Describe("Platform test", func() {
 			})
 			It("should be successfully removed", func() {
 				params.FieldQuery = append(params.FieldQuery, "id eq 'id'")
-				err := client.DeletePlatforms(params)
+				err := client.DeletePlatforms(context.TODO(), params)
 				Expect(err).ShouldNot(HaveOccurred())
 			})
 		})
@@ -252,7 +252,7 @@ var _ = Describe("Platform test", func() {
 			})
 			It("should handle error", func() {
 				params.FieldQuery = append(params.FieldQuery, "id eq 'id'")
-				err := client.DeletePlatforms(params)
+				err := client.DeletePlatforms(context.TODO(), params)
 				Expect(err).Should(HaveOccurred())
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
 			})
@@ -268,7 +268,7 @@ var _ = Describe("Platform test", func() {
 			})
 			It("should handle error", func() {
 				params.FieldQuery = append(params.FieldQuery, "id eq 'id'")
-				err := client.DeletePlatforms(params)
+				err := client.DeletePlatforms(context.TODO(), params)
 				Expect(err).Should(HaveOccurred())
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
 			})
@@ -285,7 +285,7 @@ var _ = Describe("Platform test", func() {
 				}
 			})
 			It("should return location of operation scheduled for platform cascade delete", func() {
-				location, err := client.DeletePlatform(platform.ID,  params)
+				location, err := client.DeletePlatform(context.TODO(), platform.ID,  params)
 
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(location).Should(Equal(locationHeader))
@@ -300,7 +300,7 @@ var _ = Describe("Platform test", func() {
 				}
 			})
 			It("should return error with non-expected status code, location should be nil", func() {
-				location, err := client.DeletePlatform(platform.ID, params)
+				location, err := client.DeletePlatform(context.TODO(), platform.ID, params)
 				Expect(err).Should(HaveOccurred())
 				Expect(location).Should(Equal(""))
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
@@ -315,7 +315,7 @@ var _ = Describe("Platform test", func() {
 				}
 			})
 			It("should return 404", func() {
-				location, err := client.DeletePlatform(platform.ID, params)
+				location, err := client.DeletePlatform(context.TODO(), platform.ID, params)
 				Expect(err).Should(HaveOccurred())
 				Expect(location).Should(Equal(""))
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
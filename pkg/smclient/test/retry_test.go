@@ -0,0 +1,182 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/Peripli/service-manager-cli/pkg/smclient"
+	"github.com/Peripli/service-manager-cli/pkg/types"
+	"github.com/Peripli/service-manager/pkg/web"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Retries", func() {
+	var retryServer *httptest.Server
+	var statuses []int
+	var retryAfter string
+	var requests []*http.Request
+	var bodies []string
+
+	BeforeEach(func() {
+		statuses = nil
+		retryAfter = ""
+		requests = nil
+		bodies = nil
+	})
+
+	JustBeforeEach(func() {
+		retryServer = httptest.NewServer(http.HandlerFunc(func(response http.ResponseWriter, req *http.Request) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requests = append(requests, req)
+			bodies = append(bodies, string(body))
+
+			status := http.StatusOK
+			if req.Method == http.MethodPost {
+				status = http.StatusCreated
+			}
+			if len(requests) <= len(statuses) {
+				status = statuses[len(requests)-1]
+			}
+			failed := status >= http.StatusBadRequest
+			if failed && retryAfter != "" {
+				response.Header().Set("Retry-After", retryAfter)
+			}
+			response.WriteHeader(status)
+			switch {
+			case failed:
+				response.Write([]byte(`{"error":"Unavailable","description":"try again"}`))
+			case req.Method == http.MethodGet:
+				body, _ := json.Marshal(types.ServiceInstances{ServiceInstances: []types.ServiceInstance{*instance}})
+				response.Write(body)
+			default:
+				body, _ := json.Marshal(instance)
+				response.Write(body)
+			}
+		}))
+		fakeAuthClient = &FakeAuthClient{AccessToken: validToken}
+		client = smclient.NewClientWithConfig(fakeAuthClient, &smclient.ClientConfig{
+			URL:   retryServer.URL,
+			Retry: smclient.RetryPolicy{MaxRetries: 2, InitialBackoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond},
+		})
+	})
+
+	AfterEach(func() {
+		retryServer.Close()
+	})
+
+	Context("when a GET request fails with a transient error", func() {
+		BeforeEach(func() {
+			statuses = []int{http.StatusServiceUnavailable, http.StatusBadGateway}
+		})
+
+		It("should retry it", func() {
+			result, err := client.ListInstances(context.TODO(), params)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result.ServiceInstances).To(HaveLen(1))
+			Expect(requests).To(HaveLen(3))
+		})
+	})
+
+	Context("when all retries fail", func() {
+		BeforeEach(func() {
+			statuses = []int{http.StatusGatewayTimeout, http.StatusGatewayTimeout, http.StatusGatewayTimeout}
+		})
+
+		It("should return the last error", func() {
+			_, err := client.GetInstanceByID(context.TODO(), instance.ID, params)
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("StatusCode: 504"))
+			Expect(requests).To(HaveLen(3))
+		})
+	})
+
+	Context("when the request fails with a non transient error", func() {
+		BeforeEach(func() {
+			statuses = []int{http.StatusInternalServerError}
+		})
+
+		It("should not retry it", func() {
+			_, err := client.GetInstanceByID(context.TODO(), instance.ID, params)
+			Expect(err).Should(HaveOccurred())
+			Expect(requests).To(HaveLen(1))
+		})
+	})
+
+	Context("when the server sends Retry-After", func() {
+		BeforeEach(func() {
+			statuses = []int{http.StatusTooManyRequests}
+			retryAfter = "1"
+		})
+
+		It("should wait for the requested time even if it exceeds the maximum backoff", func() {
+			start := time.Now()
+			_, err := client.GetInstanceByID(context.TODO(), instance.ID, params)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(requests).To(HaveLen(2))
+			Expect(time.Since(start)).To(BeNumerically(">=", time.Second))
+		})
+
+		Context("which exceeds the deadline of the request", func() {
+			It("should not retry", func() {
+				ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+				defer cancel()
+				_, err := client.GetInstanceByID(ctx, instance.ID, params)
+				Expect(err).Should(HaveOccurred())
+				Expect(err).ShouldNot(MatchError(context.DeadlineExceeded))
+				Expect(requests).To(HaveLen(1))
+			})
+		})
+	})
+
+	Context("when a POST request fails with a transient error", func() {
+		BeforeEach(func() {
+			statuses = []int{http.StatusServiceUnavailable}
+		})
+
+		It("should not retry it without an idempotency key", func() {
+			_, _, err := client.Provision(context.TODO(), instance, params)
+			Expect(err).Should(HaveOccurred())
+			Expect(requests).To(HaveLen(1))
+		})
+
+		It("should retry it with the same body and idempotency key", func() {
+			params.IdempotencyKey = "key-1"
+			_, _, err := client.Provision(context.TODO(), instance, params)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(requests).To(HaveLen(2))
+			Expect(requests[1].Header.Get(smclient.IdempotencyKeyHeader)).To(Equal("key-1"))
+			Expect(bodies[1]).To(Equal(bodies[0]))
+			Expect(bodies[1]).To(ContainSubstring(instance.Name))
+		})
+	})
+
+	Context("when a label change fails with a transient error", func() {
+		BeforeEach(func() {
+			statuses = []int{http.StatusServiceUnavailable}
+		})
+
+		It("should retry it", func() {
+			err := client.Label(context.TODO(), web.ServiceInstancesURL, instance.ID, labelChanges, params)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(requests).To(HaveLen(2))
+		})
+	})
+
+	Context("when an update fails with a transient error", func() {
+		BeforeEach(func() {
+			statuses = []int{http.StatusServiceUnavailable}
+		})
+
+		It("should not retry it", func() {
+			_, _, err := client.UpdateInstance(context.TODO(), instance.ID, instance, params)
+			Expect(err).Should(HaveOccurred())
+			Expect(requests).To(HaveLen(1))
+		})
+	})
+})
@@ -1,7 +1,6 @@
 package test
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -155,5 +154,5 @@ var _ = AfterEach(func() {
 var _ = JustBeforeEach(func() {
 	smServer = httptest.NewServer(createSMHandler())
 	fakeAuthClient = &FakeAuthClient{AccessToken: validToken}
-	client = smclient.NewClient(context.TODO(), fakeAuthClient, smServer.URL)
+	client = smclient.NewClient(fakeAuthClient, smServer.URL)
 })
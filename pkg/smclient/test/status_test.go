@@ -1,6 +1,7 @@
 package test
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/Peripli/service-manager/pkg/web"
 	"net/http"
@@ -18,7 +19,7 @@ var _ = Describe("Status test", func() {
 			}
 		})
 		It("should return it", func() {
-			result, err := client.Status(web.ServiceBrokersURL+"/"+broker.ID+"/"+operation.ID, params)
+			result, err := client.Status(context.TODO(), web.ServiceBrokersURL+"/"+broker.ID+"/"+operation.ID, params)
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(result).To(Equal(operation))
 		})
@@ -31,7 +32,7 @@ var _ = Describe("Status test", func() {
 			}
 		})
 		It("should return 404", func() {
-			_, err := client.Status(web.ServiceBrokersURL+"/"+broker.ID+"/"+operation.ID, params)
+			_, err := client.Status(context.TODO(), web.ServiceBrokersURL+"/"+broker.ID+"/"+operation.ID, params)
 			Expect(err).Should(HaveOccurred())
 			verifyErrorMsg(err.Error(), web.ServiceBrokersURL+"/"+broker.ID+"/"+operation.ID, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
 		})
@@ -44,7 +45,7 @@ var _ = Describe("Status test", func() {
 			}
 		})
 		It("should handle status code != 200", func() {
-			_, err := client.Status(web.ServiceBrokersURL+"/"+broker.ID+"/"+operation.ID, params)
+			_, err := client.Status(context.TODO(), web.ServiceBrokersURL+"/"+broker.ID+"/"+operation.ID, params)
 			Expect(err).Should(HaveOccurred())
 			verifyErrorMsg(err.Error(), web.ServiceBrokersURL+"/"+broker.ID+"/"+operation.ID, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
 		})
@@ -57,7 +58,7 @@ var _ = Describe("Status test", func() {
 			}
 		})
 		It("should handle status code > 299", func() {
-			_, err := client.Status(web.ServiceBrokersURL+"/"+broker.ID+"/"+operation.ID, params)
+			_, err := client.Status(context.TODO(), web.ServiceBrokersURL+"/"+broker.ID+"/"+operation.ID, params)
 			Expect(err).Should(HaveOccurred())
 			verifyErrorMsg(err.Error(), web.ServiceBrokersURL+"/"+broker.ID+"/"+operation.ID, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
 
@@ -33,7 +33,7 @@ var _ = Describe("Visibility test", func() {
 				}
 			})
 			It("should return all", func() {
-				result, err := client.ListVisibilities(params)
+				result, err := client.ListVisibilities(context.TODO(), params)
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(result.Visibilities).To(HaveLen(1))
 				Expect(result.Visibilities[0]).To(Equal(*visibility))
@@ -51,7 +51,7 @@ var _ = Describe("Visibility test", func() {
 				}
 			})
 			It("should return an empty array", func() {
-				result, err := client.ListVisibilities(params)
+				result, err := client.ListVisibilities(context.TODO(), params)
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(result.Visibilities).To(HaveLen(0))
 			})
@@ -64,7 +64,7 @@ var _ = Describe("Visibility test", func() {
 				}
 			})
 			It("should handle status code != 200", func() {
-				_, err := client.ListVisibilities(params)
+				_, err := client.ListVisibilities(context.TODO(), params)
 				Expect(err).Should(HaveOccurred())
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
 			})
@@ -77,7 +77,7 @@ var _ = Describe("Visibility test", func() {
 				}
 			})
 			It("should handle status code > 299", func() {
-				_, err := client.ListVisibilities(params)
+				_, err := client.ListVisibilities(context.TODO(), params)
 				Expect(err).Should(HaveOccurred())
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
 			})
@@ -93,7 +93,7 @@ var _ = Describe("Visibility test", func() {
 				}
 			})
 			It("should register successfully", func() {
-				responseVisibility, err := client.RegisterVisibility(visibility, params)
+				responseVisibility, err := client.RegisterVisibility(context.TODO(), visibility, params)
 
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(responseVisibility).To(Equal(visibility))
@@ -113,7 +113,7 @@ var _ = Describe("Visibility test", func() {
 			})
 
 			It("should return error", func() {
-				responseVisibility, err := client.RegisterVisibility(visibility, params)
+				responseVisibility, err := client.RegisterVisibility(context.TODO(), visibility, params)
 
 				Expect(err).Should(HaveOccurred())
 				Expect(responseVisibility).To(BeNil())
@@ -129,7 +129,7 @@ var _ = Describe("Visibility test", func() {
 					}
 				})
 				It("should return error with status code", func() {
-					responseVisibility, err := client.RegisterVisibility(visibility, params)
+					responseVisibility, err := client.RegisterVisibility(context.TODO(), visibility, params)
 
 					Expect(err).Should(HaveOccurred())
 					verifyErrorMsg(err.Error(), handlerDetails[0].Path, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
@@ -145,7 +145,7 @@ var _ = Describe("Visibility test", func() {
 					}
 				})
 				It("should return error with url and description", func() {
-					responseVisibility, err := client.RegisterVisibility(visibility, params)
+					responseVisibility, err := client.RegisterVisibility(context.TODO(), visibility, params)
 
 					Expect(err).Should(HaveOccurred())
 					verifyErrorMsg(err.Error(), handlerDetails[0].Path, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
@@ -161,7 +161,7 @@ var _ = Describe("Visibility test", func() {
 					}
 				})
 				It("should return error without url and description if invalid response body", func() {
-					responseVisibility, err := client.RegisterVisibility(visibility, params)
+					responseVisibility, err := client.RegisterVisibility(context.TODO(), visibility, params)
 
 					Expect(err).Should(HaveOccurred())
 					verifyErrorMsg(err.Error(), handlerDetails[0].Path, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
@@ -173,8 +173,8 @@ var _ = Describe("Visibility test", func() {
 
 		Context("When invalid config is set", func() {
 			It("should return error", func() {
-				client = smclient.NewClient(context.TODO(), fakeAuthClient, "invalidURL")
-				_, err := client.RegisterVisibility(visibility, params)
+				client = smclient.NewClient(fakeAuthClient, "invalidURL")
+				_, err := client.RegisterVisibility(context.TODO(), visibility, params)
 
 				Expect(err).Should(HaveOccurred())
 			})
@@ -191,7 +191,7 @@ var _ = Describe("Visibility test", func() {
 				}
 			})
 			It("should be successfully removed", func() {
-				updatedVisibility, err := client.UpdateVisibility("id", visibility, params)
+				updatedVisibility, err := client.UpdateVisibility(context.TODO(), "id", visibility, params)
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(updatedVisibility).To(Equal(visibility))
 			})
@@ -206,7 +206,7 @@ var _ = Describe("Visibility test", func() {
 				}
 			})
 			It("should handle error", func() {
-				_, err := client.UpdateVisibility("id", visibility, params)
+				_, err := client.UpdateVisibility(context.TODO(), "id", visibility, params)
 				Expect(err).Should(HaveOccurred())
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path+"id", handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
 			})
@@ -221,7 +221,7 @@ var _ = Describe("Visibility test", func() {
 				}
 			})
 			It("should handle error", func() {
-				_, err := client.UpdateVisibility("id", visibility, params)
+				_, err := client.UpdateVisibility(context.TODO(), "id", visibility, params)
 				Expect(err).Should(HaveOccurred())
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path+"id", handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
 
@@ -240,7 +240,7 @@ var _ = Describe("Visibility test", func() {
 			})
 			It("should be successfully removed", func() {
 				params.FieldQuery = append(params.FieldQuery, "id eq 'id'")
-				err := client.DeleteVisibilities(params)
+				err := client.DeleteVisibilities(context.TODO(), params)
 				Expect(err).ShouldNot(HaveOccurred())
 			})
 		})
@@ -255,7 +255,7 @@ var _ = Describe("Visibility test", func() {
 			})
 			It("should handle error", func() {
 				params.FieldQuery = append(params.FieldQuery, "id eq 'id'")
-				err := client.DeleteVisibilities(params)
+				err := client.DeleteVisibilities(context.TODO(), params)
 				Expect(err).Should(HaveOccurred())
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
 			})
@@ -271,7 +271,7 @@ var _ = Describe("Visibility test", func() {
 			})
 			It("should handle error", func() {
 				params.FieldQuery = append(params.FieldQuery, "id eq 'id'")
-				err := client.DeleteVisibilities(params)
+				err := client.DeleteVisibilities(context.TODO(), params)
 				Expect(err).Should(HaveOccurred())
 				verifyErrorMsg(err.Error(), handlerDetails[0].Path, handlerDetails[0].ResponseBody, handlerDetails[0].ResponseStatusCode)
 			})
@@ -64,7 +64,7 @@ func (b *Broker) TableData() *TableData {
 
 // Brokers wraps an array of brokers
 type Brokers struct {
-	Brokers []Broker `json:"items"`
+	Brokers []Broker `json:"items" yaml:"items"`
 }
 
 // IsEmpty whether the structure is empty
@@ -1,5 +1,10 @@
 package types
 
+import "encoding/json"
+
+// RedactedValue replaces credential values in the output
+const RedactedValue = "[REDACTED]"
+
 // Credentials contains types of credentials
 type Credentials struct {
 	Basic Basic `json:"basic,omitempty" yaml:"basic,omitempty"`
@@ -10,3 +15,53 @@ type Basic struct {
 	User     string `json:"username,omitempty" yaml:"username,omitempty"`
 	Password string `json:"password,omitempty" yaml:"password,omitempty"`
 }
+
+// redacted returns a copy of the credentials with masked values
+func (c *Credentials) redacted() *Credentials {
+	if c == nil {
+		return nil
+	}
+	result := *c
+	if result.Basic.User != "" {
+		result.Basic.User = RedactedValue
+	}
+	if result.Basic.Password != "" {
+		result.Basic.Password = RedactedValue
+	}
+	return &result
+}
+
+// redactJSON masks all values of a JSON document, keeping the keys of its objects
+func redactJSON(raw json.RawMessage) json.RawMessage {
+	if len(raw) == 0 {
+		return raw
+	}
+	var value interface{}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		value = RedactedValue
+	}
+	result, err := json.Marshal(redactValue(value))
+	if err != nil {
+		return json.RawMessage(`"` + RedactedValue + `"`)
+	}
+	return result
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			v[key] = redactValue(item)
+		}
+		return v
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+		return v
+	case nil:
+		return nil
+	default:
+		return RedactedValue
+	}
+}
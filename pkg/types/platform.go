@@ -33,6 +33,10 @@ type Platform struct {
 	Credentials *Credentials `json:"credentials,omitempty" yaml:"credentials,omitempty"`
 	Labels      types.Labels `json:"labels,omitempty" yaml:"labels,omitempty"`
 	Ready       bool         `json:"ready,omitempty" yaml:"ready,omitempty"`
+
+	LastOperation *types.Operation `json:"last_operation,omitempty" yaml:"last_operation,omitempty"`
+
+	Vertical bool `json:"-" yaml:"-"`
 }
 
 // Message title of the table
@@ -45,12 +49,23 @@ func (p *Platform) IsEmpty() bool {
 	return false
 }
 
+// WithRedactedCredentials returns a copy of the platform with masked credentials
+func (p *Platform) WithRedactedCredentials() ServiceManagerObject {
+	result := *p
+	result.Credentials = p.Credentials.redacted()
+	return &result
+}
+
 // TableData returns the data to populate a table
 func (p *Platform) TableData() *TableData {
-	result := &TableData{}
+	result := &TableData{Vertical: p.Vertical}
+	result.Headers = []string{"ID", "Name", "Type", "Description", "Created", "Updated", "Ready", "Labels", "Last Op"}
 
-	result.Headers = []string{"ID", "Name", "Type", "Description", "Created", "Updated", "Labels"}
-	row := []string{p.ID, p.Name, p.Type, p.Description, p.Created, p.Updated, formatLabels(p.Labels)}
+	lastState := "-"
+	if p.LastOperation != nil {
+		lastState = formatLastOp(p.LastOperation)
+	}
+	row := []string{p.ID, p.Name, p.Type, p.Description, p.Created, p.Updated, strconv.FormatBool(p.Ready), formatLabels(p.Labels), lastState}
 
 	if p.Credentials != nil {
 		result.Headers = append(result.Headers, "Username", "Password")
@@ -64,7 +79,7 @@ func (p *Platform) TableData() *TableData {
 
 // Platforms wraps an array of platforms
 type Platforms struct {
-	Platforms []Platform `json:"items"`
+	Platforms []Platform `json:"items" yaml:"items"`
 }
 
 // IsEmpty whether the structure is empty
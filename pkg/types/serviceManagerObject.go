@@ -26,3 +26,9 @@ type ServiceManagerObject interface {
 type TableDataPrinter interface {
 	TableData() *TableData
 }
+
+// CredentialsRedactor defines interface for objects containing credentials
+type CredentialsRedactor interface {
+	// WithRedactedCredentials returns a copy of the object with masked credential values
+	WithRedactedCredentials() ServiceManagerObject
+}
@@ -39,7 +39,7 @@ type ServiceBinding struct {
 	ServiceInstanceName string `json:"service_instance_name,omitempty" yaml:"service_instance_name,omitempty"`
 
 	SyslogDrainURL  string          `json:"syslog_drain_url,omitempty" yaml:"syslog_drain_url,omitempty"`
-	RouteServiceURL string          `json:"route_service_url,omitempty"`
+	RouteServiceURL string          `json:"route_service_url,omitempty" yaml:"route_service_url,omitempty"`
 	VolumeMounts    json.RawMessage `json:"-" yaml:"-"`
 	Endpoints       json.RawMessage `json:"-" yaml:"-"`
 	Context         json.RawMessage `json:"context,omitempty" yaml:"context,omitempty"`
@@ -61,6 +61,13 @@ func (sb *ServiceBinding) IsEmpty() bool {
 	return false
 }
 
+// WithRedactedCredentials returns a copy of the binding with masked credentials
+func (sb *ServiceBinding) WithRedactedCredentials() ServiceManagerObject {
+	result := *sb
+	result.Credentials = redactJSON(sb.Credentials)
+	return &result
+}
+
 // TableData returns the data to populate a table
 func (sb *ServiceBinding) TableData() *TableData {
 	result := &TableData{Vertical: true}
@@ -102,6 +109,16 @@ func (sb *ServiceBindings) IsEmpty() bool {
 	return len(sb.ServiceBindings) == 0
 }
 
+// WithRedactedCredentials returns a copy of the bindings with masked credentials
+func (sb *ServiceBindings) WithRedactedCredentials() ServiceManagerObject {
+	result := *sb
+	result.ServiceBindings = make([]ServiceBinding, len(sb.ServiceBindings))
+	for i := range sb.ServiceBindings {
+		result.ServiceBindings[i] = *sb.ServiceBindings[i].WithRedactedCredentials().(*ServiceBinding)
+	}
+	return &result
+}
+
 // TableData returns the data to populate a table
 func (sb *ServiceBindings) TableData() *TableData {
 	result := &TableData{Vertical: sb.Vertical}
@@ -36,7 +36,7 @@ type ServiceInstance struct {
 	PlatformID    string `json:"platform_id,omitempty" yaml:"platform_id,omitempty"`
 
 	Parameters      json.RawMessage `json:"parameters,omitempty" yaml:"parameters,omitempty"`
-	MaintenanceInfo json.RawMessage `json:"maintenance_info,omitempty" yaml:"-"`
+	MaintenanceInfo json.RawMessage `json:"maintenance_info,omitempty" yaml:"maintenance_info,omitempty"`
 	Context         json.RawMessage `json:"context,omitempty" yaml:"context,omitempty"`
 	PreviousValues  json.RawMessage `json:"-" yaml:"-"`
 
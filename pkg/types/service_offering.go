@@ -41,15 +41,17 @@ type ServiceOffering struct {
 	CatalogID            string `json:"catalog_id,omitempty" yaml:"catalog_id,omitempty"`
 	CatalogName          string `json:"catalog_name,omitempty" yaml:"catalog_name,omitempty"`
 
-	Tags     json.RawMessage `json:"tags,omitempty" yaml:"-"`
-	Requires json.RawMessage `json:"requires,omitempty" yaml:"-"`
-	Metadata json.RawMessage `json:"metadata,omitempty" yaml:"-"`
+	Tags     json.RawMessage `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Requires json.RawMessage `json:"requires,omitempty" yaml:"requires,omitempty"`
+	Metadata json.RawMessage `json:"metadata,omitempty" yaml:"metadata,omitempty"`
 
 	BrokerID   string        `json:"broker_id,omitempty" yaml:"broker_id,omitempty"`
 	BrokerName string        `json:"broker_name,omitempty" yaml:"broker_name,omitempty"`
 	Plans      []ServicePlan `json:"plans,omitempty" yaml:"plans,omitempty"`
 	Labels     types.Labels  `json:"labels,omitempty" yaml:"labels,omitempty"`
 	Ready      bool          `json:"ready,omitempty" yaml:"ready,omitempty"`
+
+	LastOperation *types.Operation `json:"last_operation,omitempty" yaml:"last_operation,omitempty"`
 }
 
 const BROKER_ID = "BROKER ID"
@@ -66,15 +68,24 @@ func (so *ServiceOffering) IsEmpty() bool {
 
 // TableData returns the data to populate a table
 func (so *ServiceOffering) TableData() *TableData {
-	result := &TableData{}
-	result.Headers = []string{"Name", "Plans", "Description", BROKER_ID}
+	result := &TableData{Vertical: true}
+	result.Headers = []string{"ID", "Name", "Description", "Catalog ID", "Catalog Name", BROKER_ID, "Broker Name", "Plans",
+		"Bindable", "Plan Updateable", "Instances Retrievable", "Bindings Retrievable", "Allow Context Updates", "Tags",
+		"Created", "Updated", "Ready", "Labels", "Last Op"}
 
 	plans := make([]string, len(so.Plans))
 	for i, v := range so.Plans {
 		plans[i] = v.Name
 	}
+	lastState := "-"
+	if so.LastOperation != nil {
+		lastState = formatLastOp(so.LastOperation)
+	}
 
-	row := []string{so.Name, strings.Join(plans, ", "), so.Description, so.BrokerID}
+	row := []string{so.ID, so.Name, so.Description, so.CatalogID, so.CatalogName, so.BrokerID, so.BrokerName, strings.Join(plans, ", "),
+		strconv.FormatBool(so.Bindable), strconv.FormatBool(so.PlanUpdatable), strconv.FormatBool(so.InstancesRetrievable),
+		strconv.FormatBool(so.BindingsRetrievable), strconv.FormatBool(so.AllowContextUpdates), formatRawJSON(so.Tags),
+		so.CreatedAt, so.UpdatedAt, strconv.FormatBool(so.Ready), formatLabels(so.Labels), lastState}
 	result.Data = append(result.Data, row)
 
 	return result
@@ -39,12 +39,14 @@ type ServicePlan struct {
 	Bindable      bool   `json:"bindable,omitempty" yaml:"bindable,omitempty"`
 	PlanUpdatable bool   `json:"plan_updateable,omitempty" yaml:"plan_updateable,omitempty"`
 
-	Metadata json.RawMessage `json:"metadata,omitempty" yaml:"-"`
-	Schemas  json.RawMessage `json:"schemas,omitempty" yaml:"-"`
+	Metadata json.RawMessage `json:"metadata,omitempty" yaml:"metadata,omitempty"`
+	Schemas  json.RawMessage `json:"schemas,omitempty" yaml:"schemas,omitempty"`
 
 	ServiceOfferingID string       `json:"service_offering_id,omitempty" yaml:"service_offering_id,omitempty"`
 	Labels            types.Labels `json:"labels,omitempty" yaml:"labels,omitempty"`
 	Ready             bool         `json:"ready,omitempty" yaml:"ready,omitempty"`
+
+	LastOperation *types.Operation `json:"last_operation,omitempty" yaml:"last_operation,omitempty"`
 }
 
 // Message title of the table
@@ -59,10 +61,17 @@ func (sp *ServicePlan) IsEmpty() bool {
 
 // TableData returns the data to populate a table
 func (sp *ServicePlan) TableData() *TableData {
-	result := &TableData{}
-	result.Headers = []string{"Plan", "Description", "ID"}
+	result := &TableData{Vertical: true}
+	result.Headers = []string{"ID", "Name", "Description", "Catalog ID", "Catalog Name", "Offering ID",
+		"Free", "Bindable", "Plan Updateable", "Shareable", "Created", "Updated", "Ready", "Labels", "Last Op"}
 
-	row := []string{sp.Name, sp.Description, sp.ID}
+	lastState := "-"
+	if sp.LastOperation != nil {
+		lastState = formatLastOp(sp.LastOperation)
+	}
+	row := []string{sp.ID, sp.Name, sp.Description, sp.CatalogID, sp.CatalogName, sp.ServiceOfferingID,
+		strconv.FormatBool(sp.Free), strconv.FormatBool(sp.Bindable), strconv.FormatBool(sp.PlanUpdatable),
+		strconv.FormatBool(sp.ShareableProperty()), sp.CreatedAt, sp.UpdatedAt, strconv.FormatBool(sp.Ready), formatLabels(sp.Labels), lastState}
 	result.Data = append(result.Data, row)
 
 	return result
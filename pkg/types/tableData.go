@@ -17,6 +17,8 @@
 package types
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"github.com/Peripli/service-manager/pkg/types"
 	"strings"
@@ -27,6 +29,22 @@ type TableData struct {
 	Headers  []string
 	Data     [][]string
 	Vertical bool
+	// HideHeaders omits the header lines of a horizontal table, e.g. when rows are appended to an already printed table
+	HideHeaders bool
+	// Widths sets the minimum widths of the columns of a horizontal table, e.g. the widths of an already printed table.
+	// Columns are widened to fit longer cells.
+	Widths []int
+}
+
+// ColumnWidths returns the widths of the columns of a horizontal table, including the spacing between columns
+func (table *TableData) ColumnWidths() []int {
+	fieldLen := table.fieldsLen()
+	for i := range fieldLen {
+		if i < len(table.Widths) && table.Widths[i] > fieldLen[i] {
+			fieldLen[i] = table.Widths[i]
+		}
+	}
+	return fieldLen
 }
 
 // String implements Stringer interface
@@ -44,17 +62,19 @@ func (table *TableData) horizontalTable() string {
 	}
 
 	// get fields lengths
-	fieldLen := table.fieldsLen()
+	fieldLen := table.ColumnWidths()
 
-	for i, header := range table.Headers {
-		output += pad(header, fieldLen[i])
-	}
-	output += "\n"
+	if !table.HideHeaders {
+		for i, header := range table.Headers {
+			output += pad(header, fieldLen[i])
+		}
+		output += "\n"
 
-	for i := range table.Headers {
-		output += line(fieldLen[i]-2) + "  "
+		for i := range table.Headers {
+			output += line(fieldLen[i]-2) + "  "
+		}
+		output += "\n"
 	}
-	output += "\n"
 
 	for _, row := range table.Data {
 		for i, cell := range row {
@@ -176,3 +196,11 @@ func formatLastOp(operation *types.Operation) string {
 	}
 	return fmt.Sprintf("%s %s %s", operation.Type, operation.State, operation.Errors)
 }
+
+func formatRawJSON(raw json.RawMessage) string {
+	compacted := &bytes.Buffer{}
+	if err := json.Compact(compacted, raw); err != nil {
+		return string(raw)
+	}
+	return compacted.String()
+}
@@ -31,6 +31,8 @@ type Visibility struct {
 	UpdatedAt     string       `json:"updated_at,omitempty" yaml:"updated_at,omitempty"`
 	Labels        types.Labels `json:"labels,omitempty" yaml:"labels,omitempty"`
 	Ready         bool         `json:"ready,omitempty" yaml:"ready,omitempty"`
+
+	LastOperation *types.Operation `json:"last_operation,omitempty" yaml:"last_operation,omitempty"`
 }
 
 // Message title of the table
@@ -45,10 +47,14 @@ func (v *Visibility) IsEmpty() bool {
 
 // TableData returns the data to populate a table
 func (v *Visibility) TableData() *TableData {
-	result := &TableData{}
-	result.Headers = []string{"ID", "Platform ID", "Service Plan ID", "Labels"}
+	result := &TableData{Vertical: true}
+	result.Headers = []string{"ID", "Platform ID", "Service Plan ID", "Created", "Updated", "Ready", "Labels", "Last Op"}
 
-	row := []string{v.ID, v.PlatformID, v.ServicePlanID, formatLabels(v.Labels)}
+	lastState := "-"
+	if v.LastOperation != nil {
+		lastState = formatLastOp(v.LastOperation)
+	}
+	row := []string{v.ID, v.PlatformID, v.ServicePlanID, v.CreatedAt, v.UpdatedAt, strconv.FormatBool(v.Ready), formatLabels(v.Labels), lastState}
 	result.Data = append(result.Data, row)
 
 	return result